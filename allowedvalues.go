@@ -0,0 +1,88 @@
+package hyprls
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hyprland-community/hyprls/parser"
+	"go.lsp.dev/protocol"
+)
+
+// disallowedValueProblems reports values that are not one of the values an option documents, such as layout = dwindel.
+// Values that take parameters, such as accel_profile = custom 200 0.0 0.5, are recognised by their first word.
+// Layouts are not checked when the configuration loads plugins, which can add some, such as hy3.
+func disallowedValueProblems(uri protocol.URI, document parser.Section, lines []string) []configProblem {
+	problems := make([]configProblem, 0)
+	schema := schemaOf(uri)
+	withPlugins := loadsPlugins(configGraph(uri))
+	walkAssignmentsWithPath(document, []string{}, func(path []string, assignment parser.Assignment) {
+		if len(path) < 2 || isFreeformPath(path) {
+			return
+		}
+		if withPlugins && strings.Join(path, ":") == "general:layout" {
+			return
+		}
+		variable := schema.FindVariableDefinitionInSection(path[len(path)-2], path[len(path)-1])
+		if variable == nil {
+			return
+		}
+		value, rang := valueRange(lines, assignment.Position.Line)
+		// Values using custom variables are checked once expanded, by Hyprland
+		if value == "" || strings.Contains(value, "$") || variable.Allows(value) {
+			return
+		}
+
+		allowed := make([]string, 0, len(variable.AllowedValues))
+		for _, a := range variable.AllowedValues {
+			allowed = append(allowed, a.Value)
+		}
+		// Offer the most likely intended value first
+		word, _, _ := strings.Cut(value, " ")
+		if suggestion, found := closestName(word, allowed); found {
+			i := slices.Index(allowed, suggestion)
+			allowed = append([]string{suggestion}, slices.Delete(slices.Clone(allowed), i, i+1)...)
+		}
+
+		fixes := make([]quickFix, 0, len(allowed))
+		for _, a := range allowed {
+			// Keep the parameters of values that take some
+			edit := protocol.TextEdit{Range: rang, NewText: a}
+			if definition, _ := variable.AllowedValue(a); definition.Parameters != "" && word != value {
+				edit.Range.End.Character = edit.Range.Start.Character + uint32(len(word))
+			}
+			fixes = append(fixes, quickFix{
+				Title: fmt.Sprintf("Replace with %s", a),
+				Edits: []protocol.TextEdit{edit},
+			})
+		}
+		problems = append(problems, configProblem{
+			Diagnostic: protocol.Diagnostic{
+				Range:    rang,
+				Severity: protocol.DiagnosticSeverityWarning,
+				Source:   diagnosticsSource,
+				Message:  fmt.Sprintf("%s is not a valid value for %s, expected one of %s", value, strings.Join(path, ":"), strings.Join(allowed, ", ")),
+			},
+			Fixes: fixes,
+		})
+	})
+	return problems
+}
+
+// loadsPlugins tells whether the configuration loads Hyprland plugins, with plugin = PATH or with exec-once = hyprpm reload.
+// A plugin { } section configures plugins, so it is taken as loading them too.
+func loadsPlugins(graph []configDocument) bool {
+	for _, doc := range graph {
+		if slices.ContainsFunc(doc.Document.Assignments, func(a parser.Assignment) bool { return a.Key == "plugin" }) ||
+			slices.ContainsFunc(doc.Document.Subsections, func(s parser.Section) bool { return s.Name == "plugin" }) {
+			return true
+		}
+	}
+	loads := false
+	walkConfigStatements(graph, func(doc configDocument, stmt *parser.Statement) {
+		if (stmt.Keyword == "exec-once" || stmt.Keyword == "exec") && strings.Contains(stmt.ValueRaw, "hyprpm reload") {
+			loads = true
+		}
+	})
+	return loads
+}
@@ -0,0 +1,359 @@
+package hyprls
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hyprland-community/hyprls/parser"
+	parser_data "github.com/hyprland-community/hyprls/parser/data"
+	"go.lsp.dev/protocol"
+)
+
+const (
+	animationArgName = iota
+	animationArgOnOff
+	animationArgSpeed
+	animationArgCurve
+	animationArgStyle
+)
+
+const (
+	bezierArgName = iota
+	bezierArgX0
+	bezierArgY0
+	bezierArgX1
+	bezierArgY1
+)
+
+var percentagePattern = regexp.MustCompile(`^\d+(\.\d+)?%$`)
+
+// animationStyleOptions validate the optional argument that can follow a style, as in "popin 80%" or "slide left".
+// Styles that are not listed here don't accept any argument.
+var animationStyleOptions = map[string]*regexp.Regexp{
+	"popin":         percentagePattern,
+	"slide":         regexp.MustCompile(`^(top|bottom|left|right|\d+(\.\d+)?%)$`),
+	"slidevert":     percentagePattern,
+	"slidefade":     percentagePattern,
+	"slidefadevert": percentagePattern,
+}
+
+// bezierDeclaration is a curve declared with bezier = NAME, X0, Y0, X1, Y1
+type bezierDeclaration struct {
+	URI       protocol.URI
+	Name      string
+	Points    [4]float64
+	Statement parser.Statement
+}
+
+func (b bezierDeclaration) Location() protocol.Location {
+	return protocol.Location{
+		URI:   b.URI,
+		Range: b.Statement.Arguments[bezierArgName].LSPRange(),
+	}
+}
+
+// bezierDeclarations returns all the curves declared in the configuration graph, in declaration order.
+func bezierDeclarations(graph []configDocument) []bezierDeclaration {
+	declarations := make([]bezierDeclaration, 0)
+	for _, doc := range graph {
+		doc.Document.WalkStatements(func(stmt *parser.Statement) {
+			if stmt.Keyword != "bezier" {
+				return
+			}
+			args := stmt.RawArguments()
+			declaration := bezierDeclaration{
+				URI:       doc.URI,
+				Name:      args[bezierArgName],
+				Statement: *stmt,
+			}
+			for i := range declaration.Points {
+				if i+1 < len(args) {
+					declaration.Points[i], _ = strconv.ParseFloat(args[i+1], 64)
+				}
+			}
+			declarations = append(declarations, declaration)
+		})
+	}
+	return declarations
+}
+
+func findBezierDeclarations(graph []configDocument, name string) []bezierDeclaration {
+	found := make([]bezierDeclaration, 0)
+	for _, declaration := range bezierDeclarations(graph) {
+		if declaration.Name == name {
+			found = append(found, declaration)
+		}
+	}
+	return found
+}
+
+func animationDiagnostics(uri protocol.URI, document parser.Section) []protocol.Diagnostic {
+	declaredCurves := make([]string, 0)
+	for _, declaration := range bezierDeclarations(configGraph(uri)) {
+		declaredCurves = append(declaredCurves, declaration.Name)
+	}
+
+	diagnostics := make([]protocol.Diagnostic, 0)
+	document.WalkStatements(func(stmt *parser.Statement) {
+		switch stmt.Keyword {
+		case "animation":
+			diagnostics = append(diagnostics, checkAnimation(*stmt, declaredCurves)...)
+		case "bezier":
+			diagnostics = append(diagnostics, checkBezier(*stmt)...)
+		}
+	})
+	return diagnostics
+}
+
+func statementDiagnostic(stmt parser.Statement, argument int, severity protocol.DiagnosticSeverity, message string, fmtArgs ...any) protocol.Diagnostic {
+	rang := statementRange(stmt)
+	if argument >= 0 && argument < len(stmt.Arguments) {
+		rang = stmt.Arguments[argument].LSPRange()
+	}
+	return protocol.Diagnostic{
+		Range:    rang,
+		Severity: severity,
+		Source:   diagnosticsSource,
+		Message:  fmt.Sprintf(message, fmtArgs...),
+	}
+}
+
+func checkAnimation(stmt parser.Statement, declaredCurves []string) []protocol.Diagnostic {
+	args := stmt.RawArguments()
+	if len(args) < 2 {
+		return []protocol.Diagnostic{statementDiagnostic(stmt, -1, protocol.DiagnosticSeverityError, "expected animation = NAME, ONOFF, SPEED, CURVE[, STYLE]")}
+	}
+
+	diagnostics := make([]protocol.Diagnostic, 0)
+	animation, known := parser_data.FindAnimation(args[animationArgName])
+	if !known {
+		diagnostics = append(diagnostics, statementDiagnostic(stmt, animationArgName, protocol.DiagnosticSeverityError, "unknown animation %q", args[animationArgName]))
+	}
+
+	switch args[animationArgOnOff] {
+	case "0":
+		return diagnostics
+	case "1":
+	default:
+		return append(diagnostics, statementDiagnostic(stmt, animationArgOnOff, protocol.DiagnosticSeverityError, "ONOFF must be 0 or 1, got %q", args[animationArgOnOff]))
+	}
+
+	if len(args) < 4 {
+		return append(diagnostics, statementDiagnostic(stmt, -1, protocol.DiagnosticSeverityError, "enabled animations need a SPEED and a CURVE: animation = NAME, 1, SPEED, CURVE[, STYLE]"))
+	}
+
+	if speed, err := strconv.ParseFloat(args[animationArgSpeed], 64); err != nil || speed <= 0 {
+		diagnostics = append(diagnostics, statementDiagnostic(stmt, animationArgSpeed, protocol.DiagnosticSeverityError, "SPEED must be a positive number of deciseconds, got %q", args[animationArgSpeed]))
+	}
+
+	curve := args[animationArgCurve]
+	if _, builtin := parser_data.BezierCurves[curve]; !builtin && !slices.Contains(declaredCurves, curve) {
+		diagnostics = append(diagnostics, statementDiagnostic(stmt, animationArgCurve, protocol.DiagnosticSeverityError, "unknown curve %q, declare it with bezier = %s, X0, Y0, X1, Y1", curve, curve))
+	}
+
+	if len(args) > animationArgStyle && known {
+		if message := checkAnimationStyle(animation, args[animationArgStyle]); message != "" {
+			diagnostics = append(diagnostics, statementDiagnostic(stmt, animationArgStyle, protocol.DiagnosticSeverityError, "%s", message))
+		}
+	}
+
+	if len(args) > animationArgStyle+1 {
+		diagnostics = append(diagnostics, statementDiagnostic(stmt, animationArgStyle+1, protocol.DiagnosticSeverityError, "too many arguments, expected animation = NAME, ONOFF, SPEED, CURVE[, STYLE]"))
+	}
+
+	return diagnostics
+}
+
+// checkAnimationStyle returns an error message if style is not valid for animation, or an empty string.
+func checkAnimationStyle(animation parser_data.AnimationDefinition, style string) string {
+	fields := strings.Fields(style)
+	if len(fields) == 0 {
+		return ""
+	}
+
+	available := animation.AvailableStyles()
+	if len(available) == 0 {
+		return fmt.Sprintf("animation %s does not accept a style", animation.Name)
+	}
+	if !slices.Contains(available, fields[0]) {
+		return fmt.Sprintf("unknown style %q for %s, expected one of %s", fields[0], animation.Name, strings.Join(available, ", "))
+	}
+
+	if len(fields) == 1 {
+		return ""
+	}
+	option, acceptsOption := animationStyleOptions[fields[0]]
+	if !acceptsOption || len(fields) > 2 {
+		return fmt.Sprintf("style %s does not accept %q", fields[0], strings.Join(fields[1:], " "))
+	}
+	if !option.MatchString(fields[1]) {
+		return fmt.Sprintf("invalid option %q for style %s", fields[1], fields[0])
+	}
+	return ""
+}
+
+func checkBezier(stmt parser.Statement) []protocol.Diagnostic {
+	args := stmt.RawArguments()
+	if len(args) != 5 {
+		return []protocol.Diagnostic{statementDiagnostic(stmt, -1, protocol.DiagnosticSeverityError, "expected bezier = NAME, X0, Y0, X1, Y1")}
+	}
+
+	diagnostics := make([]protocol.Diagnostic, 0)
+	if _, builtin := parser_data.BezierCurves[args[bezierArgName]]; builtin {
+		diagnostics = append(diagnostics, statementDiagnostic(stmt, bezierArgName, protocol.DiagnosticSeverityWarning, "%s is a built-in curve, redefining it changes every animation that uses it", args[bezierArgName]))
+	}
+
+	for i := bezierArgX0; i <= bezierArgY1; i++ {
+		value, err := strconv.ParseFloat(args[i], 64)
+		if err != nil {
+			diagnostics = append(diagnostics, statementDiagnostic(stmt, i, protocol.DiagnosticSeverityError, "control point coordinates must be numbers, got %q", args[i]))
+			continue
+		}
+		if (i == bezierArgX0 || i == bezierArgX1) && (value < 0 || value > 1) {
+			diagnostics = append(diagnostics, statementDiagnostic(stmt, i, protocol.DiagnosticSeverityWarning, "X coordinates of control points should be between 0 and 1, otherwise the curve goes back in time"))
+		}
+	}
+	return diagnostics
+}
+
+// animationCurveDefinitions returns the location of the bezier declarations for the curve used by an animation = ... statement.
+func animationCurveDefinitions(uri protocol.URI, stmt parser.Statement) []protocol.Location {
+	args := stmt.RawArguments()
+	if len(args) <= animationArgCurve {
+		return []protocol.Location{}
+	}
+
+	locations := make([]protocol.Location, 0)
+	for _, declaration := range findBezierDeclarations(configGraph(uri), args[animationArgCurve]) {
+		locations = append(locations, declaration.Location())
+	}
+	return locations
+}
+
+// defaultAnimation is what the root of the animation tree ("global") is set to by Hyprland when it is not configured.
+var defaultAnimation = resolvedAnimation{
+	Enabled: true,
+	Speed:   8,
+	Curve:   "default",
+}
+
+// animationSetting is an explicit animation = ... statement.
+type animationSetting struct {
+	URI       protocol.URI
+	Statement parser.Statement
+	Enabled   bool
+	// Speed is 0 when not set
+	Speed float64
+	Curve string
+	Style string
+}
+
+// animationTree holds the effective explicit setting of every animation configured in a configuration graph,
+// which is the last animation = ... statement for that animation.
+type animationTree map[string]animationSetting
+
+func buildAnimationTree(graph []configDocument) animationTree {
+	tree := make(animationTree)
+	walkConfigStatements(graph, func(doc configDocument, stmt *parser.Statement) {
+		if stmt.Keyword != "animation" {
+			return
+		}
+		args := stmt.RawArguments()
+		if len(args) < 2 {
+			return
+		}
+		setting := animationSetting{
+			URI:       doc.URI,
+			Statement: *stmt,
+			Enabled:   args[animationArgOnOff] == "1",
+		}
+		if len(args) > animationArgSpeed {
+			setting.Speed, _ = strconv.ParseFloat(args[animationArgSpeed], 64)
+		}
+		if len(args) > animationArgCurve {
+			setting.Curve = args[animationArgCurve]
+		}
+		if len(args) > animationArgStyle {
+			setting.Style = args[animationArgStyle]
+		}
+		tree[args[animationArgName]] = setting
+	})
+	return tree
+}
+
+// resolvedAnimation is the effective configuration of an animation, once values of its ancestors have been inherited.
+type resolvedAnimation struct {
+	Enabled bool
+	// Speed is in deciseconds
+	Speed float64
+	Curve string
+	Style string
+	// EnabledFrom, SpeedFrom and CurveFrom are the names of the animations the values were set on. Empty if they are Hyprland's defaults.
+	EnabledFrom string
+	SpeedFrom   string
+	CurveFrom   string
+}
+
+func (r resolvedAnimation) Duration() time.Duration {
+	return time.Duration(r.Speed * float64(100*time.Millisecond))
+}
+
+// resolve computes the effective configuration of the animation, by applying the settings of its ancestors from the root of the tree down to it.
+func (t animationTree) resolve(name string) resolvedAnimation {
+	chain := []string{name}
+	if animation, found := parser_data.FindAnimation(name); found {
+		for _, ancestor := range animation.Ancestors() {
+			chain = append([]string{ancestor.Name}, chain...)
+		}
+	}
+
+	resolved := defaultAnimation
+	for _, node := range chain {
+		setting, ok := t[node]
+		if !ok {
+			continue
+		}
+		resolved.Enabled, resolved.EnabledFrom = setting.Enabled, node
+		if !setting.Enabled {
+			continue
+		}
+		if setting.Speed > 0 {
+			resolved.Speed, resolved.SpeedFrom = setting.Speed, node
+		}
+		if setting.Curve != "" {
+			resolved.Curve, resolved.CurveFrom = setting.Curve, node
+		}
+		if setting.Style != "" {
+			resolved.Style = setting.Style
+		}
+	}
+	return resolved
+}
+
+// summary describes the resolved animation in a single line, mentioning where inherited values come from.
+func (r resolvedAnimation) summary(name string) string {
+	inheritedFrom := func(from string) string {
+		switch from {
+		case name:
+			return ""
+		case "":
+			return " (Hyprland's default)"
+		default:
+			return fmt.Sprintf(" (from %s)", from)
+		}
+	}
+
+	if !r.Enabled {
+		return "disabled" + inheritedFrom(r.EnabledFrom)
+	}
+
+	summary := fmt.Sprintf("%dms%s, curve %s%s", r.Duration().Milliseconds(), inheritedFrom(r.SpeedFrom), r.Curve, inheritedFrom(r.CurveFrom))
+	if r.Style != "" {
+		summary += ", style " + r.Style
+	}
+	return summary
+}
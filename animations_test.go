@@ -0,0 +1,86 @@
+package hyprls
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyprland-community/hyprls/parser"
+	"go.lsp.dev/protocol"
+	lspuri "go.lsp.dev/uri"
+)
+
+func TestAnimationDiagnostics(t *testing.T) {
+	directory := t.TempDir()
+	os.WriteFile(filepath.Join(directory, "hyprland.conf"), []byte("source = ./conf/*.conf\nbezier = myCurve, 0.05, 0.9, 0.1, 1.1\n"), 0644)
+	os.Mkdir(filepath.Join(directory, "conf"), 0755)
+	animationsFile := filepath.Join(directory, "conf", "animations.conf")
+	os.WriteFile(animationsFile, []byte(`animation = windows, 1, 8, myCurve, popin 80%
+animation = windowsIn, 1, 8, default, slide left
+animation = fade, 0
+animation = windws, 1, 8, default
+animation = workspaces, 1, 8, nope, slidefade 20%
+animation = borderangle, 1, 8, linear, popin
+animation = fadeIn, 1, 8, linear, slide
+`), 0644)
+
+	uri := lspuri.File(animationsFile)
+	contents, _ := os.ReadFile(animationsFile)
+	document, _ := parser.Parse(string(contents))
+	diagnostics := animationDiagnostics(uri, document)
+
+	expectedLines := []uint32{3, 4, 5, 6}
+	if len(diagnostics) != len(expectedLines) {
+		t.Fatalf("expected %d diagnostics, got %#v", len(expectedLines), diagnostics)
+	}
+	for i, diagnostic := range diagnostics {
+		if diagnostic.Range.Start.Line != expectedLines[i] {
+			t.Errorf("diagnostic %d: expected on line %d, got %#v", i, expectedLines[i], diagnostic)
+		}
+	}
+
+	stmt, argument := statementAt(document, protocol.Position{Line: 0, Character: 28})
+	if stmt == nil || argument != animationArgCurve {
+		t.Fatalf("expected cursor to be on the curve argument, got %d", argument)
+	}
+	locations := animationCurveDefinitions(uri, *stmt)
+	if len(locations) != 1 || locations[0].URI != lspuri.File(filepath.Join(directory, "hyprland.conf")) || locations[0].Range.Start.Line != 1 {
+		t.Errorf("unexpected definition for myCurve: %#v", locations)
+	}
+}
+
+func TestAnimationTreeResolve(t *testing.T) {
+	document, _ := parser.Parse(`bezier = myCurve, 0.05, 0.9, 0.1, 1.1
+animation = windows, 1, 4, myCurve, slide
+animation = windowsIn, 1, 2.5, default
+animation = fade, 0
+animation = windowsOut, 1, 3, linear
+animation = windowsOut, 1, 6, linear
+`)
+	tree := buildAnimationTree([]configDocument{{URI: "file:///hyprland.conf", Document: document}})
+
+	windowsIn := tree.resolve("windowsIn")
+	if windowsIn.Duration().Milliseconds() != 250 || windowsIn.Curve != "default" || windowsIn.Style != "slide" {
+		t.Errorf("unexpected resolution for windowsIn: %#v", windowsIn)
+	}
+
+	windowsMove := tree.resolve("windowsMove")
+	if windowsMove.Duration().Milliseconds() != 400 || windowsMove.CurveFrom != "windows" {
+		t.Errorf("unexpected resolution for windowsMove: %#v", windowsMove)
+	}
+	if summary := windowsMove.summary("windowsMove"); summary != "400ms (from windows), curve myCurve (from windows), style slide" {
+		t.Errorf("unexpected summary for windowsMove: %q", summary)
+	}
+
+	if fadeIn := tree.resolve("fadeIn"); fadeIn.Enabled {
+		t.Errorf("expected fadeIn to inherit being disabled from fade: %#v", fadeIn)
+	}
+
+	if workspaces := tree.resolve("workspaces"); workspaces.summary("workspaces") != "800ms (Hyprland's default), curve default (Hyprland's default)" {
+		t.Errorf("unexpected summary for workspaces: %q", workspaces.summary("workspaces"))
+	}
+
+	if tree["windowsOut"].Speed != 6 {
+		t.Errorf("expected last windowsOut statement to win, got %#v", tree["windowsOut"])
+	}
+}
@@ -0,0 +1,246 @@
+package hyprls
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hyprland-community/hyprls/parser"
+	parser_data "github.com/hyprland-community/hyprls/parser/data"
+)
+
+// keywordArgument is one of the comma-separated arguments of a keyword.
+type keywordArgument struct {
+	Name        string
+	Description string
+}
+
+const (
+	argumentMods        = "MODS"
+	argumentKey         = "KEY"
+	argumentDescription = "DESCRIPTION"
+	argumentDispatcher  = "DISPATCHER"
+	argumentParams      = "PARAMS"
+)
+
+// keywordArguments are the arguments of keywords, in order. The last argument of a keyword takes the rest of the line. See keywordArgumentsOf for binds.
+var keywordArguments = map[string][]keywordArgument{
+	"monitor": {
+		{"NAME", "the output, such as DP-1, or desc: followed by its description. Empty for the rule used by other monitors"},
+		{"RESOLUTION", "WIDTHxHEIGHT@RATE, preferred, highres, highrr, maxwidth, a modeline, or disable"},
+		{"POSITION", "XxY in the layout, or auto, auto-right, auto-left, auto-up, auto-down"},
+		{"SCALE", "the scale, or auto"},
+		{"OPTIONS", "additional settings, such as transform, 1 or vrr, 1"},
+	},
+	"workspace": {
+		{"WORKSPACE", "the workspace: an ID, name:NAME, special:NAME, or a selector such as r[1-5]"},
+		{"RULES", "the rules of the workspace, such as monitor:DP-1, default:true or gapsin:0"},
+	},
+	"animation": {
+		{"NAME", "the animation, such as windows or workspacesIn"},
+		{"ONOFF", "1 to enable the animation, 0 to disable it"},
+		{"SPEED", "the duration of the animation, in deciseconds"},
+		{"CURVE", "a curve declared with bezier = ..., or default"},
+		{"STYLE", "the style of the animation, optionally followed by its parameter. Optional"},
+	},
+	"bezier": {
+		{"NAME", "the name of the curve, to use in animation = ..."},
+		{"X0", "the X coordinate of the first control point"},
+		{"Y0", "the Y coordinate of the first control point"},
+		{"X1", "the X coordinate of the second control point"},
+		{"Y1", "the Y coordinate of the second control point"},
+	},
+	"windowrule": {
+		{"RULE", "what to do with the matching windows"},
+		{"WINDOW", "the fields windows must match, such as class:^(kitty)$"},
+	},
+	"windowrulev2": {
+		{"RULE", "what to do with the matching windows"},
+		{"WINDOW", "the fields windows must match, such as class:^(kitty)$"},
+	},
+	"layerrule": {
+		{"RULE", "what to do with the matching layers"},
+		{"NAMESPACE", "a regular expression the namespace of layers must match, or address:0x..."},
+	},
+	"exec":      {{"COMMAND", "the shell command, optionally preceded by [rules]"}},
+	"exec-once": {{"COMMAND", "the shell command, optionally preceded by [rules]"}},
+	"source":    {{"PATH", "the configuration files to include. ~ and globs are expanded"}},
+	"env": {
+		{"NAME", "the environment variable"},
+		{"VALUE", "its value"},
+	},
+	"submap": {{"NAME", "the submap to enter, or reset to go back to the global one"}},
+	"unbind": {
+		{argumentMods, "the mod keys of the keybinding to remove"},
+		{argumentKey, "the key of the keybinding to remove"},
+	},
+}
+
+// keywordArgumentsOf returns the arguments of a keyword, which depend on the flags of binds.
+func keywordArgumentsOf(keyword string) []keywordArgument {
+	if arguments, ok := keywordArguments[keyword]; ok {
+		return arguments
+	}
+	flags, isBind := strings.CutPrefix(keyword, "bind")
+	if _, known := parser_data.FindKeyword(keyword); !isBind || !known {
+		return nil
+	}
+	arguments := []keywordArgument{
+		{argumentMods, "the mod keys to hold, such as SUPER SHIFT. Empty for none"},
+		{argumentKey, "the key to press"},
+	}
+	if strings.ContainsRune(flags, 'd') {
+		arguments = append(arguments, keywordArgument{argumentDescription, "what the keybinding does"})
+	}
+	if strings.ContainsRune(flags, 'm') {
+		return append(arguments, keywordArgument{argumentDispatcher, "movewindow or resizewindow"})
+	}
+	return append(arguments,
+		keywordArgument{argumentDispatcher, "what to do when the keys are pressed"},
+		keywordArgument{argumentParams, "the parameters of the dispatcher"},
+	)
+}
+
+// argumentOf returns the argument at index, the last one if the statement has more arguments than the keyword.
+func argumentOf(arguments []keywordArgument, index int) keywordArgument {
+	return arguments[min(index, len(arguments)-1)]
+}
+
+// argumentHoverContents documents the argument at index of stmt. value is the argument with its variables expanded.
+func argumentHoverContents(stmt parser.Statement, index int, value string) string {
+	arguments := keywordArgumentsOf(string(stmt.Keyword))
+	if len(arguments) == 0 {
+		return ""
+	}
+	argument := argumentOf(arguments, index)
+	switch argument.Name {
+	case argumentMods:
+		return modsHoverContents(value)
+	case argumentKey:
+		return keyHoverContents(value)
+	case argumentDispatcher:
+		if contents := dispatcherHoverContents(value); contents != "" {
+			return contents
+		}
+	case argumentParams:
+		dispatcher := slices.IndexFunc(arguments, func(a keywordArgument) bool { return a.Name == argumentDispatcher })
+		if raw := stmt.RawArguments(); dispatcher < len(raw) {
+			if definition, found := parser_data.FindDispatcher(raw[dispatcher]); found {
+				return fmt.Sprintf("### Parameters of %s\n\n%s\n", definition.Name, definition.Params)
+			}
+		}
+	}
+	if stmt.Keyword == "animation" && index == animationArgName {
+		if contents := animationHoverContents(value); contents != "" {
+			return contents
+		}
+	}
+	return fmt.Sprintf("### %s\n\nArgument %d of `%s`: %s\n", argument.Name, index+1, stmt.Keyword, argument.Description)
+}
+
+var modKeyDescriptions = map[parser.ModKey]string{
+	parser.ModSuper:   "the Super key, also called Windows or Logo key (Mod4)",
+	parser.ModControl: "the Control key",
+	parser.ModAlt:     "the Alt key (Mod1)",
+	parser.ModShift:   "the Shift key",
+	parser.ModCaps:    "Caps Lock",
+	parser.Mod2:       "Mod2, Num Lock with most layouts",
+	parser.Mod3:       "Mod3, unassigned with most layouts",
+	parser.Mod5:       "Mod5, AltGr with most layouts",
+}
+
+func modsHoverContents(raw string) string {
+	mods := normalizeModMask(raw)
+	if len(mods) == 0 {
+		return "### No mod keys\n\nThe keybinding is triggered by the key alone.\n"
+	}
+	lines := make([]string, 0, len(mods))
+	for _, mod := range modKeyDisplayNames {
+		if slices.Contains(mods, mod.Key) {
+			lines = append(lines, fmt.Sprintf("- `%s`: %s", mod.Name, modKeyDescriptions[mod.Key]))
+		}
+	}
+	return fmt.Sprintf("### Mod keys\n\n%s\n", strings.Join(lines, "\n"))
+}
+
+// mouseButtons are the names of the mouse:NNN keys, from linux/input-event-codes.h.
+var mouseButtons = map[int]string{
+	272: "left button",
+	273: "right button",
+	274: "middle button",
+	275: "side button (back)",
+	276: "extra button (forward)",
+}
+
+var mouseButtonPattern = regexp.MustCompile(`^mouse:(\d+)$`)
+
+func keyHoverContents(key string) string {
+	if match := keycodePattern.FindStringSubmatch(key); match != nil {
+		code, _ := strconv.Atoi(match[1])
+		if keysym, ok := usKeycodeKeysyms[code]; ok {
+			return fmt.Sprintf("### Keycode %d\n\nProduces `%s` with a US keyboard layout.\n", code, keysym)
+		}
+		return fmt.Sprintf("### Keycode %d\n", code)
+	}
+	if match := mouseButtonPattern.FindStringSubmatch(key); match != nil {
+		code, _ := strconv.Atoi(match[1])
+		if button, ok := mouseButtons[code]; ok {
+			return fmt.Sprintf("### Mouse %s\n", button)
+		}
+		return fmt.Sprintf("### Mouse button %d\n", code)
+	}
+	if description, isWheel := mouseWheelDescription(key); isWheel {
+		return fmt.Sprintf("### Mouse wheel\n\nThe mouse wheel, %s.\n", description)
+	}
+	if match := switchKeyPattern.FindStringSubmatch(key); match != nil {
+		change := map[string]string{"on": "turning on", "off": "turning off", "": "toggling"}[match[1]]
+		return fmt.Sprintf("### Switch %s\n\nThe `%s` switch, such as a laptop lid, %s.\n", match[2], match[2], change)
+	}
+	switch key {
+	case "catchall":
+		return "### Any key\n\nEvery key that no other keybinding of the submap handles.\n"
+	case "":
+		return ""
+	}
+	if keysym, found := parser_data.FindKeysym(key); found {
+		return fmt.Sprintf("### Key %s\n\nThe key that produces the `%s` XKB keysym (%#x).\n", key, keysym.Name, keysym.Value)
+	}
+	if code, err := strconv.Atoi(key); err == nil && code > 9 {
+		return keyHoverContents(fmt.Sprintf("code:%d", code))
+	}
+	if isKnownKey(key) {
+		return fmt.Sprintf("### Key %s\n\nThe key that produces the `%s` XKB keysym.\n", key, key)
+	}
+	if suggestion, found := closestName(key, keyNames()); found {
+		return fmt.Sprintf("### Unknown key %s\n\nNot an XKB keysym, did you mean `%s`?\n", key, suggestion)
+	}
+	return fmt.Sprintf("### Unknown key %s\n\nNot an XKB keysym, the keybinding will never be triggered.\n", key)
+}
+
+func dispatcherHoverContents(name string) string {
+	dispatcher, found := parser_data.FindDispatcher(name)
+	if !found {
+		return ""
+	}
+	return fmt.Sprintf("### %s [[docs]](https://wiki.hyprland.org/Configuring/Dispatchers/#list-of-dispatchers)\n\n%s\n\n- Parameters: %s\n", dispatcher.Name, dispatcher.Description, dispatcher.Params)
+}
+
+func animationHoverContents(name string) string {
+	animation, found := parser_data.FindAnimation(name)
+	if !found {
+		return ""
+	}
+	contents := fmt.Sprintf("### %s animation [[docs]](https://wiki.hyprland.org/Configuring/Animations/#animation-tree)\n\n", animation.Name)
+	if animation.Description != "" {
+		contents += animation.Description + "\n\n"
+	}
+	if animation.Parent != "" {
+		contents += fmt.Sprintf("- Inherits from: %s\n", animation.Parent)
+	}
+	if styles := animation.AvailableStyles(); len(styles) > 0 {
+		contents += fmt.Sprintf("- Styles: %s\n", strings.Join(styles, ", "))
+	}
+	return contents
+}
@@ -0,0 +1,119 @@
+package hyprls
+
+import (
+	"encoding/base64"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/hyprland-community/hyprls/parser"
+	parser_data "github.com/hyprland-community/hyprls/parser/data"
+	"go.lsp.dev/protocol"
+)
+
+// cubicBezier is an easing curve going from (0, 0) to (1, 1), defined by its two control points X0, Y0, X1, Y1.
+type cubicBezier [4]float64
+
+// at returns the point of the curve at parameter s, between 0 and 1.
+func (b cubicBezier) at(s float64) (x, y float64) {
+	coordinate := func(p1, p2 float64) float64 {
+		return 3*math.Pow(1-s, 2)*s*p1 + 3*(1-s)*math.Pow(s, 2)*p2 + math.Pow(s, 3)
+	}
+	return coordinate(b[0], b[2]), coordinate(b[1], b[3])
+}
+
+// progress returns the animation progress (the y coordinate) after a fraction t of the animation's duration has elapsed (the x coordinate).
+func (b cubicBezier) progress(t float64) float64 {
+	low, high := 0.0, 1.0
+	for range 64 {
+		middle := (low + high) / 2
+		if x, _ := b.at(middle); x < t {
+			low = middle
+		} else {
+			high = middle
+		}
+	}
+	_, y := b.at((low + high) / 2)
+	return y
+}
+
+const bezierPreviewSize = 160
+const bezierPreviewPadding = 12
+
+// svg renders the curve along with its control points. Overshooting curves are given more vertical room.
+func (b cubicBezier) svg() string {
+	minY, maxY := math.Min(0, math.Min(b[1], b[3])), math.Max(1, math.Max(b[1], b[3]))
+	width := float64(bezierPreviewSize + 2*bezierPreviewPadding)
+	height := (maxY-minY)*bezierPreviewSize + 2*bezierPreviewPadding
+	px := func(x float64) float64 { return bezierPreviewPadding + x*bezierPreviewSize }
+	py := func(y float64) float64 { return bezierPreviewPadding + (maxY-y)*bezierPreviewSize }
+
+	var svg strings.Builder
+	fmt.Fprintf(&svg, `<svg xmlns="http://www.w3.org/2000/svg" width="%.0f" height="%.0f" viewBox="0 0 %.0f %.0f">`, width, height, width, height)
+	fmt.Fprintf(&svg, `<rect width="%.0f" height="%.0f" fill="#1e1e2e"/>`, width, height)
+	fmt.Fprintf(&svg, `<polyline points="%.1f,%.1f %.1f,%.1f %.1f,%.1f" fill="none" stroke="#45475a"/>`, px(0), py(1), px(0), py(0), px(1), py(0))
+	fmt.Fprintf(&svg, `<line x1="%.1f" y1="%.1f" x2="%.1f" y2="%.1f" stroke="#6c7086" stroke-dasharray="3"/>`, px(0), py(0), px(b[0]), py(b[1]))
+	fmt.Fprintf(&svg, `<line x1="%.1f" y1="%.1f" x2="%.1f" y2="%.1f" stroke="#6c7086" stroke-dasharray="3"/>`, px(1), py(1), px(b[2]), py(b[3]))
+	fmt.Fprintf(&svg, `<path d="M %.1f,%.1f C %.1f,%.1f %.1f,%.1f %.1f,%.1f" fill="none" stroke="#89b4fa" stroke-width="2.5"/>`, px(0), py(0), px(b[0]), py(b[1]), px(b[2]), py(b[3]), px(1), py(1))
+	fmt.Fprintf(&svg, `<circle cx="%.1f" cy="%.1f" r="4" fill="#f38ba8"/>`, px(b[0]), py(b[1]))
+	fmt.Fprintf(&svg, `<circle cx="%.1f" cy="%.1f" r="4" fill="#f38ba8"/>`, px(b[2]), py(b[3]))
+	svg.WriteString(`</svg>`)
+	return svg.String()
+}
+
+func (b cubicBezier) dataURI() string {
+	return "data:image/svg+xml;base64," + base64.StdEncoding.EncodeToString([]byte(b.svg()))
+}
+
+func bezierHover(name string, curve cubicBezier, rang protocol.Range) *protocol.Hover {
+	samples := make([]string, 0, 3)
+	for _, t := range []float64{0.25, 0.5, 0.75} {
+		samples = append(samples, fmt.Sprintf("| %.2f | %.3f |", t, curve.progress(t)))
+	}
+
+	return &protocol.Hover{
+		Contents: protocol.MarkupContent{
+			Kind: protocol.Markdown,
+			Value: fmt.Sprintf("### %s: bezier(%g, %g, %g, %g) [[docs]](https://wiki.hyprland.org/Configuring/Animations/#curves)\n\n![%s](%s)\n\n| time | progress |\n| --- | --- |\n%s",
+				name, curve[0], curve[1], curve[2], curve[3], name, curve.dataURI(), strings.Join(samples, "\n")),
+		},
+		Range: &rang,
+	}
+}
+
+// bezierHoverAt shows the curve of a bezier = ... statement, or the curve used by an animation = ... statement, if the cursor is on it.
+func bezierHoverAt(uri protocol.URI, stmt parser.Statement, argument int) *protocol.Hover {
+	args := stmt.RawArguments()
+	switch {
+	case stmt.Keyword == "bezier" && argument >= 0:
+		if len(args) != 5 {
+			return nil
+		}
+		var curve cubicBezier
+		for i := range curve {
+			point, err := strconv.ParseFloat(args[i+1], 64)
+			if err != nil {
+				return nil
+			}
+			curve[i] = point
+		}
+		return bezierHover(args[bezierArgName], curve, protocol.Range{
+			Start: stmt.Arguments[0].Start.LSP(),
+			End:   stmt.Arguments[len(stmt.Arguments)-1].End.LSP(),
+		})
+
+	case stmt.Keyword == "animation" && argument == animationArgCurve:
+		name := args[animationArgCurve]
+		if builtin, ok := parser_data.BezierCurves[name]; ok {
+			return bezierHover(name, builtin, stmt.Arguments[argument].LSPRange())
+		}
+		declarations := findBezierDeclarations(configGraph(uri), name)
+		if len(declarations) == 0 {
+			return nil
+		}
+		// Later declarations override earlier ones
+		return bezierHover(name, declarations[len(declarations)-1].Points, stmt.Arguments[argument].LSPRange())
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package hyprls
+
+import (
+	"encoding/xml"
+	"math"
+	"testing"
+)
+
+func TestBezierProgress(t *testing.T) {
+	linear := cubicBezier{0, 0, 1, 1}
+	for _, x := range []float64{0.25, 0.5, 0.75} {
+		if y := linear.progress(x); math.Abs(y-x) > 1e-6 {
+			t.Errorf("linear curve at %.2f: expected %.2f, got %f", x, x, y)
+		}
+	}
+
+	easeOutQuint := cubicBezier{0.23, 1, 0.32, 1}
+	if y := easeOutQuint.progress(0.5); math.Abs(y-0.966) > 1e-3 {
+		t.Errorf("easeOutQuint at 0.5: expected 0.966, got %f", y)
+	}
+}
+
+func TestBezierSVG(t *testing.T) {
+	overshoot := cubicBezier{0.05, 0.9, 0.1, 1.1}
+	var svg struct {
+		XMLName xml.Name `xml:"svg"`
+		Height  float64  `xml:"height,attr"`
+	}
+	if err := xml.Unmarshal([]byte(overshoot.svg()), &svg); err != nil {
+		t.Fatalf("invalid svg: %s", err)
+	}
+	if svg.Height <= bezierPreviewSize+2*bezierPreviewPadding {
+		t.Errorf("expected overshooting curve to get more vertical room, got height %f", svg.Height)
+	}
+}
@@ -0,0 +1,80 @@
+package hyprls
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/hyprland-community/hyprls/parser"
+	parser_data "github.com/hyprland-community/hyprls/parser/data"
+	"go.lsp.dev/protocol"
+)
+
+// outOfBoundsProblems reports values of numeric options that are outside of their documented bounds, such as active_opacity = 1.5.
+func outOfBoundsProblems(uri protocol.URI, document parser.Section, lines []string) []configProblem {
+	problems := make([]configProblem, 0)
+	schema := schemaOf(uri)
+	walkAssignmentsWithPath(document, []string{}, func(path []string, assignment parser.Assignment) {
+		if len(path) < 2 || isFreeformPath(path) {
+			return
+		}
+		variable := schema.FindVariableDefinitionInSection(path[len(path)-2], path[len(path)-1])
+		if variable == nil || variable.Bounds == nil {
+			return
+		}
+
+		text, rang := valueRange(lines, assignment.Position.Line)
+		// Bounds are checked on every number, some options documented as int take floats
+		value, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return
+		}
+		if isIntegerOption(*variable) && value != math.Trunc(value) {
+			replacement := strconv.FormatFloat(math.Min(math.Max(math.Round(value), math.Ceil(variable.Bounds.Min)), math.Floor(variable.Bounds.Max)), 'f', -1, 64)
+			problems = append(problems, configProblem{
+				Diagnostic: protocol.Diagnostic{
+					Range:    rang,
+					Severity: protocol.DiagnosticSeverityWarning,
+					Source:   diagnosticsSource,
+					Message:  fmt.Sprintf("%s is not an integer, %s expects an integer from %s", text, strings.Join(path, ":"), variable.Bounds),
+				},
+				Fixes: []quickFix{{
+					Title: fmt.Sprintf("Replace with %s", replacement),
+					Edits: []protocol.TextEdit{{Range: rang, NewText: replacement}},
+				}},
+			})
+			// The replacement is within bounds already
+			return
+		}
+		if variable.Bounds.Contains(value) {
+			return
+		}
+
+		bound := variable.Bounds.Min
+		if value > variable.Bounds.Max {
+			bound = variable.Bounds.Max
+		}
+		replacement := strconv.FormatFloat(bound, 'f', -1, 64)
+		problems = append(problems, configProblem{
+			Diagnostic: protocol.Diagnostic{
+				Range:    rang,
+				Severity: protocol.DiagnosticSeverityWarning,
+				Source:   diagnosticsSource,
+				Message:  fmt.Sprintf("%s is out of bounds for %s, expected a value from %s", text, strings.Join(path, ":"), variable.Bounds),
+			},
+			Fixes: []quickFix{{
+				Title: fmt.Sprintf("Replace with %s", replacement),
+				Edits: []protocol.TextEdit{{Range: rang, NewText: replacement}},
+			}},
+		})
+	})
+	return problems
+}
+
+// isIntegerOption tells whether the option only takes integers: its type is int and its default is an integer,
+// since some options the wiki documents as int default to a float.
+func isIntegerOption(variable parser_data.VariableDefinition) bool {
+	_, err := strconv.Atoi(variable.Default)
+	return variable.Type == "int" && err == nil
+}
@@ -0,0 +1,199 @@
+package hyprls
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"go.lsp.dev/protocol"
+	lspuri "go.lsp.dev/uri"
+)
+
+// CheatsheetFormat is an output format of ExportKeybindings.
+type CheatsheetFormat string
+
+const (
+	CheatsheetMarkdown CheatsheetFormat = "markdown"
+	CheatsheetJSON     CheatsheetFormat = "json"
+	CheatsheetHTML     CheatsheetFormat = "html"
+)
+
+var CheatsheetFormats = []CheatsheetFormat{CheatsheetMarkdown, CheatsheetJSON, CheatsheetHTML}
+
+// cheatsheet lists the keybindings of a configuration graph, grouped by submap.
+type cheatsheet struct {
+	Submaps []cheatsheetSubmap `json:"submaps"`
+}
+
+type cheatsheetSubmap struct {
+	// Name is empty for the global submap
+	Name        string                 `json:"name"`
+	Keybindings []cheatsheetKeybinding `json:"keybindings"`
+}
+
+type cheatsheetKeybinding struct {
+	Keys        string `json:"keys"`
+	Flags       string `json:"flags,omitempty"`
+	Description string `json:"description,omitempty"`
+	Dispatcher  string `json:"dispatcher"`
+	Params      string `json:"params,omitempty"`
+	File        string `json:"file"`
+	Line        int    `json:"line"`
+}
+
+func (s cheatsheetSubmap) Title() string {
+	if s.Name == "" {
+		return "Global"
+	}
+	return "Submap " + s.Name
+}
+
+// Action is the dispatcher along with its parameters, as written in the configuration.
+func (k cheatsheetKeybinding) Action() string {
+	if k.Params == "" {
+		return k.Dispatcher
+	}
+	return k.Dispatcher + " " + k.Params
+}
+
+// KeyParts splits the keys to press into the mod keys and the key, for display in <kbd> elements.
+func (k cheatsheetKeybinding) KeyParts() []string {
+	mods, key, found := strings.Cut(k.Keys, " + ")
+	if !found {
+		return []string{k.Keys}
+	}
+	return append(strings.Fields(mods), key)
+}
+
+// buildCheatsheet groups the active keybindings of the graph by submap. The global submap comes first, then submaps in the order they are declared in.
+func buildCheatsheet(graph []configDocument) cheatsheet {
+	sheet := cheatsheet{Submaps: []cheatsheetSubmap{{Name: "", Keybindings: make([]cheatsheetKeybinding, 0)}}}
+	submaps := map[string]int{"": 0}
+
+	for _, bind := range indexKeybindings(graph).Active {
+		i, ok := submaps[bind.Submap]
+		if !ok {
+			i = len(sheet.Submaps)
+			submaps[bind.Submap] = i
+			sheet.Submaps = append(sheet.Submaps, cheatsheetSubmap{Name: bind.Submap, Keybindings: make([]cheatsheetKeybinding, 0)})
+		}
+		sheet.Submaps[i].Keybindings = append(sheet.Submaps[i].Keybindings, cheatsheetKeybinding{
+			Keys:        bind.Chord(),
+			Flags:       bind.Flags,
+			Description: bind.Description,
+			Dispatcher:  bind.Dispatcher,
+			Params:      bind.Params,
+			File:        bind.URI.Filename(),
+			Line:        int(bind.Statement.Position.Line) + 1,
+		})
+	}
+
+	if len(sheet.Submaps[0].Keybindings) == 0 && len(sheet.Submaps) > 1 {
+		sheet.Submaps = sheet.Submaps[1:]
+	}
+	return sheet
+}
+
+// ExportKeybindings writes a cheatsheet of every keybinding of the configuration that configFile is part of.
+func ExportKeybindings(w io.Writer, configFile string, format CheatsheetFormat) error {
+	absolute, err := filepath.Abs(configFile)
+	if err != nil {
+		return fmt.Errorf("while resolving %s: %w", configFile, err)
+	}
+	uri := lspuri.File(absolute)
+	if _, err := parseGraphFile(uri); err != nil {
+		return fmt.Errorf("while reading %s: %w", configFile, err)
+	}
+
+	return buildCheatsheet(configGraph(uri)).write(w, format)
+}
+
+func (sheet cheatsheet) write(w io.Writer, format CheatsheetFormat) error {
+	switch format {
+	case CheatsheetMarkdown:
+		_, err := io.WriteString(w, sheet.markdown())
+		return err
+	case CheatsheetJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(sheet)
+	case CheatsheetHTML:
+		return cheatsheetTemplate.Execute(w, sheet)
+	}
+	return fmt.Errorf("unknown format %q, expected one of %v", format, CheatsheetFormats)
+}
+
+func (sheet cheatsheet) markdown() string {
+	cell := strings.NewReplacer("|", `\|`, "\n", " ")
+	var out strings.Builder
+	out.WriteString("# Keybindings\n")
+	for _, submap := range sheet.Submaps {
+		fmt.Fprintf(&out, "\n## %s\n\n| Keys | Description | Action |\n| --- | --- | --- |\n", submap.Title())
+		for _, bind := range submap.Keybindings {
+			fmt.Fprintf(&out, "| `%s` | %s | `%s` |\n", cell.Replace(bind.Keys), cell.Replace(bind.Description), cell.Replace(bind.Action()))
+		}
+	}
+	return out.String()
+}
+
+var cheatsheetTemplate = template.Must(template.New("cheatsheet").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Keybindings</title>
+<style>
+body { font-family: system-ui, sans-serif; margin: 2rem auto; max-width: 60rem; color: #1e1e2e; }
+h2 { break-after: avoid; margin-top: 2rem; }
+table { border-collapse: collapse; width: 100%; break-inside: auto; }
+tr { break-inside: avoid; }
+th, td { border-bottom: 1px solid #ccd0da; padding: 0.4rem 0.6rem; text-align: left; vertical-align: top; }
+kbd { border: 1px solid #9ca0b0; border-bottom-width: 2px; border-radius: 4px; padding: 0 0.3rem; font-size: 0.9em; white-space: nowrap; }
+code { color: #5c5f77; }
+</style>
+</head>
+<body>
+<h1>Keybindings</h1>
+{{- range .Submaps }}
+<h2>{{ .Title }}</h2>
+<table>
+<thead><tr><th>Keys</th><th>Description</th><th>Action</th></tr></thead>
+<tbody>
+{{- range .Keybindings }}
+<tr><td>{{ range $i, $part := .KeyParts }}{{ if $i }} + {{ end }}<kbd>{{ $part }}</kbd>{{ end }}</td><td>{{ .Description }}</td><td><code>{{ .Action }}</code></td></tr>
+{{- end }}
+</tbody>
+</table>
+{{- end }}
+</body>
+</html>
+`))
+
+// exportKeybindingsCommand is the workspace/executeCommand command that returns the cheatsheet of the configuration a file is part of.
+// Its arguments are the file's URI and optionally the format, markdown by default.
+const exportKeybindingsCommand = "hyprls.exportKeybindings"
+
+func exportKeybindingsFromCommand(arguments []any) (string, error) {
+	if len(arguments) < 1 {
+		return "", fmt.Errorf("%s expects a document URI as its first argument", exportKeybindingsCommand)
+	}
+	uri, ok := arguments[0].(string)
+	if !ok {
+		return "", fmt.Errorf("%s expects a document URI as its first argument, got %v", exportKeybindingsCommand, arguments[0])
+	}
+	format := CheatsheetMarkdown
+	if len(arguments) > 1 {
+		if f, ok := arguments[1].(string); ok {
+			format = CheatsheetFormat(f)
+		}
+	}
+
+	var out bytes.Buffer
+	if err := buildCheatsheet(configGraph(protocol.DocumentURI(uri))).write(&out, format); err != nil {
+		return "", err
+	}
+	return out.String(), nil
+}
@@ -1,17 +1,30 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
 	"path/filepath"
 
 	hyprls "github.com/hyprland-community/hyprls"
+	parser_data "github.com/hyprland-community/hyprls/parser/data"
 	"go.uber.org/zap"
 )
 
 var OutputServerLogs string
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "binds":
+			binds(os.Args[2:])
+			return
+		case "migrate":
+			migrate(os.Args[2:])
+			return
+		}
+	}
+
 	var logconf zap.Config
 
 	if os.Getenv("HYPRLS_DEBUG") != "" {
@@ -36,3 +49,76 @@ func main() {
 		hyprls.StartServer(logger, "")
 	}
 }
+
+// binds exports a cheatsheet of the keybindings of a configuration: hyprls binds [-format markdown|json|html] [-o file] [hyprland.conf]
+func binds(args []string) {
+	flags := flag.NewFlagSet("binds", flag.ExitOnError)
+	format := flags.String("format", string(hyprls.CheatsheetMarkdown), fmt.Sprintf("output format, one of %v", hyprls.CheatsheetFormats))
+	output := flags.String("o", "", "write to this file instead of stdout")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: hyprls binds [flags] [config file, defaults to ~/.config/hypr/hyprland.conf]")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+
+	configFile := configFileArgument(flags)
+
+	out := os.Stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "while creating %s: %s\n", *output, err)
+			os.Exit(1)
+		}
+		out = file
+	}
+
+	err := hyprls.ExportKeybindings(out, configFile, hyprls.CheatsheetFormat(*format))
+	// os.Exit doesn't run deferred calls, close the file before exiting
+	if out != os.Stdout {
+		if closeErr := out.Close(); err == nil && closeErr != nil {
+			err = fmt.Errorf("while writing %s: %w", *output, closeErr)
+		}
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// migrate rewrites a configuration for a newer Hyprland version: hyprls migrate -from 0.45 [-to 0.55] [-w] [hyprland.conf]
+func migrate(args []string) {
+	flags := flag.NewFlagSet("migrate", flag.ExitOnError)
+	from := flags.String("from", "", "Hyprland version the configuration was written for (required)")
+	to := flags.String("to", parser_data.HyprlandVersion, "Hyprland version to migrate the configuration to")
+	write := flags.Bool("w", false, "rewrite files instead of only listing the changes")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: hyprls migrate -from VERSION [flags] [config file, defaults to ~/.config/hypr/hyprland.conf]")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+
+	if *from == "" {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	if err := hyprls.MigrateConfig(os.Stdout, configFileArgument(flags), *from, *to, *write); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// configFileArgument returns the config file given as the first positional argument, or the default Hyprland config file.
+func configFileArgument(flags *flag.FlagSet) string {
+	if flags.Arg(0) != "" {
+		return flags.Arg(0)
+	}
+
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "while finding configuration directory: %s\n", err)
+		os.Exit(1)
+	}
+	return filepath.Join(configDir, "hypr", "hyprland.conf")
+}
@@ -0,0 +1,385 @@
+package hyprls
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hyprland-community/hyprls/parser"
+	"go.lsp.dev/protocol"
+)
+
+// refactoring computes the refactoring code actions available for the selected range.
+type refactoring func(uri protocol.URI, document parser.Section, lines []string, selection protocol.Range) []protocol.CodeAction
+
+var refactorings = []refactoring{
+	extractLiteralActions,
+	inlineVariableActions,
+	nestAssignmentActions,
+	flattenSectionActions,
+}
+
+var codeActionKinds = []protocol.CodeActionKind{
+	protocol.QuickFix,
+	protocol.RefactorExtract,
+	protocol.RefactorInline,
+	protocol.RefactorRewrite,
+}
+
+func (h Handler) CodeAction(ctx context.Context, params *protocol.CodeActionParams) ([]protocol.CodeAction, error) {
+	uri := params.TextDocument.URI
+	if isFileIgnored(uri) {
+		return nil, nil
+	}
+	document, err := parse(uri)
+	if err != nil {
+		return nil, nil
+	}
+	contents, err := file(uri)
+	if err != nil {
+		return nil, nil
+	}
+	lines := strings.Split(contents, "\n")
+
+	actions := make([]protocol.CodeAction, 0)
+	for _, problem := range configProblems(uri, document) {
+		if !rangesOverlap(problem.Diagnostic.Range, params.Range) {
+			continue
+		}
+		for i, fix := range problem.Fixes {
+			actions = append(actions, protocol.CodeAction{
+				Title:       fix.Title,
+				Kind:        protocol.QuickFix,
+				Diagnostics: []protocol.Diagnostic{problem.Diagnostic},
+				IsPreferred: i == 0,
+				Edit:        documentEdit(uri, fix.Edits...),
+			})
+		}
+	}
+	for _, refactor := range refactorings {
+		actions = append(actions, refactor(uri, document, lines, params.Range)...)
+	}
+
+	return slices.DeleteFunc(actions, func(action protocol.CodeAction) bool {
+		return !codeActionKindRequested(action.Kind, params.Context.Only)
+	}), nil
+}
+
+// codeActionKindRequested tells whether kind matches one of the kinds the client asked for, such as refactor for refactor.extract.
+func codeActionKindRequested(kind protocol.CodeActionKind, only []protocol.CodeActionKind) bool {
+	if len(only) == 0 {
+		return true
+	}
+	for _, requested := range only {
+		if kind == requested || strings.HasPrefix(string(kind), string(requested)+".") {
+			return true
+		}
+	}
+	return false
+}
+
+func documentEdit(uri protocol.URI, edits ...protocol.TextEdit) *protocol.WorkspaceEdit {
+	return &protocol.WorkspaceEdit{
+		Changes: map[protocol.DocumentURI][]protocol.TextEdit{uri: edits},
+	}
+}
+
+// valueRange returns the value of an assignment line, without its comment, and its range.
+func valueRange(lines []string, lineNumber int) (string, protocol.Range) {
+	line, _, _ := strings.Cut(lines[lineNumber], "#")
+	equals := strings.Index(line, "=")
+	value := strings.TrimSpace(line[equals+1:])
+	start := equals + 1 + strings.Index(line[equals+1:], value)
+	return value, protocol.Range{
+		Start: protocol.Position{Line: uint32(lineNumber), Character: uint32(start)},
+		End:   protocol.Position{Line: uint32(lineNumber), Character: uint32(start + len(value))},
+	}
+}
+
+// literal is a value written in the document: the value of an assignment or an argument of a statement.
+type literal struct {
+	Text  string
+	Range protocol.Range
+	// Name is the option or keyword the literal is a value of
+	Name string
+}
+
+func documentLiterals(document parser.Section, lines []string) []literal {
+	literals := make([]literal, 0)
+	walkAssignmentsWithPath(document, []string{}, func(path []string, assignment parser.Assignment) {
+		text, rang := valueRange(lines, assignment.Position.Line)
+		literals = append(literals, literal{Text: text, Range: rang, Name: path[len(path)-1]})
+	})
+	document.WalkStatements(func(stmt *parser.Statement) {
+		for i, arg := range stmt.RawArguments() {
+			literals = append(literals, literal{Text: arg, Range: stmt.Arguments[i].LSPRange(), Name: string(stmt.Keyword)})
+		}
+	})
+	return slices.DeleteFunc(literals, func(l literal) bool {
+		return l.Text == "" || strings.Contains(l.Text, "$")
+	})
+}
+
+var invalidVariableNameCharacters = regexp.MustCompile(`[^A-Za-z0-9_]+`)
+
+// newVariableName derives a variable name from the option a value is extracted from, such as active_border for col.active_border.
+func newVariableName(option string, variables parser.Variables) string {
+	base := invalidVariableNameCharacters.ReplaceAllString(option[strings.LastIndex(option, ".")+1:], "_")
+	if base == "" {
+		base = "value"
+	}
+	name := base
+	for i := 2; ; i++ {
+		if _, taken := variables.Lookup(name); !taken {
+			break
+		}
+		name = fmt.Sprintf("%s%d", base, i)
+	}
+	return name
+}
+
+// topLevelLine returns the line of the top-level section that contains line, or line itself if it is not in a section.
+func topLevelLine(document parser.Section, line int) int {
+	for _, section := range document.Subsections {
+		if section.Start.Line <= line && line <= section.End.Line {
+			return section.Start.Line
+		}
+	}
+	return line
+}
+
+// extractLiteralActions offers to declare a variable for a value that is repeated in the document, and use it everywhere.
+func extractLiteralActions(uri protocol.URI, document parser.Section, lines []string, selection protocol.Range) []protocol.CodeAction {
+	literals := documentLiterals(document, lines)
+	index := slices.IndexFunc(literals, func(l literal) bool { return within(l.Range, selection.Start) })
+	if index < 0 {
+		return []protocol.CodeAction{}
+	}
+	selected := literals[index]
+
+	occurrences := slices.DeleteFunc(literals, func(l literal) bool { return l.Text != selected.Text })
+	if len(occurrences) < 2 {
+		return []protocol.CodeAction{}
+	}
+
+	name := newVariableName(selected.Name, graphVariables(configGraph(uri)))
+	firstLine := int(occurrences[0].Range.Start.Line)
+	for _, occurrence := range occurrences {
+		firstLine = min(firstLine, int(occurrence.Range.Start.Line))
+	}
+
+	edits := []protocol.TextEdit{{
+		Range:   collapsedRange(protocol.Position{Line: uint32(topLevelLine(document, firstLine))}),
+		NewText: fmt.Sprintf("$%s = %s\n", name, selected.Text),
+	}}
+	for _, occurrence := range occurrences {
+		edits = append(edits, protocol.TextEdit{Range: occurrence.Range, NewText: "$" + name})
+	}
+
+	return []protocol.CodeAction{{
+		Title: fmt.Sprintf("Extract %s into $%s (%d occurrences)", selected.Text, name, len(occurrences)),
+		Kind:  protocol.RefactorExtract,
+		Edit:  documentEdit(uri, edits...),
+	}}
+}
+
+// inlineVariableActions offers to replace a variable reference with the variable's value,
+// or, on a declaration, to replace all of its references in the configuration and remove it.
+func inlineVariableActions(uri protocol.URI, document parser.Section, lines []string, selection protocol.Range) []protocol.CodeAction {
+	graph := configGraph(uri)
+	variables := graphVariables(graph)
+
+	for _, reference := range variableReferences(lines, variables) {
+		if reference.Declared && within(reference.Range, selection.Start) {
+			value, _ := variables.Lookup(reference.Name)
+			return []protocol.CodeAction{{
+				Title: fmt.Sprintf("Inline $%s", reference.Name),
+				Kind:  protocol.RefactorInline,
+				Edit:  documentEdit(uri, protocol.TextEdit{Range: reference.Range, NewText: value}),
+			}}
+		}
+	}
+
+	var declaration *parser.CustomVariable
+	document.WalkCustomVariables(func(v *parser.CustomVariable) {
+		if v.Position.Line == int(selection.Start.Line) {
+			declaration = v
+		}
+	})
+	if declaration == nil {
+		return []protocol.CodeAction{}
+	}
+
+	// Which value a reference gets is ambiguous if the variable is declared multiple times
+	declarations := 0
+	for _, doc := range graph {
+		doc.Document.WalkCustomVariables(func(v *parser.CustomVariable) {
+			if v.Key == declaration.Key {
+				declarations++
+			}
+		})
+	}
+	if declarations > 1 {
+		return []protocol.CodeAction{}
+	}
+
+	edit := &protocol.WorkspaceEdit{Changes: map[protocol.DocumentURI][]protocol.TextEdit{
+		uri: {{Range: lineRange(declaration.Position.Line), NewText: ""}},
+	}}
+	for _, doc := range graph {
+		contents, err := graphFile(doc.URI)
+		if err != nil {
+			continue
+		}
+		for _, reference := range variableReferences(strings.Split(contents, "\n"), variables) {
+			if reference.Declared && reference.Name == declaration.Key {
+				edit.Changes[doc.URI] = append(edit.Changes[doc.URI], protocol.TextEdit{Range: reference.Range, NewText: strings.TrimSpace(declaration.ValueRaw)})
+			}
+		}
+	}
+
+	return []protocol.CodeAction{{
+		Title: fmt.Sprintf("Inline $%s everywhere and remove it", declaration.Key),
+		Kind:  protocol.RefactorInline,
+		Edit:  edit,
+	}}
+}
+
+// nestedBlock writes an assignment inside nested sections, indented as if it started at the given depth.
+func nestedBlock(sections []string, assignment string, unit string, depth int) string {
+	lines := make([]string, 0, 2*len(sections)+1)
+	for i, section := range sections {
+		lines = append(lines, strings.Repeat(unit, depth+i)+section+" {")
+	}
+	lines = append(lines, strings.Repeat(unit, depth+len(sections))+assignment)
+	for i := len(sections) - 1; i >= 0; i-- {
+		lines = append(lines, strings.Repeat(unit, depth+i)+"}")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// nestAssignmentActions offers to move a top-level category:key = value assignment into a category { key = value } section,
+// reusing the sections that already exist in the document.
+func nestAssignmentActions(uri protocol.URI, document parser.Section, lines []string, selection protocol.Range) []protocol.CodeAction {
+	index := slices.IndexFunc(document.Assignments, func(a parser.Assignment) bool {
+		return a.Position.Line == int(selection.Start.Line) && strings.Contains(a.Key, ":")
+	})
+	if index < 0 {
+		return []protocol.CodeAction{}
+	}
+	assignment := document.Assignments[index]
+	path := strings.Split(assignment.Key, ":")
+	sections, key := path[:len(path)-1], path[len(path)-1]
+	_, value, _ := strings.Cut(lines[assignment.Position.Line], "=")
+	nested := key + " = " + strings.TrimSpace(value)
+
+	container := &document
+	depth := 0
+	for ; depth < len(sections); depth++ {
+		found := -1
+		for i, subsection := range container.Subsections {
+			if subsection.Name == sections[depth] {
+				found = i
+			}
+		}
+		if found < 0 {
+			break
+		}
+		container = &container.Subsections[found]
+	}
+
+	unit := indentUnit(lines)
+	var edits []protocol.TextEdit
+	if depth == 0 {
+		line := lines[assignment.Position.Line]
+		edits = []protocol.TextEdit{{
+			Range: protocol.Range{
+				Start: protocol.Position{Line: uint32(assignment.Position.Line), Character: uint32(len(indentation(line)))},
+				End:   protocol.Position{Line: uint32(assignment.Position.Line), Character: uint32(len(strings.TrimRight(line, " \t\r")))},
+			},
+			NewText: nestedBlock(sections, nested, unit, 0),
+		}}
+	} else {
+		edits = []protocol.TextEdit{
+			{Range: lineRange(assignment.Position.Line), NewText: ""},
+			{
+				Range:   collapsedRange(protocol.Position{Line: uint32(container.End.Line)}),
+				NewText: nestedBlock(sections[depth:], nested, unit, depth) + "\n",
+			},
+		}
+	}
+
+	return []protocol.CodeAction{{
+		Title: fmt.Sprintf("Move into section %s", strings.Join(sections, ":")),
+		Kind:  protocol.RefactorRewrite,
+		Edit:  documentEdit(uri, edits...),
+	}}
+}
+
+// flattenSectionActions offers to convert a section into category:key = value assignments, when the cursor is on its header.
+func flattenSectionActions(uri protocol.URI, document parser.Section, lines []string, selection protocol.Range) []protocol.CodeAction {
+	var section *parser.Section
+	var path []string
+	var find func(s *parser.Section, parents []string)
+	find = func(s *parser.Section, parents []string) {
+		for i := range s.Subsections {
+			subsection := &s.Subsections[i]
+			if subsection.Start.Line == int(selection.Start.Line) {
+				section, path = subsection, append(slices.Clone(parents), subsection.Name)
+				return
+			}
+			find(subsection, append(slices.Clone(parents), subsection.Name))
+		}
+	}
+	find(&document, []string{})
+	if section == nil || section.End.Line <= section.Start.Line || isFreeformPath(path) || !onlyHasAssignments(*section) {
+		return []protocol.CodeAction{}
+	}
+	// Comments would be lost
+	for _, line := range lines[section.Start.Line+1 : section.End.Line] {
+		if strings.HasPrefix(strings.TrimSpace(line), "#") {
+			return []protocol.CodeAction{}
+		}
+	}
+
+	assignments := make([]parser.Assignment, 0)
+	paths := make(map[int][]string)
+	walkAssignmentsWithPath(*section, path, func(p []string, assignment parser.Assignment) {
+		assignments = append(assignments, assignment)
+		paths[assignment.Position.Line] = p
+	})
+	slices.SortFunc(assignments, func(a, b parser.Assignment) int { return a.Position.Line - b.Position.Line })
+
+	indent := indentation(lines[section.Start.Line])
+	flattened := make([]string, 0, len(assignments))
+	for _, assignment := range assignments {
+		_, value, _ := strings.Cut(lines[assignment.Position.Line], "=")
+		flattened = append(flattened, indent+strings.Join(paths[assignment.Position.Line], ":")+" = "+strings.TrimSpace(value))
+	}
+
+	return []protocol.CodeAction{{
+		Title: fmt.Sprintf("Convert section %s to %s:key = value assignments", section.Name, strings.Join(path, ":")),
+		Kind:  protocol.RefactorRewrite,
+		Edit: documentEdit(uri, protocol.TextEdit{
+			Range: protocol.Range{
+				Start: protocol.Position{Line: uint32(section.Start.Line)},
+				End:   protocol.Position{Line: uint32(section.End.Line), Character: uint32(len(lines[section.End.Line]))},
+			},
+			NewText: strings.Join(flattened, "\n"),
+		}),
+	}}
+}
+
+func onlyHasAssignments(section parser.Section) bool {
+	if len(section.Statements) > 0 || len(section.Variables) > 0 {
+		return false
+	}
+	for _, subsection := range section.Subsections {
+		if !onlyHasAssignments(subsection) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,256 @@
+package hyprls
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"go.lsp.dev/protocol"
+	lspuri "go.lsp.dev/uri"
+)
+
+// openTestConfig writes contents to the hyprland.conf of a new directory and opens it, as an editor would.
+func openTestConfig(t *testing.T, contents string) protocol.URI {
+	t.Helper()
+	mainFile := filepath.Join(t.TempDir(), "hyprland.conf")
+	if err := os.WriteFile(mainFile, []byte(contents), 0644); err != nil {
+		t.Fatal(err)
+	}
+	uri := lspuri.File(mainFile)
+	openedFiles[uri] = contents
+	t.Cleanup(func() { delete(openedFiles, uri) })
+	return uri
+}
+
+func codeActionsAt(t *testing.T, contents string, line, character uint32) (protocol.URI, []protocol.CodeAction) {
+	t.Helper()
+	uri := openTestConfig(t, contents)
+
+	position := protocol.Position{Line: line, Character: character}
+	actions, err := Handler{}.CodeAction(t.Context(), &protocol.CodeActionParams{
+		TextDocument: protocol.TextDocumentIdentifier{URI: uri},
+		Range:        protocol.Range{Start: position, End: position},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return uri, actions
+}
+
+func applyCodeAction(t *testing.T, contents string, line, character uint32, title string) string {
+	t.Helper()
+	uri, actions := codeActionsAt(t, contents, line, character)
+	for _, action := range actions {
+		if strings.HasPrefix(action.Title, title) {
+			return applyTextEdits(contents, action.Edit.Changes[uri])
+		}
+	}
+	titles := make([]string, 0, len(actions))
+	for _, action := range actions {
+		titles = append(titles, action.Title)
+	}
+	t.Fatalf("no %q action, got %q", title, titles)
+	return ""
+}
+
+func TestQuickFixes(t *testing.T) {
+	cases := []struct {
+		name            string
+		before          string
+		line, character uint32
+		title           string
+		after           string
+	}{
+		{
+			"misspelled option",
+			"general {\n    gaps_ot = 10\n}\n", 1, 6, "Replace with gaps_out",
+			"general {\n    gaps_out = 10\n}\n",
+		},
+		{
+			"misspelled variable",
+			"$mainMod = SUPER\nbind = $mainMdo, Q, killactive,\n", 1, 10, "Replace with $mainMod",
+			"$mainMod = SUPER\nbind = $mainMod, Q, killactive,\n",
+		},
+		{
+			"legacy color",
+			"general {\n    col.active_border = 0xee33ccff 0xff00ff99 45deg\n}\n", 1, 36, "Convert to rgba(00ff99ff)",
+			"general {\n    col.active_border = 0xee33ccff rgba(00ff99ff) 45deg\n}\n",
+		},
+		{
+			"duplicate assignment",
+			"general {\n    border_size = 1\n}\ngeneral:border_size = 2\n", 1, 6, "Remove overridden assignment",
+			"general {\n}\ngeneral:border_size = 2\n",
+		},
+		{
+			"unclosed section",
+			"decoration {\n    blur {\n        size = 3", 1, 5, "Add missing closing braces",
+			"decoration {\n    blur {\n        size = 3\n    }\n}\n",
+		},
+		{
+			"disallowed value",
+			"general {\n    layout = dwindel\n}\n", 1, 15, "Replace with dwindle",
+			"general {\n    layout = dwindle\n}\n",
+		},
+		{
+			"disallowed value with parameters",
+			"input {\n    accel_profile = custm 200 0.0 0.5\n}\n", 1, 22, "Replace with custom",
+			"input {\n    accel_profile = custom 200 0.0 0.5\n}\n",
+		},
+		{
+			"out of bounds value",
+			"decoration {\n    active_opacity = 1.5\n}\n", 1, 23, "Replace with 1",
+			"decoration {\n    active_opacity = 1\n}\n",
+		},
+		{
+			"out of bounds float in an option documented as int",
+			"layout {\n    single_window_aspect_ratio_tolerance = 3.5\n}\n", 1, 45, "Replace with 1",
+			"layout {\n    single_window_aspect_ratio_tolerance = 1\n}\n",
+		},
+		{
+			"non-integer value",
+			"decoration {\n    shadow {\n        render_power = 2.5\n    }\n}\n", 2, 25, "Replace with 3",
+			"decoration {\n    shadow {\n        render_power = 3\n    }\n}\n",
+		},
+		{
+			"out of bounds non-integer value",
+			"decoration {\n    shadow {\n        render_power = 7.5\n    }\n}\n", 2, 25, "Replace with 4",
+			"decoration {\n    shadow {\n        render_power = 4\n    }\n}\n",
+		},
+		{
+			"gradient angle without unit",
+			"general {\n    col.active_border = rgb(ff0000) rgb(00ff00) 45\n}\n", 1, 50, "Replace with 45deg",
+			"general {\n    col.active_border = rgb(ff0000) rgb(00ff00) 45deg\n}\n",
+		},
+		{
+			"negative fractional gradient angle",
+			"general {\n    col.active_border = rgb(ff0000) rgb(00ff00) -90.5deg\n}\n", 1, 50, "Replace with 270deg",
+			"general {\n    col.active_border = rgb(ff0000) rgb(00ff00) 270deg\n}\n",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if after := applyCodeAction(t, c.before, c.line, c.character, c.title); after != c.after {
+				t.Errorf("expected\n%s\ngot\n%s", c.after, after)
+			}
+		})
+	}
+}
+
+func TestRefactorings(t *testing.T) {
+	cases := []struct {
+		name            string
+		before          string
+		line, character uint32
+		title           string
+		after           string
+	}{
+		{
+			"extract literal",
+			"general {\n    col.active_border = rgb(ff0000)\n}\ngroup {\n    col.border_active = rgb(ff0000)\n}\n", 1, 27, "Extract rgb(ff0000) into $active_border",
+			"$active_border = rgb(ff0000)\ngeneral {\n    col.active_border = $active_border\n}\ngroup {\n    col.border_active = $active_border\n}\n",
+		},
+		{
+			"inline reference",
+			"$term = kitty\nbind = SUPER, Return, exec, $term\n", 1, 30, "Inline $term",
+			"$term = kitty\nbind = SUPER, Return, exec, kitty\n",
+		},
+		{
+			"inline declaration",
+			"$term = kitty\nbind = SUPER, Return, exec, $term\nbind = SUPER, T, exec, $term --hold\n", 0, 2, "Inline $term everywhere",
+			"bind = SUPER, Return, exec, kitty\nbind = SUPER, T, exec, kitty --hold\n",
+		},
+		{
+			"nest into new sections",
+			"decoration:blur:size = 3 # big\n", 0, 3, "Move into section decoration:blur",
+			"decoration {\n    blur {\n        size = 3 # big\n    }\n}\n",
+		},
+		{
+			"nest into existing section",
+			"decoration {\n\trounding = 4\n}\ndecoration:blur:size = 3\n", 3, 3, "Move into section decoration:blur",
+			"decoration {\n\trounding = 4\n\tblur {\n\t\tsize = 3\n\t}\n}\n",
+		},
+		{
+			"flatten section",
+			"decoration {\n    rounding = 4\n    blur {\n        size = 3\n    }\n}\n", 0, 3, "Convert section decoration",
+			"decoration:rounding = 4\ndecoration:blur:size = 3\n",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if after := applyCodeAction(t, c.before, c.line, c.character, c.title); after != c.after {
+				t.Errorf("expected\n%s\ngot\n%s", c.after, after)
+			}
+		})
+	}
+}
+
+func TestOutOfBoundsNonInteger(t *testing.T) {
+	contents := "decoration {\n    shadow {\n        render_power = 7.5\n    }\n}\n"
+	uri := openTestConfig(t, contents)
+	document, err := parse(uri)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	problems := outOfBoundsProblems(uri, document, strings.Split(contents, "\n"))
+	if len(problems) != 1 {
+		t.Fatalf("expected a single problem, got %d", len(problems))
+	}
+	if fixes := problems[0].Fixes; len(fixes) != 1 || fixes[0].Title != "Replace with 4" {
+		t.Errorf("expected to replace with 4, got %+v", fixes)
+	}
+}
+
+func TestPluginLayouts(t *testing.T) {
+	for _, loading := range []string{"exec-once = hyprpm reload -n", "plugin = /usr/lib/libhy3.so", "plugin {\n    hy3 {\n    }\n}"} {
+		contents := loading + "\ngeneral {\n    layout = hy3\n}\n"
+		uri := openTestConfig(t, contents)
+		document, err := parse(uri)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if problems := disallowedValueProblems(uri, document, strings.Split(contents, "\n")); len(problems) > 0 {
+			t.Errorf("%s: expected layout = hy3 not to be flagged, got %q", loading, problems[0].Diagnostic.Message)
+		}
+	}
+}
+
+func TestUndefinedVariableProblems(t *testing.T) {
+	contents := `$a = $b
+$b = $a
+$terminal = kitty
+exec-once = $HYPRLS_UNDEFINED_ENV/script.sh
+general {
+    layout = $layout
+}
+bind = SUPER, Return, exec, $terminl
+`
+	uri := openTestConfig(t, contents)
+	document, err := parse(uri)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	messages := make(map[uint32]string)
+	for _, problem := range undefinedVariableProblems(uri, document, strings.Split(contents, "\n")) {
+		messages[problem.Diagnostic.Range.Start.Line] = problem.Diagnostic.Message
+	}
+	expected := map[uint32]string{
+		0: "$a can't be expanded, its value references itself through $b",
+		1: "$b can't be expanded, its value references itself through $a",
+		5: "undefined variable $layout",
+		7: "undefined variable $terminl, did you mean $terminal?",
+	}
+	if len(messages) != len(expected) {
+		t.Errorf("expected %d problems, got %v", len(expected), messages)
+	}
+	for line, message := range expected {
+		if messages[line] != message {
+			t.Errorf("line %d: expected %q, got %q", line, message, messages[line])
+		}
+	}
+}
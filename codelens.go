@@ -0,0 +1,78 @@
+package hyprls
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/hyprland-community/hyprls/parser"
+	"go.lsp.dev/protocol"
+)
+
+// codeLensData is sent along with unresolved code lenses, to find back what they are about in CodeLensResolve.
+type codeLensData struct {
+	URI  protocol.URI `json:"uri"`
+	Line int          `json:"line"`
+}
+
+func (h Handler) CodeLens(ctx context.Context, params *protocol.CodeLensParams) ([]protocol.CodeLens, error) {
+	if isFileIgnored(params.TextDocument.URI) {
+		return nil, nil
+	}
+	document, err := parse(params.TextDocument.URI)
+	if err != nil {
+		return nil, fmt.Errorf("while parsing: %w", err)
+	}
+
+	lenses := make([]protocol.CodeLens, 0)
+	document.WalkStatements(func(stmt *parser.Statement) {
+		if stmt.Keyword != "animation" {
+			return
+		}
+		lenses = append(lenses, protocol.CodeLens{
+			Range: collapsedRange(stmt.Position.LSP()),
+			Data: codeLensData{
+				URI:  params.TextDocument.URI,
+				Line: stmt.Position.Line,
+			},
+		})
+	})
+	return lenses, nil
+}
+
+func (h Handler) CodeLensResolve(ctx context.Context, params *protocol.CodeLens) (*protocol.CodeLens, error) {
+	// Data went through JSON, so it's now a map
+	raw, ok := params.Data.(map[string]any)
+	if !ok {
+		return params, nil
+	}
+	uri, _ := raw["uri"].(string)
+	line, _ := raw["line"].(float64)
+
+	data := codeLensData{URI: protocol.URI(uri), Line: int(line)}
+	document, err := parse(data.URI)
+	if err != nil {
+		return nil, fmt.Errorf("while parsing: %w", err)
+	}
+
+	stmt, _ := statementAt(document, protocol.Position{Line: uint32(data.Line)})
+	if stmt == nil || stmt.Keyword != "animation" {
+		return params, nil
+	}
+
+	params.Command = &protocol.Command{
+		Title: animationCodeLensTitle(data.URI, *stmt),
+	}
+	return params, nil
+}
+
+func animationCodeLensTitle(uri protocol.URI, stmt parser.Statement) string {
+	name := stmt.RawArguments()[animationArgName]
+	tree := buildAnimationTree(configGraph(uri))
+
+	if setting, ok := tree[name]; ok && (setting.URI != uri || setting.Statement.Position.Line != stmt.Position.Line) {
+		return fmt.Sprintf("overridden by line %d of %s", setting.Statement.Position.Line+1, filepath.Base(setting.URI.Filename()))
+	}
+
+	return tree.resolve(name).summary(name)
+}
@@ -4,25 +4,187 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/hyprland-community/hyprls/parser"
-	"github.com/mazznoer/csscolorparser"
 	"go.lsp.dev/protocol"
 	"go.uber.org/zap"
 )
 
 func (h Handler) ColorPresentation(ctx context.Context, params *protocol.ColorPresentationParams) ([]protocol.ColorPresentation, error) {
 	logger.Debug("LSP:ColorPresentation", zap.Any("color", params.Color), zap.Any("range", params.Range))
-	return []protocol.ColorPresentation{
-		{
-			Label: encodeColorLiteral(params.Color),
+	literals := colorLiterals(params.Color, currentColorSyntax(params.TextDocument.URI, params.Range))
+	presentations := variableColorPresentations(params.TextDocument.URI, params.Range, params.Color)
+	for _, literal := range literals {
+		presentations = append(presentations, protocol.ColorPresentation{
+			Label: literal,
 			TextEdit: &protocol.TextEdit{
 				Range:   params.Range,
-				NewText: encodeColorLiteral(params.Color),
+				NewText: literal,
 			},
-		},
-	}, nil
+		})
+	}
+	return presentations, nil
+}
+
+// colorSyntax is one of the ways Hyprland accepts colors to be written.
+type colorSyntax int
+
+const (
+	colorSyntaxUnknown colorSyntax = iota
+	// rgb(RRGGBB)
+	colorSyntaxHexRGB
+	// rgba(RRGGBBAA)
+	colorSyntaxHexRGBA
+	// rgb(r, g, b)
+	colorSyntaxDecimalRGB
+	// rgba(r, g, b, a)
+	colorSyntaxDecimalRGBA
+	// 0xAARRGGBB
+	colorSyntaxLegacy
+)
+
+var colorSyntaxes = []colorSyntax{colorSyntaxHexRGB, colorSyntaxHexRGBA, colorSyntaxDecimalRGB, colorSyntaxDecimalRGBA, colorSyntaxLegacy}
+
+func colorSyntaxOf(raw string) colorSyntax {
+	compact := strings.Join(strings.Fields(raw), "")
+	if _, err := parser.ParseColor(compact); err != nil {
+		return colorSyntaxUnknown
+	}
+	switch {
+	case strings.HasPrefix(compact, "0x"):
+		return colorSyntaxLegacy
+	case strings.Contains(compact, ",") && strings.HasPrefix(compact, "rgba("):
+		return colorSyntaxDecimalRGBA
+	case strings.Contains(compact, ","):
+		return colorSyntaxDecimalRGB
+	case strings.HasPrefix(compact, "rgba("):
+		return colorSyntaxHexRGBA
+	default:
+		return colorSyntaxHexRGB
+	}
+}
+
+// currentColorSyntax returns the syntax of the color written at rang.
+func currentColorSyntax(uri protocol.URI, rang protocol.Range) colorSyntax {
+	contents, err := file(uri)
+	if err != nil {
+		return colorSyntaxUnknown
+	}
+	lines := strings.Split(contents, "\n")
+	if rang.Start.Line != rang.End.Line || int(rang.Start.Line) >= len(lines) || int(rang.End.Character) > len(lines[rang.Start.Line]) {
+		return colorSyntaxUnknown
+	}
+	return colorSyntaxOf(lines[rang.Start.Line][rang.Start.Character:rang.End.Character])
+}
+
+// colorLiterals writes color in every syntax that can represent it, starting with preferred.
+func colorLiterals(color protocol.Color, preferred colorSyntax) []string {
+	literals := make([]string, 0, len(colorSyntaxes))
+	if literal, ok := formatColor(color, preferred); ok {
+		literals = append(literals, literal)
+	}
+	for _, syntax := range colorSyntaxes {
+		literal, ok := formatColor(color, syntax)
+		if ok && !slices.Contains(literals, literal) {
+			literals = append(literals, literal)
+		}
+	}
+	return literals
+}
+
+// formatColor writes color with a syntax. rgb() syntaxes can't represent translucent colors.
+func formatColor(color protocol.Color, syntax colorSyntax) (string, bool) {
+	r, g, b, a := colorComponent(color.Red), colorComponent(color.Green), colorComponent(color.Blue), colorComponent(color.Alpha)
+	opaque := a == 0xff
+	switch syntax {
+	case colorSyntaxHexRGB:
+		return fmt.Sprintf("rgb(%02x%02x%02x)", r, g, b), opaque
+	case colorSyntaxHexRGBA:
+		return fmt.Sprintf("rgba(%02x%02x%02x%02x)", r, g, b, a), true
+	case colorSyntaxDecimalRGB:
+		return fmt.Sprintf("rgb(%d, %d, %d)", r, g, b), opaque
+	case colorSyntaxDecimalRGBA:
+		return fmt.Sprintf("rgba(%d, %d, %d, %s)", r, g, b, strconv.FormatFloat(roundToThree(color.Alpha), 'f', -1, 64)), true
+	case colorSyntaxLegacy:
+		return fmt.Sprintf("0x%02x%02x%02x%02x", a, r, g, b), true
+	}
+	return "", false
+}
+
+func colorComponent(f float64) uint8 {
+	return uint8(math.Round(min(max(f, 0), 1) * 255))
+}
+
+// variableColorPresentations offers to change the color in the declaration of the variable referenced at rang.
+// Presentations can only edit the document of the color, so none is offered when the reference uses a declaration of another file.
+// Replacing the reference with a literal color is left to the default presentation.
+func variableColorPresentations(uri protocol.URI, rang protocol.Range, newColor protocol.Color) []protocol.ColorPresentation {
+	contents, err := file(uri)
+	if err != nil {
+		return nil
+	}
+	lines := strings.Split(contents, "\n")
+	if rang.Start.Line != rang.End.Line || int(rang.Start.Line) >= len(lines) || int(rang.End.Character) > len(lines[rang.Start.Line]) {
+		return nil
+	}
+	text := lines[rang.Start.Line][rang.Start.Character:rang.End.Character]
+	document, err := parse(uri)
+	if err != nil {
+		return nil
+	}
+
+	graph := configGraph(uri)
+	variables := graphVariables(graph)
+	references := variables.References(text)
+	if len(references) != 1 || references[0].Start != 0 || references[0].End != len(text) || !references[0].Declared {
+		return nil
+	}
+	// Declarations of this document start after the ones of the documents Hyprland reads before it
+	offset := 0
+	for _, doc := range graph {
+		if doc.URI == uri {
+			break
+		}
+		offset += len(parser.VariablesOf(doc.Document).Declarations)
+	}
+	declarations := parser.VariablesOf(document).Declarations
+	before := offset + slices.IndexFunc(declarations, func(v parser.CustomVariable) bool { return v.Position.Line >= int(rang.Start.Line) })
+	if before < offset {
+		before = offset + len(declarations)
+	}
+	i := variables.DeclarationAt(references[0].Name, before)
+	if i < offset || i >= offset+len(declarations) {
+		return nil
+	}
+	declaration := variables.Declarations[i]
+	if declaration.Value.Custom != "" {
+		return nil
+	}
+	color, ok := singleColor(declaration.Value)
+	if !ok {
+		return nil
+	}
+	written := lines[color.Start.Line][color.Start.Column:color.End.Column]
+	literal := colorLiterals(newColor, colorSyntaxOf(written))[0]
+	return []protocol.ColorPresentation{{
+		Label:               fmt.Sprintf("$%s = %s", references[0].Name, literal),
+		TextEdit:            &protocol.TextEdit{Range: rang, NewText: text},
+		AdditionalTextEdits: []protocol.TextEdit{{Range: color.LSPRange(), NewText: literal}},
+	}}
+}
+
+// singleColor returns the color of a value that is only a color, which is parsed as a gradient with a single stop.
+func singleColor(v parser.Value) (parser.Value, bool) {
+	switch {
+	case v.Kind == parser.Color:
+		return v, true
+	case v.Kind == parser.Gradient && len(v.Gradient.Stops) == 1 && v.Gradient.Angle == 0:
+		return v.Gradient.Stops[0], true
+	}
+	return parser.Value{}, false
 }
 
 func (h Handler) DocumentColor(ctx context.Context, params *protocol.DocumentColorParams) ([]protocol.ColorInformation, error) {
@@ -33,8 +195,14 @@ func (h Handler) DocumentColor(ctx context.Context, params *protocol.DocumentCol
 	if err != nil {
 		return []protocol.ColorInformation{}, fmt.Errorf("while parsing: %w", err)
 	}
+	variables := graphVariables(configGraph(params.TextDocument.URI))
 	colors := make([]protocol.ColorInformation, 0)
-	document.WalkValues(func(a *parser.Assignment, v *parser.Value) {
+	addColors := func(v *parser.Value) {
+		if v.Custom != "" {
+			colors = append(colors, variableColors(v, variables)...)
+			return
+		}
+
 		if v.Kind == parser.Gradient {
 			for _, stop := range v.Gradient.Stops {
 				colors = append(colors, protocol.ColorInformation{
@@ -53,10 +221,40 @@ func (h Handler) DocumentColor(ctx context.Context, params *protocol.DocumentCol
 			Color: v.LSPColor(),
 			Range: v.LSPRange(),
 		})
-	})
+	}
+	document.WalkValues(func(a *parser.Assignment, v *parser.Value) { addColors(v) })
+	document.WalkCustomVariables(func(v *parser.CustomVariable) { addColors(&v.Value) })
 	return colors, nil
 }
 
+// variableColors finds the colors of a value that references variables: color literals, and references to variables whose value is a color.
+func variableColors(v *parser.Value, variables parser.Variables) []protocol.ColorInformation {
+	colors := make([]protocol.ColorInformation, 0)
+	offset := 0
+	for _, token := range strings.Fields(v.Custom) {
+		start := offset + strings.Index(v.Custom[offset:], token)
+		offset = start + len(token)
+
+		value := token
+		if references := variables.References(token); len(references) == 1 && references[0].Start == 0 && references[0].End == len(token) {
+			value = expandCustomVariables(token, variables)
+		}
+		color, err := parser.ParseColor(value)
+		if err != nil {
+			continue
+		}
+		colorValue := parser.Value{Kind: parser.Color, Color: color}
+		colors = append(colors, protocol.ColorInformation{
+			Color: colorValue.LSPColor(),
+			Range: protocol.Range{
+				Start: protocol.Position{Line: uint32(v.Start.Line), Character: uint32(v.Start.Column + start)},
+				End:   protocol.Position{Line: uint32(v.Start.Line), Character: uint32(v.Start.Column + offset)},
+			},
+		})
+	}
+	return colors
+}
+
 func decodeColorLiteral(raw string) protocol.Color {
 	logger.Debug("decodeColorLiteral", zap.String("raw", raw))
 	color, err := parser.ParseColor(raw)
@@ -74,25 +272,11 @@ func decodeColorLiteral(raw string) protocol.Color {
 	}
 }
 
+// encodeColorLiteral writes color with rgb(RRGGBB), or rgba(RRGGBBAA) if it is translucent.
 func encodeColorLiteral(color protocol.Color) string {
-	logger.Debug("encodeColorLiteral", zap.Any("color", color))
-	out := strings.TrimPrefix(csscolorparser.Color{
-		R: roundToThree(color.Red),
-		G: roundToThree(color.Green),
-		B: roundToThree(color.Blue),
-		A: roundToThree(color.Alpha),
-	}.HexString(), "#")
-
-	if color.Alpha == 1.0 {
-		out = fmt.Sprintf("rgb(%s)", out)
-	} else {
-		out = fmt.Sprintf("rgba(%s)", out)
-	}
-
-	logger.Debug("encodeColorLiteral", zap.String("out", out))
-	return out
+	return colorLiterals(color, colorSyntaxUnknown)[0]
 }
 
 func roundToThree(f float64) float64 {
-	return math.Round(f*1_00) / 1_00
+	return math.Round(f*1_000) / 1_000
 }
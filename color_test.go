@@ -1,10 +1,15 @@
 package hyprls
 
 import (
+	"fmt"
 	"math/rand"
 	"os"
+	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
 
+	"github.com/hyprland-community/hyprls/parser"
 	"go.lsp.dev/protocol"
 	"go.uber.org/zap"
 )
@@ -77,3 +82,113 @@ func randomColor() protocol.Color {
 		Alpha: roundToThree(rand.Float64()),
 	}
 }
+
+func TestVariableColors(t *testing.T) {
+	contents := `$accent = rgb(89b4fa)
+$accent2 = rgba(a6e3a1ee)
+general {
+    col.active_border = $accent $accent2 45deg
+    col.inactive_border = rgb(313244)
+}
+`
+	uri := openTestConfig(t, contents)
+
+	colors, err := Handler{}.DocumentColor(t.Context(), &protocol.DocumentColorParams{
+		TextDocument: protocol.TextDocumentIdentifier{URI: uri},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(contents, "\n")
+	swatches := make([]string, 0, len(colors))
+	for _, color := range colors {
+		rang := color.Range
+		swatches = append(swatches, fmt.Sprintf("%d:%s", rang.Start.Line, lines[rang.Start.Line][rang.Start.Character:rang.End.Character]))
+	}
+	slices.Sort(swatches)
+	expected := []string{"0:rgb(89b4fa)", "1:rgba(a6e3a1ee)", "3:$accent", "3:$accent2", "4:rgb(313244)"}
+	if !slices.Equal(swatches, expected) {
+		t.Errorf("expected swatches %v, got %v", expected, swatches)
+	}
+
+	usage := protocol.Range{
+		Start: protocol.Position{Line: 3, Character: 24},
+		End:   protocol.Position{Line: 3, Character: 31},
+	}
+	presentations, err := Handler{}.ColorPresentation(t.Context(), &protocol.ColorPresentationParams{
+		TextDocument: protocol.TextDocumentIdentifier{URI: uri},
+		Color:        protocol.Color{Red: 1, Alpha: 1},
+		Range:        usage,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(presentations) < 2 {
+		t.Fatalf("expected to edit the definition or inline the color, got %+v", presentations)
+	}
+	edited := applyTextEdits(contents, append([]protocol.TextEdit{*presentations[0].TextEdit}, presentations[0].AdditionalTextEdits...))
+	if !strings.HasPrefix(edited, "$accent = rgb(ff0000)\n") || !strings.Contains(edited, "= $accent $accent2 45deg") {
+		t.Errorf("expected the definition of $accent to be edited, got:\n%s", edited)
+	}
+	inlined := applyTextEdits(contents, []protocol.TextEdit{*presentations[1].TextEdit})
+	if !strings.Contains(inlined, "= rgb(ff0000) $accent2 45deg") {
+		t.Errorf("expected the reference to be inlined, got:\n%s", inlined)
+	}
+}
+
+func TestVariableColorsOfSourcedFiles(t *testing.T) {
+	// The reference uses the declaration of colors.conf, the one of this file comes after it
+	contents := "source = ./colors.conf\ngeneral {\n    col.active_border = $accent\n}\n$accent = rgb(000000)\n"
+	uri := openTestConfig(t, contents)
+	if err := os.WriteFile(filepath.Join(filepath.Dir(uri.Filename()), "colors.conf"), []byte("$accent = rgb(89b4fa)\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	presentations, err := Handler{}.ColorPresentation(t.Context(), &protocol.ColorPresentationParams{
+		TextDocument: protocol.TextDocumentIdentifier{URI: uri},
+		Color:        protocol.Color{Red: 1, Alpha: 1},
+		Range: protocol.Range{
+			Start: protocol.Position{Line: 2, Character: 24},
+			End:   protocol.Position{Line: 2, Character: 31},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, presentation := range presentations {
+		if len(presentation.AdditionalTextEdits) > 0 {
+			t.Errorf("expected no edit of the declaration in colors.conf, got %+v", presentation)
+		}
+	}
+}
+
+func TestColorSyntaxes(t *testing.T) {
+	cases := map[string]protocol.Color{
+		"rgb(ff0000)":              {Red: 1, Alpha: 1},
+		"rgba(00ff0080)":           {Green: 1, Alpha: 0.502},
+		"rgb(0, 0, 255)":           {Blue: 1, Alpha: 1},
+		"rgba(255, 255, 255, 0.5)": {Red: 1, Green: 1, Blue: 1, Alpha: 0.502},
+		"rgba(0,0,0,1)":            {Alpha: 1},
+		"0x80ff0000":               {Red: 1, Alpha: 0.502},
+	}
+	for raw, expected := range cases {
+		if decoded := decodeColorLiteral(raw); !compareColorStructs(decoded, expected) {
+			t.Errorf("%s: expected %v, got %v", raw, expected, decoded)
+		}
+	}
+
+	for _, invalid := range []string{"rgb(256, 0, 0)", "rgba(0, 0, 0, 2)", "rgb(ff0000) junk", "rgb(ff00)"} {
+		if _, err := parser.ParseColor(invalid); err == nil {
+			t.Errorf("expected %q to be invalid", invalid)
+		}
+	}
+
+	translucent := protocol.Color{Red: 1, Alpha: 0.5}
+	expected := []string{"rgba(255, 0, 0, 0.5)", "rgba(ff000080)", "0x80ff0000"}
+	if literals := colorLiterals(translucent, colorSyntaxDecimalRGBA); !slices.Equal(literals, expected) {
+		t.Errorf("expected %v, got %v", expected, literals)
+	}
+	if rounded := roundToThree(0.1234); rounded != 0.123 {
+		t.Errorf("expected 0.1234 to be rounded to 0.123, got %v", rounded)
+	}
+}
@@ -0,0 +1,21 @@
+package hyprls
+
+import (
+	"context"
+	"fmt"
+
+	"go.lsp.dev/protocol"
+)
+
+// commands are the commands supported by workspace/executeCommand.
+var commands = []string{
+	exportKeybindingsCommand,
+}
+
+func (h Handler) ExecuteCommand(ctx context.Context, params *protocol.ExecuteCommandParams) (interface{}, error) {
+	switch params.Command {
+	case exportKeybindingsCommand:
+		return exportKeybindingsFromCommand(params.Arguments)
+	}
+	return nil, fmt.Errorf("unknown command %q", params.Command)
+}
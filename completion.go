@@ -2,216 +2,360 @@ package hyprls
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
-	"unicode"
 
 	"github.com/hyprland-community/hyprls/parser"
 	parser_data "github.com/hyprland-community/hyprls/parser/data"
 	"go.lsp.dev/protocol"
-	"go.uber.org/zap"
 )
 
+// completionContext is what is being written at the cursor.
+type completionContext struct {
+	URI       protocol.URI
+	Graph     []configDocument
+	Variables parser.Variables
+	// Schema documents the Hyprland version the document is written for
+	Schema parser_data.Schema
+	// Section is the innermost section the cursor is in, and Path its path
+	Section parser.Section
+	Path    []string
+	// Word is the word being written before the cursor, and Replace its range
+	Word    string
+	Replace protocol.Range
+	// Indent is the indentation unit of the document
+	Indent string
+}
+
 func (h Handler) Completion(ctx context.Context, params *protocol.CompletionParams) (*protocol.CompletionList, error) {
-	if isFileIgnored(params.TextDocument.URI) {
+	uri := params.TextDocument.URI
+	if isFileIgnored(uri) {
 		return nil, nil
 	}
-	line, err := currentLine(params.TextDocument.URI, params.Position)
+	contents, err := file(uri)
 	if err != nil {
 		return nil, nil
 	}
-
-	file, err := parse(params.TextDocument.URI)
+	document, err := parse(uri)
 	if err != nil {
 		return nil, nil
 	}
+	lines := strings.Split(contents, "\n")
+	if int(params.Position.Line) >= len(lines) {
+		return nil, nil
+	}
+	line := lines[params.Position.Line]
+	before := line[:min(int(params.Position.Character), len(line))]
 
-	sec := currentSection(file, params.Position)
-	if sec == nil {
-		sec = &parser.Section{}
+	graph := configGraph(uri)
+	c := completionContext{URI: uri, Graph: graph, Variables: graphVariables(graph), Schema: schemaOf(uri), Indent: indentUnit(lines)}
+	c.Section, c.Path, _ = sectionPathAt(document, []string{}, params.Position)
+	wordStart := strings.LastIndexAny(before, " \t,=") + 1
+	c.Word = before[wordStart:]
+	c.Replace = columnsRange(params.Position.Line, wordStart, len(before))
+
+	items := make([]protocol.CompletionItem, 0)
+	incomplete := false
+	tok := tokenAt(document, lines, c.Variables, params.Position)
+	// Lines without an = yet, such as decoration:bl, don't parse into anything: the word before the cursor is a key being written
+	onKey := tok.Assignment == nil && tok.Variable == nil && tok.Statement == nil || tok.Kind == tokenOptionKey || tok.Kind == tokenKeyword
+	onVariableName := tok.Kind == tokenVariableDeclaration && tok.Range.Start.Character == uint32(tok.Variable.Position.Column)
+	switch {
+	case onKey:
+		items = append(items, c.keyCompletions()...)
+	case onVariableName:
+	case tok.Kind == tokenVariableReference, c.Word == "$": // a lone $ is not a reference yet
+		items = append(items, c.variableCompletions()...)
+	case tok.Statement != nil:
+		argument := tok.Argument
+		if argument < 0 {
+			argument = argumentIndexAt(*tok.Statement, params.Position)
+		}
+		var arguments []protocol.CompletionItem
+		arguments, incomplete = c.argumentCompletions(*tok.Statement, argument)
+		items = append(items, arguments...)
+	case tok.Assignment != nil:
+		items = append(items, c.valueCompletions(optionDefinition(c.Schema, tok.Option))...)
+	}
+	if !onKey && !onVariableName && c.Word == "" {
+		items = append(items, c.variableCompletions()...)
 	}
 
-	cursorIsAfterEquals := strings.Contains(line, "=") && strings.Index(line, "=") < int(params.Position.Character)
+	return &protocol.CompletionList{
+		IsIncomplete: incomplete,
+		Items:        items,
+	}, nil
+}
 
-	// we are after the equals sign, suggest custom properties only
-	if cursorIsAfterEquals {
-		items := make([]protocol.CompletionItem, 0)
+func (c completionContext) item(label string, kind protocol.CompletionItemKind, detail string) protocol.CompletionItem {
+	return protocol.CompletionItem{
+		Label:    label,
+		Kind:     kind,
+		Detail:   detail,
+		TextEdit: &protocol.TextEdit{Range: c.Replace, NewText: label},
+	}
+}
 
-		cursorOrLineEnd := min(int(params.Position.Character), len(line)-1)
-		characterBeforeCursorIsDollarSign := line[cursorOrLineEnd] == '$'
+func (c completionContext) snippet(label string, kind protocol.CompletionItemKind, snippet string) protocol.CompletionItem {
+	return protocol.CompletionItem{
+		Label:            label,
+		Kind:             kind,
+		InsertTextFormat: protocol.InsertTextFormatSnippet,
+		TextEdit:         &protocol.TextEdit{Range: c.Replace, NewText: snippet},
+	}
+}
 
-		// Don't propose custom variables if in the middle of typing a word
-		// Only propose if a dollar sign was typed or is just before the cursor
-		// Or we are after whitespace
-		// Or we are in the middle of a color completion (typed a r, and key is a color or gradient)
-		if params.Position.Character <= 0 {
-			return nil, nil
-		}
-		if !characterBeforeCursorIsDollarSign && !unicode.IsSpace(rune(line[params.Position.Character-1])) {
-			return nil, nil
-		}
+func markdown(value string) protocol.MarkupContent {
+	return protocol.MarkupContent{Kind: protocol.Markdown, Value: value}
+}
 
-		// Prevent duplicate dollar signs upon completion accept
-		var textEditRange protocol.Range
-		if characterBeforeCursorIsDollarSign {
-			textEditRange = protocol.Range{
-				Start: protocol.Position{Line: params.Position.Line, Character: params.Position.Character - 1},
-				End:   protocol.Position{Line: params.Position.Line, Character: params.Position.Character},
+// keyCompletions suggests the options, subsections and keywords that can be written in the section at the cursor.
+// Words such as decoration:bl complete the options and subsections of the section they start with.
+func (c completionContext) keyCompletions() []protocol.CompletionItem {
+	items := make([]protocol.CompletionItem, 0)
+	components := strings.Split(c.Word, ":")
+	prefixed := len(components) > 1
+	c.Replace.Start.Character += uint32(len(c.Word) - len(components[len(components)-1]))
+	path := append(slices.Clone(c.Path), components[:len(components)-1]...)
+	if isFreeformPath(path) {
+		return items
+	}
+
+	var subsections []parser_data.SectionDefinition
+	if len(path) == 0 {
+		for _, section := range c.Schema.Sections {
+			if len(section.Path) == 1 {
+				subsections = append(subsections, section)
 			}
-		} else {
-			textEditRange = collapsedRange(params.Position)
-		}
-
-		file.WalkCustomVariables(func(v *parser.CustomVariable) {
-			items = append(items, protocol.CompletionItem{
-				Label: "$" + v.Key,
-				Kind:  protocol.CompletionItemKindVariable,
-				Documentation: protocol.MarkupContent{
-					Kind:  protocol.PlainText,
-					Value: v.ValueRaw,
-				},
-				TextEdit: &protocol.TextEdit{
-					Range:   textEditRange,
-					NewText: "$" + v.Key,
-				},
-			})
-		})
-
-		textedit := func(t string) *protocol.TextEdit {
-			return &protocol.TextEdit{
-				Range:   collapsedRange(params.Position),
-				NewText: t,
+		}
+	} else if definition := c.Schema.FindSectionDefinitionByPath(path); definition != nil {
+		subsections = definition.Subsections
+		for _, option := range definition.Variables {
+			if !prefixed && slices.ContainsFunc(c.Section.Assignments, func(a parser.Assignment) bool { return a.Key == option.Name }) {
+				continue
 			}
+			item := c.snippet(option.Name, protocol.CompletionItemKindField, fmt.Sprintf("%s = ${1:%s}", option.Name, escapeSnippet(defaultValueText(option))))
+			item.Data = completionItemData{Kind: completionOption, Path: append(slices.Clone(path), option.Name), URI: c.URI}
+			items = append(items, item)
 		}
+	}
 
-		valueKind := parser.String
-		if sec != nil {
-			assignment := currentAssignment(*sec, params.Position)
-			if assignment != nil {
-				valueKind, err = parser.ValueKindFromString(assignment.ParserTypeString())
-				if err != nil {
-					valueKind = parser.String
-				}
-			}
+	for _, subsection := range subsections {
+		name := subsection.JSONName()
+		if !prefixed && slices.ContainsFunc(c.Section.Subsections, func(s parser.Section) bool { return s.Name == name }) {
+			continue
+		}
+		item := c.snippet(name, protocol.CompletionItemKindModule, fmt.Sprintf("%s {\n%s$0\n}", name, c.Indent))
+		if prefixed {
+			item.TextEdit.NewText = name + ":"
+			item.InsertTextFormat = protocol.InsertTextFormatPlainText
 		}
+		item.Data = completionItemData{Kind: completionSection, Path: append(slices.Clone(path), name), URI: c.URI}
+		items = append(items, item)
+	}
 
-		logger.Debug("LSP:Completion", zap.Any("valueKind", valueKind))
-
-		switch valueKind {
-		case parser.Color, parser.Gradient:
-
-			items = append(items, protocol.CompletionItem{
-				Label:            "rgba(⋯)",
-				Kind:             protocol.CompletionItemKindColor,
-				InsertTextFormat: protocol.InsertTextFormatSnippet,
-				Documentation:    "Define a color with an alpha channel of the form rgba(RRGGBBAA) in hexadecimal notation.",
-				TextEdit:         textedit("rgba(${0:ffffffff})"),
-			})
-
-			items = append(items, protocol.CompletionItem{
-				Label:            "rgb(⋯)",
-				Kind:             protocol.CompletionItemKindColor,
-				InsertTextFormat: protocol.InsertTextFormatSnippet,
-				TextEdit:         textedit("rgb(${0:ffffff})"),
-				Documentation:    "Define a color of the form rgb(RRGGBB) in hexadecimal notation.",
-			})
-
-			items = append(items, protocol.CompletionItem{
-				Label:            "0xAARRGGBB",
-				Kind:             protocol.CompletionItemKindColor,
-				InsertTextFormat: protocol.InsertTextFormatSnippet,
-				TextEdit:         textedit("0x${1:ffffffff}"),
-				Deprecated:       true,
-				Documentation:    "Define a color of the form 0xAARRGGBB in hexadecimal notation.",
-			})
-		case parser.Bool:
-			items = append(items, protocol.CompletionItem{
-				Label: "true",
-				Kind:  protocol.CompletionItemKindValue,
-			})
-			items = append(items, protocol.CompletionItem{
-				Label: "false",
-				Kind:  protocol.CompletionItemKindValue,
-			})
-		case parser.Modmask:
-			for keystring := range parser.ModKeyNames {
-				items = append(items, protocol.CompletionItem{
-					Label: keystring,
-					Kind:  protocol.CompletionItemKindEnumMember,
-				})
-			}
+	if len(path) == 0 {
+		for _, kw := range parser_data.Keywords {
+			item := c.snippet(kw.Name, protocol.CompletionItemKindKeyword, kw.Name+" = $0")
+			item.Data = completionItemData{Kind: completionKeyword, Name: kw.Name}
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func defaultValueText(option parser_data.VariableDefinition) string {
+	if option.Default == "[[Empty]]" {
+		return ""
+	}
+	return option.Default
+}
 
+// escapeSnippet escapes the characters that have a meaning in snippets.
+func escapeSnippet(text string) string {
+	return strings.NewReplacer(`\`, `\\`, `$`, `\$`, `}`, `\}`).Replace(text)
+}
+
+func (c completionContext) variableCompletions() []protocol.CompletionItem {
+	items := make([]protocol.CompletionItem, 0)
+	for _, name := range c.Variables.Names() {
+		raw, _ := c.Variables.Lookup(name)
+		item := c.item("$"+name, protocol.CompletionItemKindVariable, raw)
+		item.Data = completionItemData{Kind: completionVariable, Name: name, URI: c.URI}
+		items = append(items, item)
+	}
+	return items
+}
+
+var fontWeights = []string{"thin", "ultralight", "light", "semilight", "book", "normal", "medium", "semibold", "bold", "ultrabold", "heavy", "ultraheavy"}
+
+// valueCompletions suggests values of the option's type, or the values it documents.
+func (c completionContext) valueCompletions(option *parser_data.VariableDefinition) []protocol.CompletionItem {
+	items := make([]protocol.CompletionItem, 0)
+	if option == nil {
+		return items
+	}
+
+	for _, allowed := range option.AllowedValues {
+		item := c.item(allowed.Value, protocol.CompletionItemKindEnumMember, allowed.Description)
+		item.Preselect = allowed.Value == option.Default
+		items = append(items, item)
+	}
+	if len(items) > 0 {
+		return items
+	}
+
+	switch option.Type {
+	case "bool":
+		defaultIsTrue, _ := boolValue(option.Default)
+		for _, value := range []bool{true, false} {
+			item := c.item(fmt.Sprint(value), protocol.CompletionItemKindValue, "")
+			item.Preselect = value == defaultIsTrue
+			items = append(items, item)
+		}
+	case "color", "gradient":
+		rgba := c.snippet("rgba(⋯)", protocol.CompletionItemKindColor, "rgba(${0:ffffffff})")
+		rgba.Documentation = "Define a color with an alpha channel of the form rgba(RRGGBBAA) in hexadecimal notation."
+		rgb := c.snippet("rgb(⋯)", protocol.CompletionItemKindColor, "rgb(${0:ffffff})")
+		rgb.Documentation = "Define a color of the form rgb(RRGGBB) in hexadecimal notation."
+		legacy := c.snippet("0xAARRGGBB", protocol.CompletionItemKindColor, "0x${1:ffffffff}")
+		legacy.Documentation = "Define a color of the form 0xAARRGGBB in hexadecimal notation."
+		legacy.Deprecated = true
+		items = append(items, rgba, rgb, legacy)
+		if option.Type == "gradient" {
+			angle := c.snippet("⋯deg", protocol.CompletionItemKindValue, "${1:45}deg")
+			angle.Documentation = "The angle of the gradient, after its colors."
+			items = append(items, angle)
 		}
+	case "font_weight":
+		for _, weight := range fontWeights {
+			items = append(items, c.item(weight, protocol.CompletionItemKindEnumMember, ""))
+		}
+	}
 
-		return &protocol.CompletionList{
-			Items: items,
-		}, nil
+	if defaultValue := defaultValueText(*option); defaultValue != "" && option.Type != "bool" {
+		item := c.item(defaultValue, protocol.CompletionItemKindValue, "default value")
+		item.Preselect = true
+		items = append(items, item)
 	}
+	return items
+}
 
-	availableVariables := make([]parser_data.VariableDefinition, 0)
-	if sec != nil {
-		secDef := parser_data.FindSectionDefinitionByName(sec.Name)
-		if secDef != nil {
-			availableVariables = append(availableVariables, secDef.Variables...)
+// argumentIndexAt is the index of the argument of stmt that the cursor is in or after, such as in the space after bind = SUPER.
+func argumentIndexAt(stmt parser.Statement, position protocol.Position) int {
+	index := 0
+	for i, argument := range stmt.Arguments {
+		if argument.Start.Column <= int(position.Character) {
+			index = i
 		}
 	}
+	return index
+}
 
-	items := make([]protocol.CompletionItem, 0)
-vars:
-	for _, vardef := range availableVariables {
-		// Don't suggest variables that are already defined
-		for _, definedvar := range sec.Assignments {
-			if vardef.Name == definedvar.Key {
-				continue vars
-			}
+// argumentCompletions suggests values for the argument at index of a statement being written.
+// incomplete is true when only some of the values were suggested, so that clients ask again as the argument is being written.
+func (c completionContext) argumentCompletions(stmt parser.Statement, index int) (items []protocol.CompletionItem, incomplete bool) {
+	items = make([]protocol.CompletionItem, 0)
+	keyword := string(stmt.Keyword)
+	arguments := keywordArgumentsOf(keyword)
+	if len(arguments) == 0 {
+		return items, false
+	}
+	written := stmt.RawArguments()
+	for i := range written {
+		written[i] = strings.TrimSpace(expandCustomVariables(written[i], c.Variables))
+	}
+	argument := argumentOf(arguments, index)
+	writtenArgument := func(name string) string {
+		i := slices.IndexFunc(arguments, func(a keywordArgument) bool { return a.Name == name })
+		if i < 0 || i >= len(written) {
+			return ""
 		}
+		return written[i]
+	}
+	values := func(kind protocol.CompletionItemKind, values ...string) {
+		for _, value := range values {
+			items = append(items, c.item(value, kind, ""))
+		}
+	}
 
-		items = append(items, protocol.CompletionItem{
-			Label: vardef.Name,
-			Kind:  protocol.CompletionItemKindField,
-			Documentation: protocol.MarkupContent{
-				Kind:  protocol.Markdown,
-				Value: fmt.Sprintf("Type: %s\n\n%s", vardef.Type, vardef.Description),
-			},
-		})
-	}
-
-	for _, kw := range parser_data.Keywords {
-		items = append(items, protocol.CompletionItem{
-			Label: kw.Name,
-			Kind:  protocol.CompletionItemKindKeyword,
-			Documentation: protocol.MarkupContent{
-				Kind:  protocol.Markdown,
-				Value: kw.Description,
-			},
-		})
-	}
-
-subsections:
-	for _, subsections := range sec.Subsections {
-		// Don't suggest subsections that are already defined
-		for _, definedSubsection := range sec.Subsections {
-			if subsections.Name == definedSubsection.Name {
-				continue subsections
+	switch {
+	case argument.Name == argumentMods:
+		for _, mod := range modKeyDisplayNames {
+			items = append(items, c.item(mod.Name, protocol.CompletionItemKindEnumMember, modKeyDescriptions[mod.Key]))
+		}
+	case argument.Name == argumentKey:
+		return c.keysymCompletions()
+	case argument.Name == argumentDispatcher:
+		for _, dispatcher := range parser_data.Dispatchers {
+			if strings.ContainsRune(strings.TrimPrefix(keyword, "bind"), 'm') && dispatcher.Name != "movewindow" && dispatcher.Name != "resizewindow" {
+				continue
 			}
+			item := c.item(dispatcher.Name, protocol.CompletionItemKindFunction, dispatcher.Params)
+			item.Data = completionItemData{Kind: completionDispatcher, Name: dispatcher.Name}
+			items = append(items, item)
 		}
-
-		items = append(items, protocol.CompletionItem{
-			Label: subsections.Name,
-			Kind:  protocol.CompletionItemKindModule,
-			Documentation: protocol.MarkupContent{
-				Kind:  protocol.Markdown,
-				Value: fmt.Sprintf("Subsection of %s", sec.Name),
-			},
-		})
+	case argument.Name == argumentParams:
+		items = append(items, c.paramsCompletions(writtenArgument(argumentDispatcher))...)
+	case keyword == "submap":
+		values(protocol.CompletionItemKindModule, c.submapNames()...)
+	case keyword == "animation" && index == animationArgName:
+		for _, animation := range parser_data.Animations {
+			item := c.item(animation.Name, protocol.CompletionItemKindEnumMember, animation.Description)
+			item.Data = completionItemData{Kind: completionAnimation, Name: animation.Name}
+			items = append(items, item)
+		}
+	case keyword == "animation" && index == animationArgOnOff:
+		values(protocol.CompletionItemKindValue, "1", "0")
+	case keyword == "animation" && index == animationArgCurve:
+		for _, name := range slices.Sorted(maps.Keys(parser_data.BezierCurves)) {
+			items = append(items, c.item(name, protocol.CompletionItemKindFunction, "built-in curve"))
+		}
+		for _, declaration := range bezierDeclarations(c.Graph) {
+			if !slices.ContainsFunc(items, func(item protocol.CompletionItem) bool { return item.Label == declaration.Name }) {
+				items = append(items, c.item(declaration.Name, protocol.CompletionItemKindFunction, fmt.Sprintf("bezier(%g, %g, %g, %g)", declaration.Points[0], declaration.Points[1], declaration.Points[2], declaration.Points[3])))
+			}
+		}
+	case keyword == "animation" && index == animationArgStyle:
+		if animation, found := parser_data.FindAnimation(written[animationArgName]); found {
+			values(protocol.CompletionItemKindEnumMember, animation.AvailableStyles()...)
+		}
+	case keyword == "monitor" && argument.Name == "RESOLUTION":
+		values(protocol.CompletionItemKindEnumMember, "preferred", "highres", "highrr", "maxwidth", "disable")
+	case keyword == "monitor" && argument.Name == "POSITION":
+		values(protocol.CompletionItemKindEnumMember, "auto", "auto-right", "auto-left", "auto-up", "auto-down", "auto-center-right", "auto-center-left", "auto-center-up", "auto-center-down")
+	case keyword == "monitor" && argument.Name == "SCALE":
+		values(protocol.CompletionItemKindEnumMember, "auto", "1")
 	}
+	return items, false
+}
 
-	return &protocol.CompletionList{
-		Items: items,
-	}, nil
+// paramsCompletions suggests parameters of dispatchers that take directions or submaps.
+func (c completionContext) paramsCompletions(dispatcher string) []protocol.CompletionItem {
+	items := make([]protocol.CompletionItem, 0)
+	definition, found := parser_data.FindDispatcher(dispatcher)
+	switch {
+	case dispatcher == "submap":
+		for _, name := range c.submapNames() {
+			items = append(items, c.item(name, protocol.CompletionItemKindModule, ""))
+		}
+	case found && strings.HasPrefix(definition.Params, "direction"):
+		for _, direction := range []struct{ Value, Description string }{{"l", "left"}, {"r", "right"}, {"u", "up"}, {"d", "down"}} {
+			items = append(items, c.item(direction.Value, protocol.CompletionItemKindEnumMember, direction.Description))
+		}
+	}
+	return items
 }
 
-func (h Handler) CompletionResolve(ctx context.Context, params *protocol.CompletionItem) (*protocol.CompletionItem, error) {
-	return nil, errors.New("unimplemented")
+// submapNames are the submaps declared in the configuration, and reset to go back to the global submap.
+func (c completionContext) submapNames() []string {
+	names := []string{"reset"}
+	for _, block := range indexSubmaps(c.Graph).Blocks {
+		if !slices.Contains(names, block.Name) {
+			names = append(names, block.Name)
+		}
+	}
+	return names
 }
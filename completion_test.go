@@ -0,0 +1,112 @@
+package hyprls
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+
+	"go.lsp.dev/protocol"
+)
+
+func TestCompletion(t *testing.T) {
+	cases := []struct {
+		name string
+		// | marks the cursor
+		contents string
+		expected []string
+		absent   []string
+	}{
+		{"options of the section", "decoration {\n    blur {\n        enabled = true\n        |\n    }\n}\n", []string{"passes = ${1:1}"}, []string{"enabled = ${1:true}", "bind = $0"}},
+		{"missing subsections", "decoration {\n    blur {\n    }\n    |\n}\n", []string{"shadow {\n    $0\n}"}, []string{"blur {\n    $0\n}"}},
+		{"root", "|", []string{"decoration {\n    $0\n}", "bind = $0"}, []string{"enabled = ${1:true}"}},
+		{"subsections with a path", "decoration:bl|", []string{"blur:"}, nil},
+		{"allowed values", "general {\n    layout = |\n}\n", []string{"dwindle", "master"}, []string{"true"}},
+		{"values of the type", "decoration {\n    blur {\n        enabled = |\n    }\n}\n", []string{"true", "false"}, nil},
+		{"variables", "$accent = rgb(33ccff)\ngeneral {\n    col.active_border = $ac|\n}\n", []string{"$accent"}, []string{"rgba(${0:ffffffff})"}},
+		{"mod keys", "bind = SUPER |", []string{"SHIFT", "CTRL"}, []string{"killactive"}},
+		{"keys", "bind = SUPER, |", []string{"mouse:272", "mouse_up", "Return"}, []string{"SHIFT"}},
+		{"keys matching fuzzily", "bind = , xf86raisevol|", []string{"XF86AudioRaiseVolume"}, []string{"Return"}},
+		{"dispatchers", "bind = SUPER, Q, |", []string{"killactive", "exec"}, []string{"SHIFT"}},
+		{"dispatchers of binds with a description", "bindd = SUPER, Q, Close the window, |", []string{"killactive"}, nil},
+		{"dispatchers before written arguments", "bind = SUPER, Q, |, kitty", []string{"killactive", "exec"}, []string{"SHIFT"}},
+		{"variables as arguments", "$terminal = kitty\nbind = SUPER, Q, exec, |", []string{"$terminal"}, nil},
+		{"dispatcher parameters", "bind = SUPER, left, movefocus, |", []string{"l", "r", "u", "d"}, nil},
+		{"animation curves", "bezier = overshot, 0.05, 0.9, 0.1, 1.1\nanimation = windows, 1, 5, |", []string{"default", "overshot"}, nil},
+		{"animation styles", "animation = windows, 1, 5, default, |", []string{"popin", "slide"}, nil},
+	}
+	for _, c := range cases {
+		cursor := strings.Index(c.contents, "|")
+		contents := strings.Replace(c.contents, "|", "", 1)
+		lines := strings.Split(contents[:cursor], "\n")
+		position := protocol.Position{Line: uint32(len(lines) - 1), Character: uint32(len(lines[len(lines)-1]))}
+
+		uri := openTestConfig(t, contents)
+
+		list, err := Handler{}.Completion(t.Context(), &protocol.CompletionParams{TextDocumentPositionParams: protocol.TextDocumentPositionParams{
+			TextDocument: protocol.TextDocumentIdentifier{URI: uri},
+			Position:     position,
+		}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		inserted := make([]string, 0)
+		if list != nil {
+			for _, item := range list.Items {
+				inserted = append(inserted, item.TextEdit.NewText)
+			}
+		}
+		for _, expected := range c.expected {
+			if !slices.Contains(inserted, expected) {
+				t.Errorf("%s: expected %q to be suggested, got %q", c.name, expected, inserted)
+			}
+		}
+		for _, absent := range c.absent {
+			if slices.Contains(inserted, absent) {
+				t.Errorf("%s: expected %q not to be suggested", c.name, absent)
+			}
+		}
+	}
+}
+
+func TestCompletionResolve(t *testing.T) {
+	contents := "$accent = rgb(33ccff)\ndecoration {\n    \n}\n"
+	uri := openTestConfig(t, contents)
+
+	list, err := Handler{}.Completion(t.Context(), &protocol.CompletionParams{TextDocumentPositionParams: protocol.TextDocumentPositionParams{
+		TextDocument: protocol.TextDocumentIdentifier{URI: uri},
+		Position:     protocol.Position{Line: 2, Character: 4},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string][]string{
+		"rounding": {"int, defaults to 0", "[[docs]](https://wiki.hyprland.org/Configuring/Variables/#decoration)"},
+		"blur":     {"section", "### decoration:blur"},
+	}
+	for _, item := range list.Items {
+		if item.Documentation != nil {
+			t.Errorf("expected %s to be documented when resolved, got %v", item.Label, item.Documentation)
+		}
+		if _, ok := expected[item.Label]; !ok {
+			continue
+		}
+		// Resolve the item the way clients send it back
+		var sent protocol.CompletionItem
+		encoded, _ := json.Marshal(item)
+		json.Unmarshal(encoded, &sent)
+		resolved, err := Handler{}.CompletionResolve(t.Context(), &sent)
+		if err != nil {
+			t.Fatal(err)
+		}
+		documentation, _ := resolved.Documentation.(protocol.MarkupContent)
+		if resolved.Detail != expected[item.Label][0] || !strings.Contains(documentation.Value, expected[item.Label][1]) {
+			t.Errorf("%s: expected detail %q and documentation containing %q, got %q and %q", item.Label, expected[item.Label][0], expected[item.Label][1], resolved.Detail, documentation.Value)
+		}
+		delete(expected, item.Label)
+	}
+	if len(expected) > 0 {
+		t.Errorf("expected %v to be suggested", expected)
+	}
+}
@@ -0,0 +1,82 @@
+package hyprls
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hyprland-community/hyprls/parser"
+	"go.lsp.dev/protocol"
+)
+
+type completionItemKind string
+
+const (
+	completionOption     completionItemKind = "option"
+	completionSection    completionItemKind = "section"
+	completionKeyword    completionItemKind = "keyword"
+	completionVariable   completionItemKind = "variable"
+	completionDispatcher completionItemKind = "dispatcher"
+	completionAnimation  completionItemKind = "animation"
+)
+
+// completionItemData points completion items at what they complete, so that CompletionResolve can document them
+// without sending the documentation of every item upfront.
+type completionItemData struct {
+	Kind completionItemKind `json:"kind"`
+	// Path of options and sections, such as [decoration blur passes]
+	Path []string `json:"path,omitempty"`
+	// Name of keywords, variables, dispatchers and animations
+	Name string `json:"name,omitempty"`
+	// URI of the document the item is completed in, to document the options of its Hyprland version and expand variables with the ones of its configuration
+	URI protocol.URI `json:"uri,omitempty"`
+}
+
+func (h Handler) CompletionResolve(ctx context.Context, params *protocol.CompletionItem) (*protocol.CompletionItem, error) {
+	if params.Data == nil {
+		return params, nil
+	}
+	// Clients send the data back as plain JSON
+	var data completionItemData
+	encoded, err := json.Marshal(params.Data)
+	if err != nil {
+		return params, nil
+	}
+	if err := json.Unmarshal(encoded, &data); err != nil {
+		return params, nil
+	}
+
+	item := *params
+	schema := schemaOf(data.URI)
+	documentation := ""
+	switch data.Kind {
+	case completionOption:
+		option := optionDefinition(schema, data.Path)
+		if option == nil {
+			break
+		}
+		item.Detail = fmt.Sprintf("%s, defaults to %s", option.Type, option.PrettyDefault())
+		documentation = option.Description
+		if option.Bounds != nil {
+			documentation += fmt.Sprintf("\n\n- Accepts values from %s", option.Bounds)
+		}
+		if section := schema.FindSectionDefinitionByPath(data.Path[:len(data.Path)-1]); section != nil && section.DocumentationLink() != "" {
+			documentation += fmt.Sprintf("\n\n[[docs]](%s)", section.DocumentationLink())
+		}
+	case completionSection:
+		item.Detail = "section"
+		documentation = sectionHoverContents(schema, data.Path)
+	case completionKeyword:
+		documentation = keywordHoverContents(data.Name)
+	case completionVariable:
+		documentation = variableHoverContents(parser.Reference{Name: data.Name, Declared: true}, graphVariables(configGraph(data.URI)))
+	case completionDispatcher:
+		documentation = dispatcherHoverContents(data.Name)
+	case completionAnimation:
+		documentation = animationHoverContents(data.Name)
+	}
+	if documentation != "" {
+		item.Documentation = markdown(documentation)
+	}
+	return &item, nil
+}
@@ -0,0 +1,194 @@
+package hyprls
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"strings"
+
+	"github.com/hyprland-community/hyprls/parser"
+	"go.lsp.dev/protocol"
+)
+
+// contrastDiagnostics enables contrastProblems, with the hyprls.contrastDiagnostics setting.
+var contrastDiagnostics = false
+
+func extractContrastDiagnostics(hyprls map[string]any) (enabled bool, isAvailable bool) {
+	enabled, isAvailable = hyprls["contrastDiagnostics"].(bool)
+	return
+}
+
+// contrastPair is two color options that are displayed one on top of, or next to, the other.
+type contrastPair struct {
+	// Foreground and Background are option paths, followed by the options Hyprland uses when they are unset
+	Foreground []string
+	Background []string
+	// Minimum is the lowest acceptable WCAG contrast ratio
+	Minimum float64
+	// Concern describes what a low contrast makes hard to see
+	Concern string
+}
+
+// contrastPairs use the WCAG 2 minimums: 4.5:1 for text, 3:1 for user interface components.
+var contrastPairs = []contrastPair{
+	{
+		Foreground: []string{"group:groupbar:text_color"},
+		Background: []string{"group:groupbar:col.active"},
+		Minimum:    4.5,
+		Concern:    "the title of the active window in groupbars may be hard to read",
+	},
+	{
+		Foreground: []string{"group:groupbar:text_color_inactive", "group:groupbar:text_color"},
+		Background: []string{"group:groupbar:col.inactive"},
+		Minimum:    4.5,
+		Concern:    "titles of inactive windows in groupbars may be hard to read",
+	},
+	{
+		Foreground: []string{"group:groupbar:text_color_locked_active", "group:groupbar:text_color"},
+		Background: []string{"group:groupbar:col.locked_active"},
+		Minimum:    4.5,
+		Concern:    "the title of the active window in locked groupbars may be hard to read",
+	},
+	{
+		Foreground: []string{"group:groupbar:text_color_locked_inactive", "group:groupbar:text_color_inactive", "group:groupbar:text_color"},
+		Background: []string{"group:groupbar:col.locked_inactive"},
+		Minimum:    4.5,
+		Concern:    "titles of inactive windows in locked groupbars may be hard to read",
+	},
+	{
+		Foreground: []string{"general:col.active_border"},
+		Background: []string{"general:col.inactive_border"},
+		Minimum:    3,
+		Concern:    "the active window may be hard to tell apart",
+	},
+	{
+		Foreground: []string{"group:col.border_active"},
+		Background: []string{"group:col.border_inactive"},
+		Minimum:    3,
+		Concern:    "the active group may be hard to tell apart",
+	},
+}
+
+// colorOption is the value a color option has once the whole configuration is loaded.
+type colorOption struct {
+	Path   string
+	Colors []color.RGBA
+	// Assignment is nil when the option has its default value
+	Assignment *parser.Assignment
+	URI        protocol.URI
+}
+
+// contrastProblems reports related color options whose WCAG contrast ratio is too low. It is opt-in, see contrastDiagnostics.
+// Translucent colors are composited over black, and gradients are checked with their least contrasted colors.
+func contrastProblems(uri protocol.URI, document parser.Section, lines []string) []configProblem {
+	problems := make([]configProblem, 0)
+	if !contrastDiagnostics {
+		return problems
+	}
+
+	graph := configGraph(uri)
+	schema := schemaOf(uri)
+	variables := graphVariables(graph)
+	assignments := make(map[string]colorOption)
+	for _, doc := range graph {
+		walkAssignmentsWithPath(doc.Document, []string{}, func(path []string, assignment parser.Assignment) {
+			key := strings.Join(path, ":")
+			assignments[key] = colorOption{Path: key, Assignment: &assignment, URI: doc.URI, Colors: colorsOf(expandCustomVariables(assignment.ValueRaw, variables))}
+		})
+	}
+	resolve := func(paths []string) (colorOption, bool) {
+		for _, path := range paths {
+			if option, ok := assignments[path]; ok && len(option.Colors) > 0 {
+				return option, true
+			}
+			components := strings.Split(path, ":")
+			variable := schema.FindVariableDefinitionInSection(components[len(components)-2], components[len(components)-1])
+			if variable == nil {
+				continue
+			}
+			if colors := colorsOf(variable.Default); len(colors) > 0 {
+				return colorOption{Path: path, Colors: colors}, true
+			}
+		}
+		return colorOption{}, false
+	}
+
+	for _, pair := range contrastPairs {
+		foreground, foundForeground := resolve(pair.Foreground)
+		background, foundBackground := resolve(pair.Background)
+		if !foundForeground || !foundBackground {
+			continue
+		}
+		ratio := lowestContrastRatio(foreground.Colors, background.Colors)
+		if ratio >= pair.Minimum {
+			continue
+		}
+		for _, option := range []colorOption{foreground, background} {
+			if option.Assignment == nil || option.URI != uri {
+				continue
+			}
+			_, rang := valueRange(lines, option.Assignment.Position.Line)
+			problems = append(problems, configProblem{Diagnostic: protocol.Diagnostic{
+				Range:    rang,
+				Severity: protocol.DiagnosticSeverityWarning,
+				Source:   diagnosticsSource,
+				Message: fmt.Sprintf("the contrast between %s and %s is %.2f:1, below %g:1: %s",
+					foreground.Path, background.Path, ratio, pair.Minimum, pair.Concern),
+			}})
+		}
+	}
+	return problems
+}
+
+// colorsOf decodes the colors of a color or gradient value.
+func colorsOf(raw string) []color.RGBA {
+	colors := make([]color.RGBA, 0)
+	for _, token := range parser.GradientTokens(raw) {
+		if c, err := parser.ParseColor(token.Text); err == nil {
+			colors = append(colors, c)
+		}
+	}
+	return colors
+}
+
+func lowestContrastRatio(foreground, background []color.RGBA) float64 {
+	lowest := math.Inf(1)
+	for _, f := range foreground {
+		for _, b := range background {
+			lowest = min(lowest, contrastRatio(f, b))
+		}
+	}
+	return lowest
+}
+
+// contrastRatio is the WCAG 2 contrast ratio between two colors, from 1 to 21.
+// The background is composited over black and the foreground over the background.
+func contrastRatio(foreground, background color.RGBA) float64 {
+	black := color.RGBA{A: 0xff}
+	bg := composite(background, black)
+	lighter, darker := relativeLuminance(composite(foreground, bg)), relativeLuminance(bg)
+	if lighter < darker {
+		lighter, darker = darker, lighter
+	}
+	return (lighter + 0.05) / (darker + 0.05)
+}
+
+func composite(top, bottom color.RGBA) color.RGBA {
+	alpha := float64(top.A) / 255
+	blend := func(t, b uint8) uint8 {
+		return uint8(math.Round(float64(t)*alpha + float64(b)*(1-alpha)))
+	}
+	return color.RGBA{R: blend(top.R, bottom.R), G: blend(top.G, bottom.G), B: blend(top.B, bottom.B), A: 0xff}
+}
+
+// relativeLuminance is defined at https://www.w3.org/TR/WCAG21/#dfn-relative-luminance
+func relativeLuminance(c color.RGBA) float64 {
+	linear := func(component uint8) float64 {
+		s := float64(component) / 255
+		if s <= 0.04045 {
+			return s / 12.92
+		}
+		return math.Pow((s+0.055)/1.055, 2.4)
+	}
+	return 0.2126*linear(c.R) + 0.7152*linear(c.G) + 0.0722*linear(c.B)
+}
@@ -0,0 +1,55 @@
+package hyprls
+
+import (
+	"image/color"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestContrastRatio(t *testing.T) {
+	cases := []struct {
+		foreground, background color.RGBA
+		expected               float64
+	}{
+		{color.RGBA{0, 0, 0, 0xff}, color.RGBA{0xff, 0xff, 0xff, 0xff}, 21},
+		{color.RGBA{0x77, 0x77, 0x77, 0xff}, color.RGBA{0xff, 0xff, 0xff, 0xff}, 4.48},
+		{color.RGBA{0xff, 0xff, 0xff, 0xff}, color.RGBA{0xff, 0xff, 0xff, 0}, 21},
+	}
+	for _, c := range cases {
+		if ratio := contrastRatio(c.foreground, c.background); math.Abs(ratio-c.expected) > 0.01 {
+			t.Errorf("contrast between %v and %v: expected %g, got %g", c.foreground, c.background, c.expected, ratio)
+		}
+	}
+}
+
+func TestContrastProblems(t *testing.T) {
+	contents := `group {
+    groupbar {
+        text_color = rgb(ffffff)
+        col.active = rgb(eeeeee)
+        col.inactive = rgb(222222)
+    }
+}
+`
+	uri := openTestConfig(t, contents)
+	document, err := parse(uri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(contents, "\n")
+
+	if problems := contrastProblems(uri, document, lines); len(problems) > 0 {
+		t.Errorf("expected contrast diagnostics to be opt-in, got %+v", problems)
+	}
+
+	contrastDiagnostics = true
+	t.Cleanup(func() { contrastDiagnostics = false })
+	reported := make(map[uint32]string)
+	for _, problem := range contrastProblems(uri, document, lines) {
+		reported[problem.Diagnostic.Range.Start.Line] = problem.Diagnostic.Message
+	}
+	if len(reported) != 2 || !strings.HasPrefix(reported[2], "the contrast between group:groupbar:text_color and group:groupbar:col.active is 1.16:1, below 4.5:1") || reported[3] != reported[2] {
+		t.Errorf("expected text_color and col.active to be reported, got %v", reported)
+	}
+}
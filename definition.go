@@ -0,0 +1,33 @@
+package hyprls
+
+import (
+	"context"
+
+	"go.lsp.dev/protocol"
+)
+
+func (h Handler) Definition(ctx context.Context, params *protocol.DefinitionParams) ([]protocol.Location, error) {
+	if isFileIgnored(params.TextDocument.URI) {
+		return nil, nil
+	}
+	document, err := parse(params.TextDocument.URI)
+	if err != nil {
+		return nil, nil
+	}
+
+	stmt, argument := statementAt(document, params.Position)
+	if stmt == nil {
+		return nil, nil
+	}
+
+	switch {
+	case stmt.Keyword == "animation" && argument == animationArgCurve:
+		return animationCurveDefinitions(params.TextDocument.URI, *stmt), nil
+	}
+
+	if name, ok := submapNameAt(params.TextDocument.URI, *stmt, argument); ok && stmt.Keyword != "submap" {
+		return submapDefinitions(params.TextDocument.URI, name), nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,67 @@
+package hyprls
+
+import (
+	"context"
+
+	"github.com/hyprland-community/hyprls/parser"
+	"go.lsp.dev/protocol"
+	"go.uber.org/zap"
+)
+
+// diagnosticsSource is shown by clients next to each diagnostic message.
+const diagnosticsSource = "hyprls"
+
+// diagnosticsProvider computes diagnostics for a parsed document.
+type diagnosticsProvider func(uri protocol.URI, document parser.Section) []protocol.Diagnostic
+
+var diagnosticsProviders = []diagnosticsProvider{
+	windowRuleRegexDiagnostics,
+	animationDiagnostics,
+	keybindingDiagnostics,
+	submapDiagnostics,
+	quickFixableDiagnostics,
+}
+
+func diagnose(uri protocol.URI) ([]protocol.Diagnostic, error) {
+	document, err := parse(uri)
+	if err != nil {
+		return nil, err
+	}
+
+	diagnostics := make([]protocol.Diagnostic, 0)
+	for _, provider := range diagnosticsProviders {
+		diagnostics = append(diagnostics, provider(uri, document)...)
+	}
+	return diagnostics, nil
+}
+
+func (h Handler) publishDiagnostics(ctx context.Context, uri protocol.URI) {
+	if h.Client == nil || isFileIgnored(uri) {
+		return
+	}
+
+	diagnostics, err := diagnose(uri)
+	if err != nil {
+		logger.Warn("could not compute diagnostics", zap.String("uri", string(uri)), zap.Error(err))
+		return
+	}
+
+	err = h.Client.PublishDiagnostics(ctx, &protocol.PublishDiagnosticsParams{
+		URI:         uri,
+		Diagnostics: diagnostics,
+	})
+	if err != nil {
+		logger.Warn("could not publish diagnostics", zap.String("uri", string(uri)), zap.Error(err))
+	}
+}
+
+func (h Handler) clearDiagnostics(ctx context.Context, uri protocol.URI) {
+	if h.Client == nil {
+		return
+	}
+
+	h.Client.PublishDiagnostics(ctx, &protocol.PublishDiagnosticsParams{
+		URI:         uri,
+		Diagnostics: []protocol.Diagnostic{},
+	})
+}
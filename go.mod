@@ -7,6 +7,7 @@ require (
 	github.com/PuerkitoBio/goquery v1.12.0
 	github.com/davecgh/go-spew v1.1.1
 	go.lsp.dev/jsonrpc2 v0.10.0
+	go.lsp.dev/uri v0.3.0
 	go.uber.org/multierr v1.11.0
 	go.uber.org/zap v1.28.0
 )
@@ -14,7 +15,6 @@ require (
 require (
 	github.com/andybalholm/cascadia v1.3.3 // indirect
 	go.lsp.dev/pkg v0.0.0-20210717090340-384b27a52fb2 // indirect
-	go.lsp.dev/uri v0.3.0 // indirect
 	golang.org/x/net v0.52.0 // indirect
 	golang.org/x/text v0.35.0 // indirect
 )
@@ -22,7 +22,6 @@ require (
 require (
 	github.com/anaskhan96/soup v1.2.5
 	github.com/evorts/html-to-markdown v0.0.10
-	github.com/mazznoer/csscolorparser v0.1.8
 	github.com/metal3d/go-slugify v0.0.0-20160607203414-7ac2014b2f23
 	github.com/segmentio/asm v1.2.0 // indirect
 	github.com/segmentio/encoding v0.4.0 // indirect
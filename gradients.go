@@ -0,0 +1,148 @@
+package hyprls
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hyprland-community/hyprls/parser"
+	"go.lsp.dev/protocol"
+)
+
+// maxGradientColors is the number of colors Hyprland accepts in a gradient.
+const maxGradientColors = 10
+
+// angleDegreesPattern matches what Hyprland reads as the degrees of an angle: the integer at the start of the text before deg
+var angleDegreesPattern = regexp.MustCompile(`^[+-]?\d+`)
+var degreeSignPattern = regexp.MustCompile(`^(-?\d+(?:\.\d+)?)°$`)
+var bareNumberPattern = regexp.MustCompile(`^\d+(?:\.\d+)?$`)
+
+// normalizedAngle writes degrees as an angle from 0 to 359deg.
+func normalizedAngle(degrees int) string {
+	return fmt.Sprintf("%ddeg", (degrees%360+360)%360)
+}
+
+// gradientProblems reports colors Hyprland can't read in gradients, malformed angles and gradients with too many colors.
+func gradientProblems(uri protocol.URI, document parser.Section, lines []string) []configProblem {
+	problems := make([]configProblem, 0)
+	schema := schemaOf(uri)
+	walkAssignmentsWithPath(document, []string{}, func(path []string, assignment parser.Assignment) {
+		if len(path) < 2 || isFreeformPath(path) {
+			return
+		}
+		variable := schema.FindVariableDefinitionInSection(path[len(path)-2], path[len(path)-1])
+		if variable == nil || variable.Type != "gradient" {
+			return
+		}
+		text, rang := valueRange(lines, assignment.Position.Line)
+		if text == "" || strings.Contains(text, "$") {
+			return
+		}
+
+		problem := func(token parser.GradientToken, severity protocol.DiagnosticSeverity, message string, fixes ...quickFix) {
+			problems = append(problems, configProblem{
+				Diagnostic: protocol.Diagnostic{
+					Range:    tokenRange(rang, token),
+					Severity: severity,
+					Source:   diagnosticsSource,
+					Message:  message,
+				},
+				Fixes: fixes,
+			})
+		}
+		replace := func(token parser.GradientToken, replacement string) quickFix {
+			return quickFix{
+				Title: fmt.Sprintf("Replace with %s", replacement),
+				Edits: []protocol.TextEdit{{Range: tokenRange(rang, token), NewText: replacement}},
+			}
+		}
+
+		tokens := parser.GradientTokens(text)
+		colors := 0
+		for i, token := range tokens {
+			// Like Hyprland, stop at the first token containing deg, that is the angle
+			if before, _, isAngle := strings.Cut(token.Text, "deg"); isAngle {
+				degrees := angleDegreesPattern.FindString(before)
+				switch value, err := strconv.Atoi(degrees); {
+				case err != nil:
+					problem(token, protocol.DiagnosticSeverityError, fmt.Sprintf("invalid angle %s, expected a whole number of degrees, such as 45deg", token.Text))
+				case token.Text != degrees+"deg" || value < 0 || value >= 360:
+					problem(token, protocol.DiagnosticSeverityHint, fmt.Sprintf("%s is read as %d degrees, the same angle as %s", token.Text, value, normalizedAngle(value)), replace(token, normalizedAngle(value)))
+				}
+				if i < len(tokens)-1 {
+					last := tokens[len(tokens)-1]
+					ignored := tokenRange(rang, tokens[i+1])
+					ignored.End = tokenRange(rang, last).End
+					problems = append(problems, configProblem{Diagnostic: protocol.Diagnostic{
+						Range:    ignored,
+						Severity: protocol.DiagnosticSeverityWarning,
+						Source:   diagnosticsSource,
+						Message:  "ignored, Hyprland stops reading the gradient at its angle",
+						Tags:     []protocol.DiagnosticTag{protocol.DiagnosticTagUnnecessary},
+					}})
+				}
+				break
+			}
+
+			if _, err := parser.ParseColor(token.Text); err == nil {
+				colors++
+				if colors > maxGradientColors {
+					problem(token, protocol.DiagnosticSeverityError, fmt.Sprintf("gradients can have at most %d colors", maxGradientColors))
+				}
+				continue
+			}
+
+			switch {
+			case degreeSignPattern.MatchString(token.Text):
+				degrees, _ := strconv.ParseFloat(degreeSignPattern.FindStringSubmatch(token.Text)[1], 64)
+				problem(token, protocol.DiagnosticSeverityError, fmt.Sprintf("%s is read as a color, angles are written in deg, such as 45deg", token.Text), replace(token, normalizedAngle(int(degrees))))
+			case bareNumberPattern.MatchString(token.Text) && i == len(tokens)-1 && i > 0:
+				problem(token, protocol.DiagnosticSeverityWarning, fmt.Sprintf("%s is read as a color, did you mean %sdeg?", token.Text, token.Text), replace(token, token.Text+"deg"))
+			default:
+				problem(token, protocol.DiagnosticSeverityError, fmt.Sprintf("%s is not a color nor an angle", token.Text))
+			}
+		}
+		if colors == 0 && len(tokens) > 0 {
+			problems = append(problems, configProblem{Diagnostic: protocol.Diagnostic{
+				Range:    rang,
+				Severity: protocol.DiagnosticSeverityError,
+				Source:   diagnosticsSource,
+				Message:  "gradients need at least one color",
+			}})
+		}
+	})
+	return problems
+}
+
+// tokenRange returns the range of a token of the gradient written at rang.
+func tokenRange(rang protocol.Range, token parser.GradientToken) protocol.Range {
+	start := rang.Start.Character + uint32(token.Start)
+	return protocol.Range{
+		Start: protocol.Position{Line: rang.Start.Line, Character: start},
+		End:   protocol.Position{Line: rang.Start.Line, Character: start + uint32(len(token.Text))},
+	}
+}
+
+// gradientArrows point in the direction colors go, for angles that are multiples of 45 degrees, starting from 0deg.
+var gradientArrows = []string{"→", "↘", "↓", "↙", "←", "↖", "↑", "↗"}
+
+// gradientAngleInlayHints shows the direction of gradients with an arrow after their angle.
+func gradientAngleInlayHints(document parser.Section) []inlayHint {
+	hints := make([]inlayHint, 0)
+	document.WalkValues(func(a *parser.Assignment, v *parser.Value) {
+		if v.Kind != parser.Gradient || !v.Gradient.HasAngle() {
+			return
+		}
+		direction := int(math.Round(float64(v.Gradient.Angle)/45)) % len(gradientArrows)
+		hints = append(hints, inlayHint{
+			Position:    v.Gradient.AngleEnd.LSP(),
+			Label:       gradientArrows[direction],
+			Kind:        inlayHintKindParameter,
+			Tooltip:     fmt.Sprintf("Colors go in this direction, from the first to the last one (%gdeg)", v.Gradient.Angle),
+			PaddingLeft: true,
+		})
+	})
+	return hints
+}
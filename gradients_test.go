@@ -0,0 +1,75 @@
+package hyprls
+
+import (
+	"strings"
+	"testing"
+
+	"go.lsp.dev/protocol"
+)
+
+func TestGradients(t *testing.T) {
+	contents := `general {
+    col.active_border = rgba(33ccffee) rgba(0, 255, 153, 0.9) 45deg
+    col.inactive_border = rgb(ff0000) nope 90deg
+    col.nogroup_border = rgb(000000) rgb(111111) rgb(222222) rgb(333333) rgb(444444) rgb(555555) rgb(666666) rgb(777777) rgb(888888) rgb(999999) rgb(aaaaaa)
+    col.nogroup_border_active = rgb(ff0000) 45deg rgb(00ff00) 90deg
+}
+group {
+    col.border_locked_active = rgb(ff0000) rgb(00ff00) -45deg
+}
+`
+	uri := openTestConfig(t, contents)
+	document, err := parse(uri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(contents, "\n")
+	text := func(rang protocol.Range) string {
+		return lines[rang.Start.Line][rang.Start.Character:rang.End.Character]
+	}
+
+	problems := gradientProblems(uri, document, lines)
+	if len(problems) != 4 {
+		t.Fatalf("expected 4 problems, got %+v", problems)
+	}
+	if got := text(problems[0].Diagnostic.Range); got != "nope" {
+		t.Errorf("expected the stray token to be reported, got %q", got)
+	}
+	if got := text(problems[1].Diagnostic.Range); got != "rgb(aaaaaa)" {
+		t.Errorf("expected the 11th color to be reported, got %q", got)
+	}
+	if got := text(problems[2].Diagnostic.Range); got != "rgb(00ff00) 90deg" || problems[2].Diagnostic.Severity != protocol.DiagnosticSeverityWarning {
+		t.Errorf("expected everything after the first angle to be reported as ignored, got %q", got)
+	}
+	if got := text(problems[3].Diagnostic.Range); got != "-45deg" || problems[3].Diagnostic.Severity != protocol.DiagnosticSeverityHint {
+		t.Errorf("expected a hint for the negative angle, got %q", got)
+	}
+
+	hints := gradientAngleInlayHints(document)
+	// The invalid gradient has no angle hint
+	if len(hints) != 1 || hints[0].Label != "↘" || hints[0].Position.Character != uint32(len(lines[1])) {
+		t.Errorf("expected a ↘ hint after 45deg, got %+v", hints)
+	}
+
+	colors, err := Handler{}.DocumentColor(t.Context(), &protocol.DocumentColorParams{
+		TextDocument: protocol.TextDocumentIdentifier{URI: uri},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := text(colors[1].Range); got != "rgba(0, 255, 153, 0.9)" {
+		t.Fatalf("expected the second stop to have its own swatch, got %q", got)
+	}
+	presentations, err := Handler{}.ColorPresentation(t.Context(), &protocol.ColorPresentationParams{
+		TextDocument: protocol.TextDocumentIdentifier{URI: uri},
+		Color:        protocol.Color{Red: 1, Alpha: 0.5},
+		Range:        colors[1].Range,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	after := applyTextEdits(contents, []protocol.TextEdit{*presentations[0].TextEdit})
+	if !strings.Contains(after, "col.active_border = rgba(33ccffee) rgba(255, 0, 0, 0.5) 45deg\n") {
+		t.Errorf("expected only the second stop to be rewritten, keeping its syntax, got:\n%s", after)
+	}
+}
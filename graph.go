@@ -0,0 +1,179 @@
+package hyprls
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hyprland-community/hyprls/parser"
+	"go.lsp.dev/protocol"
+	lspuri "go.lsp.dev/uri"
+)
+
+// mainConfigFilename is the file Hyprland loads its configuration from, which sources all the other ones.
+const mainConfigFilename = "hyprland.conf"
+
+// configDocument is a parsed configuration file, part of a configuration graph.
+type configDocument struct {
+	URI      protocol.URI
+	Document parser.Section
+}
+
+// configGraph returns the document at uri along with every document that is part of the same configuration:
+// the main hyprland.conf found in uri's directory or one of its parents, and every file reachable from it or from uri through source = ... statements.
+// Each document is followed by the documents it sources. Use walkConfigStatements to visit statements in the order Hyprland loads them.
+func configGraph(uri protocol.URI) []configDocument {
+	visited := make(map[protocol.URI]bool)
+	documents := make([]configDocument, 0)
+
+	if root, found := mainConfigOf(uri); found {
+		documents = append(documents, followSources(root, visited)...)
+	}
+	if !visited[uri] {
+		documents = append(documents, followSources(uri, visited)...)
+	}
+
+	return documents
+}
+
+func followSources(uri protocol.URI, visited map[protocol.URI]bool) []configDocument {
+	if visited[uri] || isFileIgnored(uri) {
+		return []configDocument{}
+	}
+	visited[uri] = true
+
+	document, err := parseGraphFile(uri)
+	if err != nil {
+		return []configDocument{}
+	}
+
+	documents := []configDocument{{URI: uri, Document: document}}
+	document.WalkStatements(func(stmt *parser.Statement) {
+		if stmt.Keyword != "source" {
+			return
+		}
+		for _, sourced := range resolveSourcePaths(uri, stmt.ValueRaw) {
+			documents = append(documents, followSources(sourced, visited)...)
+		}
+	})
+	return documents
+}
+
+// walkConfigStatements calls f on every statement of the graph in the order Hyprland evaluates them:
+// sourced files are walked in place of the source = ... statement that includes them.
+func walkConfigStatements(graph []configDocument, f func(doc configDocument, stmt *parser.Statement)) {
+	documents := make(map[protocol.URI]configDocument, len(graph))
+	for _, doc := range graph {
+		documents[doc.URI] = doc
+	}
+
+	visited := make(map[protocol.URI]bool)
+	var walk func(doc configDocument)
+	walk = func(doc configDocument) {
+		visited[doc.URI] = true
+		doc.Document.WalkStatements(func(stmt *parser.Statement) {
+			f(doc, stmt)
+			if stmt.Keyword != "source" {
+				return
+			}
+			for _, sourced := range resolveSourcePaths(doc.URI, stmt.ValueRaw) {
+				if sourcedDoc, ok := documents[sourced]; ok && !visited[sourced] {
+					walk(sourcedDoc)
+				}
+			}
+		})
+	}
+
+	for _, doc := range graph {
+		if !visited[doc.URI] {
+			walk(doc)
+		}
+	}
+}
+
+// parseGraphFile parses a file of the configuration graph, preferring the contents of the editor if it is opened there.
+func parseGraphFile(uri protocol.URI) (parser.Section, error) {
+	contents, err := graphFile(uri)
+	if err != nil {
+		return parser.Section{}, err
+	}
+	return parser.Parse(contents)
+}
+
+// graphFile returns the contents of a file of the configuration graph.
+// Unlike file, it does not cache contents read from disk, since the file is not synced with the client.
+func graphFile(uri protocol.URI) (string, error) {
+	if contents, ok := openedFiles[uri]; ok {
+		return contents, nil
+	}
+
+	contents, err := os.ReadFile(uri.Filename())
+	if err != nil {
+		return "", err
+	}
+	return string(contents), nil
+}
+
+// resolveSourcePaths returns the files a source = ... statement in the file at uri refers to.
+// Paths can start with ~, be relative to the sourcing file's directory and contain glob patterns.
+func resolveSourcePaths(uri protocol.URI, path string) []protocol.URI {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return []protocol.URI{}
+	}
+
+	if rest, ok := strings.CutPrefix(path, "~"); ok {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return []protocol.URI{}
+		}
+		path = filepath.Join(home, rest)
+	} else if !filepath.IsAbs(path) {
+		path = filepath.Join(filepath.Dir(uri.Filename()), path)
+	}
+
+	matches, err := filepath.Glob(path)
+	if err != nil {
+		return []protocol.URI{}
+	}
+
+	uris := make([]protocol.URI, 0, len(matches))
+	for _, match := range matches {
+		uris = append(uris, lspuri.File(match))
+	}
+	return uris
+}
+
+// mainConfigOf finds the hyprland.conf file that is the closest to uri, looking in uri's directory and then in its parents.
+func mainConfigOf(uri protocol.URI) (protocol.URI, bool) {
+	directory := filepath.Dir(uri.Filename())
+	for {
+		candidate := filepath.Join(directory, mainConfigFilename)
+		if stat, err := os.Stat(candidate); err == nil && !stat.IsDir() {
+			return lspuri.File(candidate), true
+		}
+
+		parent := filepath.Dir(directory)
+		if parent == directory {
+			return "", false
+		}
+		directory = parent
+	}
+}
+
+// graphVariables returns the custom variables declared in the configuration graph, in the order Hyprland reads them.
+// References to undeclared names fall back to environment variables.
+func graphVariables(graph []configDocument) parser.Variables {
+	documents := make([]parser.Section, 0, len(graph))
+	for _, doc := range graph {
+		documents = append(documents, doc.Document)
+	}
+	variables := parser.VariablesOf(documents...)
+	variables.LookupEnv = os.LookupEnv
+	return variables
+}
+
+// expandCustomVariables replaces references to variables in raw with their values. Undefined references are left as is.
+func expandCustomVariables(raw string, variables parser.Variables) string {
+	return variables.Expand(raw).Value
+}
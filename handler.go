@@ -12,15 +12,17 @@ import (
 
 type Handler struct {
 	Server protocol.Server
+	Client protocol.Client
 	Logger *zap.Logger
 }
 
 type GlobalContextKey string
 
-func NewHandler(ctx context.Context, server protocol.Server, logger *zap.Logger) (Handler, context.Context, error) {
+func NewHandler(ctx context.Context, server protocol.Server, client protocol.Client, logger *zap.Logger) (Handler, context.Context, error) {
 
 	return Handler{
 		Server: server,
+		Client: client,
 		Logger: logger,
 	}, context.WithValue(ctx, GlobalContextKey("state"), state{}), nil
 }
@@ -91,13 +93,37 @@ func (h Handler) Initialize(ctx context.Context, params *protocol.InitializePara
 	Ignores = igs
 	logger.Info("Ignoring files", zap.Any("ignores", Ignores))
 
+	if options, ok := params.InitializationOptions.(map[string]any); ok {
+		if version, ok := extractHyprlandVersion(options); ok {
+			configuredHyprlandVersion = version
+			logger.Info("Using documentation for Hyprland version", zap.String("version", version))
+		}
+		if mode, ok := extractDefaultValueHints(options); ok {
+			defaultValueHints = mode
+		}
+		if enabled, ok := extractContrastDiagnostics(options); ok {
+			contrastDiagnostics = enabled
+		}
+	}
+
 	return &protocol.InitializeResult{
 		Capabilities: protocol.ServerCapabilities{
 			HoverProvider:          true,
+			DefinitionProvider:     true,
+			ReferencesProvider:     true,
 			DocumentSymbolProvider: true,
 			ColorProvider:          true,
+			CodeLensProvider: &protocol.CodeLensOptions{
+				ResolveProvider: true,
+			},
+			CodeActionProvider: &protocol.CodeActionOptions{
+				CodeActionKinds: codeActionKinds,
+			},
+			ExecuteCommandProvider: &protocol.ExecuteCommandOptions{
+				Commands: commands,
+			},
 			CompletionProvider: &protocol.CompletionOptions{
-				ResolveProvider:   false,
+				ResolveProvider:   true,
 				TriggerCharacters: []string{},
 			},
 			TextDocumentSync: protocol.TextDocumentSyncOptions{
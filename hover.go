@@ -2,82 +2,160 @@ package hyprls
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
+	"image/color"
 	"strings"
 
 	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/hyprland-community/hyprls/parser"
 	parser_data "github.com/hyprland-community/hyprls/parser/data"
 	"go.lsp.dev/protocol"
 )
 
 func (h Handler) Hover(ctx context.Context, params *protocol.HoverParams) (*protocol.Hover, error) {
-	if isFileIgnored(params.TextDocument.URI) {
+	uri := params.TextDocument.URI
+	if isFileIgnored(uri) {
 		return nil, nil
 	}
-	line, err := currentLine(params.TextDocument.URI, params.Position)
+	document, err := parse(uri)
 	if err != nil {
-		return nil, fmt.Errorf("while getting current line of file: %w", err)
+		return nil, nil
+	}
+	contents, err := file(uri)
+	if err != nil {
+		return nil, fmt.Errorf("while getting contents of file: %w", err)
+	}
+	if r := ruleRegexAt(document, params.Position); r != nil {
+		return ruleRegexHover(*r), nil
 	}
 
-	if !strings.Contains(line, "=") {
+	schema := schemaOf(uri)
+	variables := graphVariables(configGraph(uri))
+	tok := tokenAt(document, strings.Split(contents, "\n"), variables, params.Position)
+	value := ""
+	switch tok.Kind {
+	case tokenSectionHeader:
+		value = sectionHoverContents(schema, tok.Section)
+	case tokenOptionKey:
+		value = optionHoverContents(schema, tok.Option)
+	case tokenOptionValue:
+		value = optionValueHoverContents(schema, tok.Option, tok.Text)
+	case tokenVariableDeclaration:
+		value = variableHoverContents(parser.Reference{Name: tok.Variable.Key, Declared: true}, variables)
+	case tokenVariableReference:
+		value = variableHoverContents(tok.Reference, variables)
+	case tokenColor:
+		value = colorHoverContents(tok.Color)
+	case tokenKeyword:
+		value = keywordHoverContents(tok.Text)
+	case tokenArgument:
+		if hover := bezierHoverAt(uri, *tok.Statement, tok.Argument); hover != nil {
+			return hover, nil
+		}
+		value = argumentHoverContents(*tok.Statement, tok.Argument, expandCustomVariables(tok.Text, variables))
+	}
+	if value == "" {
 		return nil, nil
 	}
+	return &protocol.Hover{
+		Contents: protocol.MarkupContent{
+			Kind:  protocol.Markdown,
+			Value: value,
+		},
+		Range: &tok.Range,
+	}, nil
+}
 
-	// key is word before the equal sign. [0] is safe since we checked for "=" above
-	key := strings.TrimSpace(strings.Split(line, "=")[0])
+// optionDefinition documents the option at path, such as [decoration blur enabled].
+// Options written outside of any section are looked up in the root section.
+func optionDefinition(schema parser_data.Schema, path []string) *parser_data.VariableDefinition {
+	if len(path) == 0 || isFreeformPath(path) {
+		return nil
+	}
+	sectionPath := path[:len(path)-1]
+	if len(sectionPath) == 0 {
+		sectionPath = []string{parser.RootSection}
+	}
+	section := schema.FindSectionDefinitionByPath(sectionPath)
+	if section == nil {
+		return nil
+	}
+	return section.VariableDefinition(path[len(path)-1])
+}
 
-	indexOfFirstNonWhitespace := strings.IndexFunc(line, func(r rune) bool {
-		return r != ' ' && r != '\t'
-	})
-	indexOfLastNonWhitespace := strings.LastIndexFunc(line, func(r rune) bool {
-		return r != ' ' && r != '\t'
-	}) + 1
+func optionHoverContents(schema parser_data.Schema, path []string) string {
+	def := optionDefinition(schema, path)
+	if def == nil {
+		return ""
+	}
+	boundsLine := ""
+	if def.Bounds != nil {
+		boundsLine = fmt.Sprintf("- Accepts values from %s\n", def.Bounds)
+	}
+	return heredoc.Docf(`### %s: %s (%s)
+		%s
 
-	for _, section := range parser_data.Sections {
-		if def := section.VariableDefinition(key); def != nil {
-			return &protocol.Hover{
-				Contents: protocol.MarkupContent{
-					Kind: protocol.Markdown,
-					Value: heredoc.Docf(`### %s: %s (%s)
-						%s
-						
-						- Defaults to: %s
-					`, strings.Join(section.Path, ":"), def.Name, def.Type, def.Description, def.PrettyDefault()),
-				},
-				Range: &protocol.Range{
-					Start: protocol.Position{
-						Line:      params.Position.Line,
-						Character: uint32(indexOfFirstNonWhitespace),
-					},
-					End: protocol.Position{
-						Line:      params.Position.Line,
-						Character: uint32(indexOfLastNonWhitespace),
-					},
-				},
-			}, nil
-		} else if kw, found := parser_data.FindKeyword(key); found {
-			flagsLine := ""
-			if len(kw.Flags) > 0 {
-				flagsLine = fmt.Sprintf("\n- Accepts the following flags: %s\n", strings.Join(kw.Flags, ", "))
-			}
-			return &protocol.Hover{
-				Contents: protocol.MarkupContent{
-					Kind:  protocol.Markdown,
-					Value: fmt.Sprintf("### %s [[docs]](%s)%s\n%s", kw.Name, kw.DocumentationLink(), flagsLine, kw.Description),
-				},
-				Range: &protocol.Range{
-					Start: protocol.Position{
-						Line:      params.Position.Line,
-						Character: uint32(indexOfFirstNonWhitespace),
-					},
-					End: protocol.Position{
-						Line:      params.Position.Line,
-						Character: uint32(indexOfLastNonWhitespace),
-					},
-				},
-			}, nil
+		- Defaults to: %s
+	`, strings.Join(path[:len(path)-1], ":"), def.Name, def.Type, def.Description, def.PrettyDefault()) + boundsLine
+}
+
+// optionValueHoverContents documents the option, starting with the meaning of the value when the option lists the values it accepts.
+func optionValueHoverContents(schema parser_data.Schema, path []string, value string) string {
+	contents := optionHoverContents(schema, path)
+	if contents == "" {
+		return ""
+	}
+	if allowed, found := optionDefinition(schema, path).AllowedValue(value); found && allowed.Description != "" {
+		return fmt.Sprintf("`%s`: %s\n\n---\n\n%s", value, allowed.Description, contents)
+	}
+	return contents
+}
+
+func keywordHoverContents(key string) string {
+	kw, found := parser_data.FindKeyword(key)
+	if !found {
+		return ""
+	}
+	flagsLine := ""
+	if len(kw.Flags) > 0 {
+		flagsLine = fmt.Sprintf("\n- Accepts the following flags: %s\n", strings.Join(kw.Flags, ", "))
+	}
+	return fmt.Sprintf("### %s [[docs]](%s)%s\n%s", kw.Name, kw.DocumentationLink(), flagsLine, kw.Description)
+}
+
+// variableHoverContents shows the value of a custom variable, and what it expands to.
+func variableHoverContents(reference parser.Reference, variables parser.Variables) string {
+	heading := fmt.Sprintf("### $%s\n\n", reference.Name)
+	raw, declared := variables.Lookup(reference.Name)
+	switch {
+	case declared:
+		contents := heading + fmt.Sprintf("```ini\n$%s = %s\n```\n", reference.Name, raw)
+		expansion := variables.Expand("${" + reference.Name + "}")
+		switch {
+		case len(expansion.Cyclic) > 0:
+			contents += "\nCan't be expanded, its value references itself\n"
+		case expansion.Value != raw:
+			contents += fmt.Sprintf("\nExpands to `%s`\n", expansion.Value)
 		}
+		return contents
+	case reference.Environment:
+		value, _ := variables.LookupEnv(reference.Name)
+		return heading + fmt.Sprintf("Environment variable, currently `%s`\n", value)
+	case reference.HasFallback:
+		return heading + fmt.Sprintf("Undefined variable, `%s` is used instead\n", reference.Fallback)
 	}
+	return heading + "Undefined variable\n"
+}
 
-	return nil, nil
+// colorHoverContents shows a swatch of the color, and how to write it in every syntax.
+func colorHoverContents(c color.RGBA) string {
+	lspColor := (&parser.Value{Kind: parser.Color, Color: c}).LSPColor()
+	literals := make([]string, 0)
+	for _, literal := range colorLiterals(lspColor, colorSyntaxUnknown) {
+		literals = append(literals, "- `"+literal+"`")
+	}
+	swatch := fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" width="48" height="24"><rect width="48" height="24" fill="#%02x%02x%02x" fill-opacity="%.3f"/></svg>`, c.R, c.G, c.B, float64(c.A)/255)
+	return fmt.Sprintf("### Color\n\n![#%02x%02x%02x%02x](data:image/svg+xml;base64,%s)\n\n%s\n\n- Opacity: %.0f%%\n",
+		c.R, c.G, c.B, c.A, base64.StdEncoding.EncodeToString([]byte(swatch)), strings.Join(literals, "\n"), float64(c.A)/255*100)
 }
@@ -0,0 +1,85 @@
+package hyprls
+
+import (
+	"strings"
+	"testing"
+
+	"go.lsp.dev/protocol"
+)
+
+func TestHover(t *testing.T) {
+	contents := `$mainMod = SUPER
+$accent = rgba(33ccffee)
+decoration {
+    blur {
+        enabled = true
+    }
+}
+general {
+    col.active_border = $accent rgb(00ff99) 45deg
+    layout = master
+}
+bind = $mainMod SHIFT, code:24, killactive,
+bind = , mouse:272, exec, kitty
+animation = windows, 1, 5, default
+misc:vrr = 2
+bind = , XF86AudioRaiseVolum, exec, wpctl set-volume @DEFAULT_AUDIO_SINK@ 5%+
+bind = , xf86audiomute, exec, wpctl set-mute @DEFAULT_AUDIO_SINK@ toggle
+`
+	uri := openTestConfig(t, contents)
+
+	lines := strings.Split(contents, "\n")
+	at := func(line int, text string) protocol.Position {
+		column := strings.Index(lines[line], text)
+		if column < 0 {
+			t.Fatalf("%q is not on line %d", text, line)
+		}
+		return protocol.Position{Line: uint32(line), Character: uint32(column + 1)}
+	}
+
+	cases := []struct {
+		name     string
+		position protocol.Position
+		expected []string
+		span     string
+	}{
+		{"option key with its section", at(4, "enabled"), []string{"### decoration:blur: enabled (bool)"}, "enabled"},
+		{"option value", at(9, "master"), []string{"### general: layout (str)"}, "master"},
+		{"allowed value", at(14, "2"), []string{"`2`: fullscreen only", "### misc: vrr (int)"}, "2"},
+		{"variable reference", at(8, "$accent"), []string{"### $accent", "$accent = rgba(33ccffee)"}, "$accent"},
+		{"variable declaration", at(0, "$mainMod"), []string{"### $mainMod", "$mainMod = SUPER"}, "$mainMod"},
+		{"color of a gradient", at(8, "rgb(00ff99)"), []string{"### Color", "`rgb(00ff99)`", "`rgba(00ff99ff)`"}, "rgb(00ff99)"},
+		{"keyword", at(11, "bind"), []string{"### bind [[docs]]"}, "bind"},
+		{"mod keys", at(11, "SHIFT"), []string{"### Mod keys", "`SUPER`", "`SHIFT`"}, "$mainMod SHIFT"},
+		{"keycode", at(11, "code:24"), []string{"### Keycode 24", "`q`"}, "code:24"},
+		{"dispatcher", at(11, "killactive"), []string{"### killactive [[docs]]", "closes (not kills) the active window"}, "killactive"},
+		{"mouse button", at(12, "mouse:272"), []string{"### Mouse left button"}, "mouse:272"},
+		{"dispatcher parameters", at(12, "kitty"), []string{"### Parameters of exec"}, "kitty"},
+		{"animation", at(13, "windows"), []string{"### windows animation"}, "windows"},
+		{"unknown key", at(15, "XF86"), []string{"### Unknown key XF86AudioRaiseVolum", "did you mean `XF86AudioRaiseVolume`?"}, "XF86AudioRaiseVolum"},
+		{"keysym", at(16, "xf86"), []string{"### Key xf86audiomute", "`XF86AudioMute` XKB keysym (0x1008ff12)"}, "xf86audiomute"},
+		{"keyword argument", at(13, " 5"), []string{"### SPEED", "Argument 3 of `animation`"}, "5"},
+	}
+	for _, c := range cases {
+		hover, err := Handler{}.Hover(t.Context(), &protocol.HoverParams{TextDocumentPositionParams: protocol.TextDocumentPositionParams{
+			TextDocument: protocol.TextDocumentIdentifier{URI: uri},
+			Position:     c.position,
+		}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hover == nil {
+			t.Errorf("%s: expected a hover", c.name)
+			continue
+		}
+		for _, expected := range c.expected {
+			if !strings.Contains(hover.Contents.Value, expected) {
+				t.Errorf("%s: expected the hover to contain %q, got:\n%s", c.name, expected, hover.Contents.Value)
+			}
+		}
+		line := lines[hover.Range.Start.Line]
+		if span := line[hover.Range.Start.Character:hover.Range.End.Character]; span != c.span {
+			t.Errorf("%s: expected the hover to span %q, got %q", c.name, c.span, span)
+		}
+	}
+}
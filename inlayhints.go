@@ -0,0 +1,277 @@
+package hyprls
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hyprland-community/hyprls/parser"
+	parser_data "github.com/hyprland-community/hyprls/parser/data"
+	"go.lsp.dev/jsonrpc2"
+	"go.lsp.dev/protocol"
+)
+
+// go.lsp.dev/protocol v0.12.0 predates inlay hints (LSP 3.17), they are advertised by advertiseInlayHints and served through Request.
+const inlayHintMethod = "textDocument/inlayHint"
+
+type inlayHintParams struct {
+	TextDocument protocol.TextDocumentIdentifier `json:"textDocument"`
+	Range        protocol.Range                  `json:"range"`
+}
+
+type inlayHintKind int
+
+const (
+	inlayHintKindType      inlayHintKind = 1
+	inlayHintKindParameter inlayHintKind = 2
+)
+
+type inlayHint struct {
+	Position    protocol.Position `json:"position"`
+	Label       string            `json:"label"`
+	Kind        inlayHintKind     `json:"kind,omitempty"`
+	Tooltip     string            `json:"tooltip,omitempty"`
+	PaddingLeft bool              `json:"paddingLeft,omitempty"`
+}
+
+// defaultValueHints is when to show the default value of an option after its assignment:
+// "differs" (when the value is not the default), "equals" (when it is), "always" or "never". Set with the hyprls.defaultValueHints setting.
+var defaultValueHints = "differs"
+
+func extractDefaultValueHints(hyprls map[string]any) (mode string, isAvailable bool) {
+	mode, isAvailable = hyprls["defaultValueHints"].(string)
+	switch mode {
+	case "differs", "equals", "always", "never":
+		return
+	}
+	return "", false
+}
+
+func (h Handler) Request(ctx context.Context, method string, params interface{}) (interface{}, error) {
+	switch method {
+	case inlayHintMethod:
+		var hintParams inlayHintParams
+		encoded, err := json.Marshal(params)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(encoded, &hintParams); err != nil {
+			return nil, err
+		}
+		return h.InlayHint(ctx, &hintParams)
+	}
+	return nil, errors.New("unimplemented")
+}
+
+// serverCapabilities adds the inlay hint capability to the ones protocol.ServerCapabilities knows of.
+type serverCapabilities struct {
+	protocol.ServerCapabilities
+	InlayHintProvider bool `json:"inlayHintProvider"`
+}
+
+type initializeResult struct {
+	Capabilities serverCapabilities   `json:"capabilities"`
+	ServerInfo   *protocol.ServerInfo `json:"serverInfo,omitempty"`
+}
+
+// advertiseInlayHints tells clients that the server provides inlay hints, in its response to initialize.
+// Clients that predate inlay hints ignore the capability.
+func advertiseInlayHints(handler jsonrpc2.Handler) jsonrpc2.Handler {
+	return func(ctx context.Context, reply jsonrpc2.Replier, req jsonrpc2.Request) error {
+		if req.Method() != protocol.MethodInitialize {
+			return handler(ctx, reply, req)
+		}
+		return handler(ctx, func(ctx context.Context, result any, err error) error {
+			if initialized, ok := result.(*protocol.InitializeResult); ok && initialized != nil {
+				result = initializeResult{
+					Capabilities: serverCapabilities{ServerCapabilities: initialized.Capabilities, InlayHintProvider: true},
+					ServerInfo:   initialized.ServerInfo,
+				}
+			}
+			return reply(ctx, result, err)
+		}, req)
+	}
+}
+
+func (h Handler) InlayHint(ctx context.Context, params *inlayHintParams) ([]inlayHint, error) {
+	uri := params.TextDocument.URI
+	if isFileIgnored(uri) {
+		return []inlayHint{}, nil
+	}
+	document, err := parse(uri)
+	if err != nil {
+		return []inlayHint{}, nil
+	}
+	contents, err := file(uri)
+	if err != nil {
+		return []inlayHint{}, nil
+	}
+	lines := strings.Split(contents, "\n")
+
+	hints := make([]inlayHint, 0)
+	for _, hint := range documentInlayHints(uri, document, lines) {
+		if hint.Position.Line >= params.Range.Start.Line && hint.Position.Line <= params.Range.End.Line {
+			hints = append(hints, hint)
+		}
+	}
+	return hints, nil
+}
+
+func documentInlayHints(uri protocol.URI, document parser.Section, lines []string) []inlayHint {
+	hints := make([]inlayHint, 0)
+	hints = append(hints, defaultValueInlayHints(schemaOf(uri), document, lines)...)
+	hints = append(hints, expandedValueInlayHints(uri, document, lines)...)
+	hints = append(hints, keycodeInlayHints(document)...)
+	hints = append(hints, gradientAngleInlayHints(document)...)
+	return hints
+}
+
+// defaultValueInlayHints shows the default value of options, depending on defaultValueHints.
+func defaultValueInlayHints(schema parser_data.Schema, document parser.Section, lines []string) []inlayHint {
+	hints := make([]inlayHint, 0)
+	if defaultValueHints == "never" {
+		return hints
+	}
+	walkAssignmentsWithPath(document, []string{}, func(path []string, assignment parser.Assignment) {
+		if len(path) < 2 || isFreeformPath(path) {
+			return
+		}
+		variable := schema.FindVariableDefinitionInSection(path[len(path)-2], path[len(path)-1])
+		if variable == nil {
+			return
+		}
+		value, rang := valueRange(lines, assignment.Position.Line)
+		if strings.Contains(value, "$") {
+			return
+		}
+
+		label := ""
+		isDefault := isDefaultValue(*variable, value)
+		switch {
+		case isDefault && (defaultValueHints == "equals" || defaultValueHints == "always"):
+			label = "(default)"
+		case !isDefault && (defaultValueHints == "differs" || defaultValueHints == "always"):
+			label = "default: " + prettyDefaultLabel(*variable)
+		default:
+			return
+		}
+		hints = append(hints, inlayHint{
+			Position:    rang.End,
+			Label:       label,
+			Kind:        inlayHintKindType,
+			PaddingLeft: true,
+		})
+	})
+	return hints
+}
+
+func prettyDefaultLabel(variable parser_data.VariableDefinition) string {
+	if variable.Default == "[[Empty]]" || variable.Default == "" {
+		return "(empty)"
+	}
+	return variable.Default
+}
+
+// isDefaultValue compares value to the default of the option, ignoring how numbers and booleans are written.
+func isDefaultValue(variable parser_data.VariableDefinition, value string) bool {
+	defaultValue := variable.Default
+	if defaultValue == "[[Empty]]" {
+		defaultValue = ""
+	}
+	if value == defaultValue {
+		return true
+	}
+	switch variable.Type {
+	case "bool":
+		isTrue, ok := boolValue(value)
+		defaultIsTrue, defaultOk := boolValue(defaultValue)
+		return ok && defaultOk && isTrue == defaultIsTrue
+	case "int", "float", "floatvalue":
+		number, err := strconv.ParseFloat(value, 64)
+		defaultNumber, defaultErr := strconv.ParseFloat(defaultValue, 64)
+		return err == nil && defaultErr == nil && number == defaultNumber
+	}
+	return strings.Join(strings.Fields(value), " ") == strings.Join(strings.Fields(defaultValue), " ")
+}
+
+func boolValue(value string) (isTrue bool, ok bool) {
+	switch strings.ToLower(value) {
+	case "true", "yes", "on", "1":
+		return true, true
+	case "false", "no", "off", "0":
+		return false, true
+	}
+	return false, false
+}
+
+// expandedValueInlayHints shows what values using custom variables expand to.
+func expandedValueInlayHints(uri protocol.URI, document parser.Section, lines []string) []inlayHint {
+	hints := make([]inlayHint, 0)
+	variables := graphVariables(configGraph(uri))
+
+	hintLine := func(line int) {
+		value, rang := valueRange(lines, line)
+		if !strings.Contains(value, "$") {
+			return
+		}
+		expanded := expandCustomVariables(value, variables)
+		if expanded == value {
+			return
+		}
+		hints = append(hints, inlayHint{
+			Position:    rang.End,
+			Label:       "→ " + expanded,
+			Kind:        inlayHintKindType,
+			PaddingLeft: true,
+		})
+	}
+
+	walkAssignmentsWithPath(document, []string{}, func(path []string, assignment parser.Assignment) {
+		hintLine(assignment.Position.Line)
+	})
+	document.WalkCustomVariables(func(v *parser.CustomVariable) {
+		hintLine(v.Position.Line)
+	})
+	document.WalkStatements(func(stmt *parser.Statement) {
+		hintLine(stmt.Position.Line)
+	})
+	return hints
+}
+
+var keycodePattern = regexp.MustCompile(`^code:(\d+)$`)
+
+// keycodeInlayHints shows the key that code:NN keys of keybindings are on, with a US layout.
+func keycodeInlayHints(document parser.Section) []inlayHint {
+	hints := make([]inlayHint, 0)
+	document.WalkStatements(func(stmt *parser.Statement) {
+		keyword := string(stmt.Keyword)
+		if !strings.HasPrefix(keyword, "bind") && keyword != "unbind" {
+			return
+		}
+		args := stmt.RawArguments()
+		if len(args) < 2 {
+			return
+		}
+		match := keycodePattern.FindStringSubmatch(strings.TrimSpace(args[1]))
+		if match == nil {
+			return
+		}
+		code, _ := strconv.Atoi(match[1])
+		keysym, ok := usKeycodeKeysyms[code]
+		if !ok {
+			return
+		}
+		hints = append(hints, inlayHint{
+			Position:    stmt.Arguments[1].LSPRange().End,
+			Label:       keysym,
+			Kind:        inlayHintKindParameter,
+			Tooltip:     fmt.Sprintf("Keycode %d produces %s with a US keyboard layout", code, keysym),
+			PaddingLeft: true,
+		})
+	})
+	return hints
+}
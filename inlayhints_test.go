@@ -0,0 +1,103 @@
+package hyprls
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+
+	parser_data "github.com/hyprland-community/hyprls/parser/data"
+	"go.lsp.dev/jsonrpc2"
+	"go.lsp.dev/protocol"
+)
+
+func TestInlayHints(t *testing.T) {
+	contents := `$mod = SUPER
+$terminal = kitty
+$run = $terminal --single-instance
+general {
+    layout = master
+    gaps_in = 5
+    border_size = 1
+}
+bind = $mod, code:24, exec, $run
+`
+	uri := openTestConfig(t, contents)
+
+	result, err := Handler{}.Request(t.Context(), inlayHintMethod, map[string]any{
+		"textDocument": map[string]any{"uri": string(uri)},
+		"range": map[string]any{
+			"start": map[string]any{"line": 0, "character": 0},
+			"end":   map[string]any{"line": 9, "character": 0},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	labels := make(map[uint32][]string)
+	for _, hint := range result.([]inlayHint) {
+		labels[hint.Position.Line] = append(labels[hint.Position.Line], hint.Label)
+	}
+
+	expected := map[uint32][]string{
+		2: {"→ kitty --single-instance"},
+		4: {"default: dwindle"},
+		8: {"→ SUPER, code:24, exec, kitty --single-instance", "q"},
+	}
+	for line, want := range expected {
+		if got := labels[line]; !slices.Equal(got, want) {
+			t.Errorf("line %d: expected hints %q, got %q", line, want, got)
+		}
+	}
+	for _, line := range []uint32{0, 1, 5, 6} {
+		if got := labels[line]; len(got) > 0 {
+			t.Errorf("line %d: expected no hints, got %q", line, got)
+		}
+	}
+}
+
+func TestIsDefaultValue(t *testing.T) {
+	cases := []struct {
+		option   string
+		value    string
+		expected bool
+	}{
+		{"gaps_in", "5", true},
+		{"border_size", "1.0", true},
+		{"border_size", "2", false},
+		{"resize_on_border", "off", true},
+		{"resize_on_border", "yes", false},
+	}
+	for _, c := range cases {
+		variable := parser_data.FindVariableDefinitionInSection("general", c.option)
+		if variable == nil {
+			t.Fatalf("general:%s is not documented", c.option)
+		}
+		if got := isDefaultValue(*variable, c.value); got != c.expected {
+			t.Errorf("isDefaultValue(general:%s, %q) = %v, expected %v", c.option, c.value, got, c.expected)
+		}
+	}
+}
+
+func TestAdvertiseInlayHints(t *testing.T) {
+	handler := advertiseInlayHints(func(ctx context.Context, reply jsonrpc2.Replier, req jsonrpc2.Request) error {
+		return reply(ctx, &protocol.InitializeResult{Capabilities: protocol.ServerCapabilities{HoverProvider: true}}, nil)
+	})
+	request, err := jsonrpc2.NewCall(jsonrpc2.NewNumberID(1), protocol.MethodInitialize, &protocol.InitializeParams{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var response []byte
+	handler(t.Context(), func(ctx context.Context, result any, err error) error {
+		response, _ = json.Marshal(result)
+		return nil
+	}, request)
+	for _, capability := range []string{`"inlayHintProvider":true`, `"hoverProvider":true`} {
+		if !strings.Contains(string(response), capability) {
+			t.Errorf("expected %s in %s", capability, response)
+		}
+	}
+}
@@ -0,0 +1,316 @@
+package hyprls
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/hyprland-community/hyprls/parser"
+	"go.lsp.dev/protocol"
+)
+
+// modKeyDisplayNames are the canonical names of mod keys, in the order they are displayed in.
+var modKeyDisplayNames = []struct {
+	Key  parser.ModKey
+	Name string
+}{
+	{parser.ModSuper, "SUPER"},
+	{parser.ModControl, "CTRL"},
+	{parser.ModAlt, "ALT"},
+	{parser.ModShift, "SHIFT"},
+	{parser.ModCaps, "CAPS"},
+	{parser.Mod2, "MOD2"},
+	{parser.Mod3, "MOD3"},
+	{parser.Mod5, "MOD5"},
+}
+
+// keybinding is a bind = MODS, KEY, DISPATCHER, PARAMS statement, or one of its flagged variants.
+type keybinding struct {
+	URI       protocol.URI
+	Statement parser.Statement
+	Flags     string
+	// Mods is sorted and deduplicated, so that different spellings of the same modmask are equal
+	Mods []parser.ModKey
+	Key  string
+	// Description is only set for binds with the d flag
+	Description string
+	Dispatcher  string
+	Params      string
+	// Submap is the submap the keybinding is active in, empty for the global submap
+	Submap string
+}
+
+// keybindingUnbind is an unbind = MODS, KEY statement.
+type keybindingUnbind struct {
+	Mods   []parser.ModKey
+	Key    string
+	Submap string
+}
+
+func (k keybinding) HasFlag(flag rune) bool {
+	return strings.ContainsRune(k.Flags, flag)
+}
+
+// Chord is a human-readable representation of the keys to press, such as SUPER SHIFT + Q.
+func (k keybinding) Chord() string {
+	return formatChord(k.Mods, k.Key)
+}
+
+func (k keybinding) Location() protocol.Location {
+	return protocol.Location{
+		URI:   k.URI,
+		Range: k.LSPRange(),
+	}
+}
+
+// LSPRange spans the MODS and KEY arguments
+func (k keybinding) LSPRange() protocol.Range {
+	if len(k.Statement.Arguments) < 2 {
+		return collapsedRange(k.Statement.Position.LSP())
+	}
+	return protocol.Range{
+		Start: k.Statement.Arguments[0].Start.LSP(),
+		End:   k.Statement.Arguments[1].End.LSP(),
+	}
+}
+
+// paramsArgument is the index of the PARAMS argument in the statement's arguments.
+func (k keybinding) paramsArgument() int {
+	argument := 3
+	if k.HasFlag('d') {
+		argument++
+	}
+	if k.HasFlag('m') {
+		argument--
+	}
+	return argument
+}
+
+// ParamsRange spans the PARAMS argument, or the whole statement if there is none.
+func (k keybinding) ParamsRange() protocol.Range {
+	if k.paramsArgument() >= len(k.Statement.Arguments) {
+		return statementRange(k.Statement)
+	}
+	return k.Statement.Arguments[k.paramsArgument()].LSPRange()
+}
+
+// trigger is the kind of event the keybinding reacts to. Keybindings with the same chord but different triggers don't conflict.
+func (k keybinding) trigger() string {
+	for _, flag := range []rune{'r', 'o', 'c', 'g', 'm'} {
+		if k.HasFlag(flag) {
+			return string(flag)
+		}
+	}
+	return ""
+}
+
+func (k keybinding) sameAction(other keybinding) bool {
+	return k.Dispatcher == other.Dispatcher && k.Params == other.Params
+}
+
+func formatChord(mods []parser.ModKey, key string) string {
+	names := make([]string, 0, len(mods))
+	for _, mod := range modKeyDisplayNames {
+		if slices.Contains(mods, mod.Key) {
+			names = append(names, mod.Name)
+		}
+	}
+	if len(names) == 0 {
+		return key
+	}
+	return strings.Join(names, " ") + " + " + key
+}
+
+// normalizeModMask parses the MODS argument of a bind the same way Hyprland does: any known mod key name contained in it counts, whatever the separators are.
+// SUPER_SHIFT, SUPER SHIFT, SUPERSHIFT and WIN shift are thus the same modmask.
+func normalizeModMask(raw string) []parser.ModKey {
+	raw = strings.ToUpper(raw)
+	mods := make([]parser.ModKey, 0)
+	for name, mod := range parser.ModKeyNames {
+		if strings.Contains(raw, name) && !slices.Contains(mods, mod) {
+			mods = append(mods, mod)
+		}
+	}
+	slices.Sort(mods)
+	return mods
+}
+
+// parseKeybinding reads a bind statement. Arguments are expanded with variables first.
+func parseKeybinding(uri protocol.URI, stmt parser.Statement, submap string, variables parser.Variables) keybinding {
+	bind := keybinding{
+		URI:       uri,
+		Statement: stmt,
+		Flags:     strings.TrimPrefix(string(stmt.Keyword), "bind"),
+		Submap:    submap,
+	}
+
+	argsCount := bind.paramsArgument() + 1
+
+	args := strings.SplitN(expandCustomVariables(stmt.ValueRaw, variables), ",", argsCount)
+	for i := range args {
+		args[i] = strings.TrimSpace(args[i])
+	}
+	args = append(args, make([]string, argsCount-len(args))...)
+
+	bind.Mods = normalizeModMask(args[0])
+	bind.Key = args[1]
+	args = args[2:]
+	if bind.HasFlag('d') {
+		bind.Description, args = args[0], args[1:]
+	}
+	bind.Dispatcher = args[0]
+	if len(args) > 1 {
+		bind.Params = args[1]
+	}
+	return bind
+}
+
+// keybindingConflict is a keybinding that is triggered by the same keys as another one.
+type keybindingConflict struct {
+	Keybinding keybinding
+	Other      keybinding
+	Kind       keybindingConflictKind
+}
+
+type keybindingConflictKind int
+
+const (
+	// Both keybindings are the same, the second one is useless
+	conflictDuplicate keybindingConflictKind = iota
+	// Both keybindings will run when the keys are pressed, one after the other
+	conflictSameChord
+	// A submap-universal keybinding (u flag) conflicts with one of a submap
+	conflictShadowed
+)
+
+// keybindingIndex holds every keybinding of a configuration graph.
+type keybindingIndex struct {
+	// Active are the keybindings that are still bound once every unbind = ... statement has been applied, in declaration order
+	Active    []keybinding
+	Conflicts []keybindingConflict
+}
+
+func indexKeybindings(graph []configDocument) keybindingIndex {
+	variables := graphVariables(graph)
+	index := keybindingIndex{
+		Active:    make([]keybinding, 0),
+		Conflicts: make([]keybindingConflict, 0),
+	}
+
+	// Like in Hyprland, the current submap carries over to sourced files
+	submap := ""
+	walkConfigStatements(graph, func(doc configDocument, stmt *parser.Statement) {
+		switch {
+		case stmt.Keyword == "submap":
+			submap = submapName(stmt.RawArguments()[0])
+		case stmt.Keyword == "unbind":
+			index.unbind(parseUnbind(*stmt, submap, variables))
+		case strings.HasPrefix(string(stmt.Keyword), "bind"):
+			index.bind(parseKeybinding(doc.URI, *stmt, submap, variables))
+		}
+	})
+
+	return index
+}
+
+func parseUnbind(stmt parser.Statement, submap string, variables parser.Variables) keybindingUnbind {
+	mods, key, _ := strings.Cut(expandCustomVariables(stmt.ValueRaw, variables), ",")
+	return keybindingUnbind{
+		Mods:   normalizeModMask(mods),
+		Key:    strings.TrimSpace(key),
+		Submap: submap,
+	}
+}
+
+// submapName returns the name of the submap entered by submap = NAME, or an empty string for the global submap
+func submapName(raw string) string {
+	if raw == "reset" {
+		return ""
+	}
+	return raw
+}
+
+func (index *keybindingIndex) unbind(unbind keybindingUnbind) {
+	// Unlike binds, the key of unbinds is case-sensitive
+	index.Active = slices.DeleteFunc(index.Active, func(k keybinding) bool {
+		return k.Submap == unbind.Submap && k.Key == unbind.Key && slices.Equal(k.Mods, unbind.Mods)
+	})
+}
+
+func (index *keybindingIndex) bind(bind keybinding) {
+	for _, other := range index.Active {
+		if bind.trigger() != other.trigger() || !strings.EqualFold(bind.Chord(), other.Chord()) {
+			continue
+		}
+
+		switch {
+		case bind.Submap == other.Submap && bind.sameAction(other):
+			index.Conflicts = append(index.Conflicts, keybindingConflict{Keybinding: bind, Other: other, Kind: conflictDuplicate})
+		case bind.Submap == other.Submap:
+			index.Conflicts = append(index.Conflicts, keybindingConflict{Keybinding: bind, Other: other, Kind: conflictSameChord})
+		case bind.HasFlag('u') && !other.HasFlag('t'), other.HasFlag('u') && !bind.HasFlag('t'):
+			index.Conflicts = append(index.Conflicts, keybindingConflict{Keybinding: bind, Other: other, Kind: conflictShadowed})
+		}
+	}
+	index.Active = append(index.Active, bind)
+}
+
+func (c keybindingConflict) Diagnostic() protocol.Diagnostic {
+	other := c.Other
+	where := fmt.Sprintf("%s:%d", filepath.Base(other.URI.Filename()), other.Statement.Position.Line+1)
+
+	var severity protocol.DiagnosticSeverity
+	var message, related string
+	switch c.Kind {
+	case conflictDuplicate:
+		severity = protocol.DiagnosticSeverityWarning
+		message = fmt.Sprintf("%s is already bound to the same action at %s", c.Keybinding.Chord(), where)
+		related = "first definition"
+	case conflictSameChord:
+		severity = protocol.DiagnosticSeverityInformation
+		message = fmt.Sprintf("%s is also bound at %s, both actions will run one after the other", c.Keybinding.Chord(), where)
+		related = "other definition"
+	case conflictShadowed:
+		severity = protocol.DiagnosticSeverityWarning
+		universal, submapped := c.Keybinding, c.Other
+		if !universal.HasFlag('u') {
+			universal, submapped = submapped, universal
+		}
+		message = fmt.Sprintf("%s is bound both in submap %s and by a submap-universal keybinding at %s, both will run in that submap", c.Keybinding.Chord(), displaySubmapName(submapped.Submap), where)
+		related = fmt.Sprintf("keybinding active in %s", displaySubmapName(other.Submap))
+		if other.HasFlag('u') {
+			related = "keybinding active in every submap"
+		}
+	}
+
+	return protocol.Diagnostic{
+		Range:    c.Keybinding.LSPRange(),
+		Severity: severity,
+		Source:   diagnosticsSource,
+		Message:  message,
+		RelatedInformation: []protocol.DiagnosticRelatedInformation{
+			{
+				Location: other.Location(),
+				Message:  related,
+			},
+		},
+	}
+}
+
+func displaySubmapName(submap string) string {
+	if submap == "" {
+		return "global"
+	}
+	return submap
+}
+
+func keybindingDiagnostics(uri protocol.URI, document parser.Section) []protocol.Diagnostic {
+	diagnostics := make([]protocol.Diagnostic, 0)
+	for _, conflict := range indexKeybindings(configGraph(uri)).Conflicts {
+		if conflict.Keybinding.URI == uri {
+			diagnostics = append(diagnostics, conflict.Diagnostic())
+		}
+	}
+	return diagnostics
+}
@@ -0,0 +1,105 @@
+package hyprls
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hyprland-community/hyprls/parser"
+	"go.lsp.dev/protocol"
+	lspuri "go.lsp.dev/uri"
+)
+
+func TestNormalizeModMask(t *testing.T) {
+	expected := []parser.ModKey{parser.ModShift, parser.ModSuper}
+	for _, raw := range []string{"SUPER_SHIFT", "SUPER SHIFT", "SUPERSHIFT", "shift win", "LOGO&SHIFT"} {
+		if mods := normalizeModMask(raw); !slices.Equal(mods, expected) {
+			t.Errorf("%q: expected %v, got %v", raw, expected, mods)
+		}
+	}
+
+	if mods := normalizeModMask(""); len(mods) != 0 {
+		t.Errorf("expected no mods, got %v", mods)
+	}
+}
+
+func TestKeybindingConflicts(t *testing.T) {
+	directory := t.TempDir()
+	mainFile := filepath.Join(directory, "hyprland.conf")
+	os.WriteFile(mainFile, []byte(`$mainMod = SUPER
+source = ./binds.conf
+bind = $mainMod SHIFT, Q, killactive,
+`), 0644)
+	bindsFile := filepath.Join(directory, "binds.conf")
+	os.WriteFile(bindsFile, []byte(`bind = SUPER_SHIFT, q, killactive,
+bind = WIN, Return, exec, kitty
+bind = SUPER, Return, exec, foot
+bindr = SUPER, Return, exec, wofi
+bind = CTRL, O, exec, obs
+unbind = CONTROL, O
+bind = CONTROL, O, exec, obs
+bindu = ALT, R, submap, reset
+bind = ALT, R, submap, resize
+submap = resize
+bindd = ALT, R, Leave resize mode, submap, reset
+submap = reset
+`), 0644)
+
+	index := indexKeybindings(configGraph(lspuri.File(bindsFile)))
+	if len(index.Conflicts) != 4 {
+		t.Fatalf("expected 4 conflicts, got %#v", index.Conflicts)
+	}
+
+	expected := []struct {
+		kind      keybindingConflictKind
+		line      int
+		otherLine int
+	}{
+		{conflictSameChord, 2, 1},
+		{conflictSameChord, 8, 7},
+		{conflictShadowed, 10, 7},
+		{conflictDuplicate, 2, 0},
+	}
+	for i, conflict := range index.Conflicts {
+		if conflict.Kind != expected[i].kind || conflict.Keybinding.Statement.Position.Line != expected[i].line || conflict.Other.Statement.Position.Line != expected[i].otherLine {
+			t.Errorf("conflict %d: expected %#v, got kind %d on line %d with line %d", i, expected[i], conflict.Kind, conflict.Keybinding.Statement.Position.Line, conflict.Other.Statement.Position.Line)
+		}
+	}
+
+	// The duplicate is the bind of hyprland.conf, since binds.conf is sourced before it
+	if index.Conflicts[3].Keybinding.URI != lspuri.File(mainFile) {
+		t.Errorf("expected duplicate to be reported in hyprland.conf, got %s", index.Conflicts[3].Keybinding.URI)
+	}
+
+	diagnostic := index.Conflicts[2].Diagnostic()
+	if diagnostic.Severity != protocol.DiagnosticSeverityWarning || len(diagnostic.RelatedInformation) != 1 {
+		t.Errorf("unexpected diagnostic for shadowed keybinding: %#v", diagnostic)
+	}
+	if description := index.Conflicts[2].Keybinding.Description; description != "Leave resize mode" {
+		t.Errorf("unexpected description: %q", description)
+	}
+}
+
+func TestCheatsheet(t *testing.T) {
+	uri := openTestConfig(t, `bind = ALT, R, submap, resize
+submap = resize
+bindd = , escape, Leave resize mode, submap, reset
+submap = reset
+bind = SUPER SHIFT, Return, exec, kitty | tee
+`)
+
+	sheet := buildCheatsheet(configGraph(uri))
+	if len(sheet.Submaps) != 2 || sheet.Submaps[0].Name != "" || sheet.Submaps[1].Name != "resize" {
+		t.Fatalf("expected global and resize submaps, got %#v", sheet.Submaps)
+	}
+	if len(sheet.Submaps[0].Keybindings) != 2 || sheet.Submaps[1].Keybindings[0].Description != "Leave resize mode" {
+		t.Errorf("unexpected keybindings: %#v", sheet.Submaps)
+	}
+
+	markdown := sheet.markdown()
+	if !strings.Contains(markdown, "| `SUPER SHIFT + Return` |  | `exec kitty \\| tee` |") {
+		t.Errorf("unexpected markdown:\n%s", markdown)
+	}
+}
@@ -0,0 +1,396 @@
+package hyprls
+
+// usKeycodeKeysyms maps XKB keycodes, as used in code:NN keys of keybindings, to the keysym they produce with the US layout.
+// Extracted from xkeyboard-config's keycodes/evdev and the symbols included by the us layout on a pc105 keyboard.
+var usKeycodeKeysyms = map[int]string{
+	9:   "Escape",
+	10:  "1",
+	11:  "2",
+	12:  "3",
+	13:  "4",
+	14:  "5",
+	15:  "6",
+	16:  "7",
+	17:  "8",
+	18:  "9",
+	19:  "0",
+	20:  "minus",
+	21:  "equal",
+	22:  "BackSpace",
+	23:  "Tab",
+	24:  "q",
+	25:  "w",
+	26:  "e",
+	27:  "r",
+	28:  "t",
+	29:  "y",
+	30:  "u",
+	31:  "i",
+	32:  "o",
+	33:  "p",
+	34:  "bracketleft",
+	35:  "bracketright",
+	36:  "Return",
+	37:  "Control_L",
+	38:  "a",
+	39:  "s",
+	40:  "d",
+	41:  "f",
+	42:  "g",
+	43:  "h",
+	44:  "j",
+	45:  "k",
+	46:  "l",
+	47:  "semicolon",
+	48:  "apostrophe",
+	49:  "grave",
+	50:  "Shift_L",
+	51:  "backslash",
+	52:  "z",
+	53:  "x",
+	54:  "c",
+	55:  "v",
+	56:  "b",
+	57:  "n",
+	58:  "m",
+	59:  "comma",
+	60:  "period",
+	61:  "slash",
+	62:  "Shift_R",
+	64:  "Alt_L",
+	65:  "space",
+	66:  "Caps_Lock",
+	67:  "F1",
+	68:  "F2",
+	69:  "F3",
+	70:  "F4",
+	71:  "F5",
+	72:  "F6",
+	73:  "F7",
+	74:  "F8",
+	75:  "F9",
+	76:  "F10",
+	77:  "Num_Lock",
+	78:  "Scroll_Lock",
+	79:  "KP_Home",
+	80:  "KP_Up",
+	81:  "KP_Prior",
+	83:  "KP_Left",
+	84:  "KP_Begin",
+	85:  "KP_Right",
+	87:  "KP_End",
+	88:  "KP_Down",
+	89:  "KP_Next",
+	90:  "KP_Insert",
+	91:  "KP_Delete",
+	92:  "ISO_Level3_Shift",
+	94:  "less",
+	95:  "F11",
+	96:  "F12",
+	98:  "Katakana",
+	99:  "Hiragana",
+	100: "Henkan",
+	101: "Hiragana_Katakana",
+	102: "Muhenkan",
+	104: "KP_Enter",
+	105: "Control_R",
+	107: "Print",
+	108: "Alt_R",
+	109: "Linefeed",
+	110: "Home",
+	111: "Up",
+	112: "Prior",
+	113: "Left",
+	114: "Right",
+	115: "End",
+	116: "Down",
+	117: "Next",
+	118: "Insert",
+	119: "Delete",
+	121: "XF86AudioMute",
+	124: "XF86PowerOff",
+	125: "KP_Equal",
+	126: "plusminus",
+	127: "Pause",
+	128: "XF86LaunchA",
+	129: "KP_Decimal",
+	130: "Hangul",
+	131: "Hangul_Hanja",
+	133: "Super_L",
+	134: "Super_R",
+	135: "Menu",
+	136: "Cancel",
+	137: "Redo",
+	138: "SunProps",
+	139: "Undo",
+	140: "SunFront",
+	141: "XF86Copy",
+	142: "XF86Open",
+	143: "XF86Paste",
+	144: "Find",
+	145: "XF86Cut",
+	146: "Help",
+	147: "XF86MenuKB",
+	148: "XF86Calculator",
+	150: "XF86Sleep",
+	151: "XF86WakeUp",
+	152: "XF86Explorer",
+	153: "XF86Send",
+	155: "XF86Xfer",
+	156: "XF86Launch1",
+	157: "XF86Launch2",
+	158: "XF86WWW",
+	159: "XF86DOS",
+	160: "XF86ScreenSaver",
+	161: "XF86RotateWindows",
+	162: "XF86TaskPane",
+	163: "XF86Mail",
+	164: "XF86Favorites",
+	165: "XF86MyComputer",
+	166: "XF86Back",
+	167: "XF86Forward",
+	169: "XF86Eject",
+	170: "XF86Eject",
+	171: "XF86AudioNext",
+	172: "XF86AudioPlay",
+	173: "XF86AudioPrev",
+	174: "XF86AudioStop",
+	175: "XF86AudioRecord",
+	176: "XF86AudioRewind",
+	177: "XF86Phone",
+	179: "XF86Tools",
+	180: "XF86HomePage",
+	181: "XF86Reload",
+	182: "XF86Close",
+	185: "XF86ScrollUp",
+	186: "XF86ScrollDown",
+	187: "parenleft",
+	188: "parenright",
+	189: "XF86New",
+	190: "Redo",
+	191: "XF86Tools",
+	192: "XF86Launch5",
+	193: "XF86Launch6",
+	194: "XF86Launch7",
+	195: "XF86Launch8",
+	196: "XF86Launch9",
+	198: "XF86AudioMicMute",
+	199: "XF86TouchpadToggle",
+	200: "XF86TouchpadOn",
+	201: "XF86TouchpadOff",
+	203: "Mode_switch",
+	208: "XF86AudioPlay",
+	209: "XF86AudioPause",
+	210: "XF86Launch3",
+	211: "XF86Launch4",
+	212: "XF86LaunchB",
+	213: "XF86Suspend",
+	214: "XF86Close",
+	215: "XF86AudioPlay",
+	216: "XF86AudioForward",
+	218: "Print",
+	220: "XF86WebCam",
+	221: "XF86AudioPreset",
+	223: "XF86Mail",
+	224: "XF86Messenger",
+	225: "XF86Search",
+	226: "XF86Go",
+	227: "XF86Finance",
+	228: "XF86Game",
+	229: "XF86Shop",
+	231: "Cancel",
+	232: "XF86MonBrightnessDown",
+	233: "XF86MonBrightnessUp",
+	234: "XF86AudioMedia",
+	235: "XF86Display",
+	236: "XF86KbdLightOnOff",
+	237: "XF86KbdBrightnessDown",
+	238: "XF86KbdBrightnessUp",
+	239: "XF86Send",
+	240: "XF86Reply",
+	241: "XF86MailForward",
+	242: "XF86Save",
+	243: "XF86Documents",
+	244: "XF86Battery",
+	245: "XF86Bluetooth",
+	246: "XF86WLAN",
+	247: "XF86UWB",
+	249: "XF86Next_VMode",
+	250: "XF86Prev_VMode",
+	251: "XF86MonBrightnessCycle",
+	252: "XF86BrightnessAuto",
+	253: "XF86DisplayOff",
+	254: "XF86WWAN",
+	255: "XF86RFKill",
+	256: "XF86AudioMicMute",
+	366: "XF86Info",
+	372: "XF86Favorites",
+	379: "XF86CycleAngle",
+	380: "XF86FullScreen",
+	382: "XF86Keyboard",
+	383: "XF86AspectRatio",
+	397: "XF86DVD",
+	400: "XF86Audio",
+	401: "XF86Video",
+	405: "XF86Calendar",
+	410: "XF86ChannelUp",
+	411: "XF86ChannelDown",
+	418: "XF86AudioRandomPlay",
+	419: "XF86Break",
+	424: "XF86VideoPhone",
+	425: "XF86Game",
+	426: "XF86ZoomIn",
+	427: "XF86ZoomOut",
+	428: "XF86ZoomReset",
+	429: "XF86Word",
+	430: "XF86Editor",
+	431: "XF86Excel",
+	432: "XF86GraphicsEditor",
+	433: "XF86Presentation",
+	434: "XF86Database",
+	435: "XF86News",
+	436: "XF86Voicemail",
+	437: "XF86Addressbook",
+	438: "XF86Messenger",
+	439: "XF86DisplayToggle",
+	440: "XF86SpellCheck",
+	441: "XF86LogOff",
+	442: "dollar",
+	443: "EuroSign",
+	444: "XF86FrameBack",
+	445: "XF86FrameForward",
+	446: "XF86ContextMenu",
+	447: "XF86MediaRepeat",
+	448: "XF8610ChannelsUp",
+	449: "XF8610ChannelsDown",
+	450: "XF86Images",
+	452: "XF86NotificationCenter",
+	453: "XF86PickupPhone",
+	454: "XF86HangupPhone",
+	472: "XF86Fn",
+	473: "XF86Fn_Esc",
+	493: "XF86FnRightShift",
+	505: "braille_dot_1",
+	506: "braille_dot_2",
+	507: "braille_dot_3",
+	508: "braille_dot_4",
+	509: "braille_dot_5",
+	510: "braille_dot_6",
+	511: "braille_dot_7",
+	512: "braille_dot_8",
+	513: "braille_dot_9",
+	514: "braille_dot_1",
+	520: "XF86Numeric0",
+	521: "XF86Numeric1",
+	522: "XF86Numeric2",
+	523: "XF86Numeric3",
+	524: "XF86Numeric4",
+	525: "XF86Numeric5",
+	526: "XF86Numeric6",
+	527: "XF86Numeric7",
+	528: "XF86Numeric8",
+	529: "XF86Numeric9",
+	530: "XF86NumericStar",
+	531: "XF86NumericPound",
+	532: "XF86NumericA",
+	533: "XF86NumericB",
+	534: "XF86NumericC",
+	535: "XF86NumericD",
+	536: "XF86CameraFocus",
+	537: "XF86WPSButton",
+	538: "XF86TouchpadToggle",
+	539: "XF86TouchpadOn",
+	540: "XF86TouchpadOff",
+	541: "XF86CameraZoomIn",
+	542: "XF86CameraZoomOut",
+	543: "XF86CameraUp",
+	544: "XF86CameraDown",
+	545: "XF86CameraLeft",
+	546: "XF86CameraRight",
+	547: "XF86AttendantOn",
+	548: "XF86AttendantOff",
+	549: "XF86AttendantToggle",
+	550: "XF86LightsToggle",
+	568: "XF86ALSToggle",
+	569: "XF86RotationLockToggle",
+	584: "XF86Buttonconfig",
+	585: "XF86Taskmanager",
+	586: "XF86Journal",
+	587: "XF86ControlPanel",
+	588: "XF86AppSelect",
+	589: "XF86Screensaver",
+	590: "XF86VoiceCommand",
+	591: "XF86Assistant",
+	592: "ISO_Next_Group",
+	593: "XF86EmojiPicker",
+	600: "XF86BrightnessMin",
+	601: "XF86BrightnessMax",
+	616: "XF86KbdInputAssistPrev",
+	617: "XF86KbdInputAssistNext",
+	618: "XF86KbdInputAssistPrevgroup",
+	619: "XF86KbdInputAssistNextgroup",
+	620: "XF86KbdInputAssistAccept",
+	621: "XF86KbdInputAssistCancel",
+	622: "XF86RightUp",
+	623: "XF86RightDown",
+	624: "XF86LeftUp",
+	625: "XF86LeftDown",
+	626: "XF86RootMenu",
+	627: "XF86MediaTopMenu",
+	628: "XF86Numeric11",
+	629: "XF86Numeric12",
+	630: "XF86AudioDesc",
+	631: "XF863DMode",
+	632: "XF86NextFavorite",
+	633: "XF86StopRecord",
+	634: "XF86PauseRecord",
+	635: "XF86VOD",
+	636: "XF86Unmute",
+	637: "XF86FastReverse",
+	638: "XF86SlowReverse",
+	639: "XF86Data",
+	640: "XF86OnScreenKeyboard",
+	641: "XF86PrivacyScreenToggle",
+	642: "XF86SelectiveScreenshot",
+	664: "XF86Macro1",
+	665: "XF86Macro2",
+	666: "XF86Macro3",
+	667: "XF86Macro4",
+	668: "XF86Macro5",
+	669: "XF86Macro6",
+	670: "XF86Macro7",
+	671: "XF86Macro8",
+	672: "XF86Macro9",
+	673: "XF86Macro10",
+	674: "XF86Macro11",
+	675: "XF86Macro12",
+	676: "XF86Macro13",
+	677: "XF86Macro14",
+	678: "XF86Macro15",
+	679: "XF86Macro16",
+	680: "XF86Macro17",
+	681: "XF86Macro18",
+	682: "XF86Macro19",
+	683: "XF86Macro20",
+	684: "XF86Macro21",
+	685: "XF86Macro22",
+	686: "XF86Macro23",
+	687: "XF86Macro24",
+	688: "XF86Macro25",
+	689: "XF86Macro26",
+	690: "XF86Macro27",
+	691: "XF86Macro28",
+	692: "XF86Macro29",
+	693: "XF86Macro30",
+	696: "XF86MacroRecordStart",
+	697: "XF86MacroRecordStop",
+	698: "XF86MacroPresetCycle",
+	699: "XF86MacroPreset1",
+	700: "XF86MacroPreset2",
+	701: "XF86MacroPreset3",
+	704: "XF86KbdLcdMenu1",
+	705: "XF86KbdLcdMenu2",
+	706: "XF86KbdLcdMenu3",
+	707: "XF86KbdLcdMenu4",
+	708: "XF86KbdLcdMenu5",
+}
@@ -0,0 +1,181 @@
+package hyprls
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hyprland-community/hyprls/parser"
+	parser_data "github.com/hyprland-community/hyprls/parser/data"
+	"go.lsp.dev/protocol"
+)
+
+// mouseWheelKeys are the keys of the scroll wheel.
+var mouseWheelKeys = []struct{ Key, Description string }{
+	{"mouse_up", "scrolling up"},
+	{"mouse_down", "scrolling down"},
+	{"mouse_left", "scrolling left"},
+	{"mouse_right", "scrolling right"},
+}
+
+func mouseWheelDescription(key string) (string, bool) {
+	for _, wheel := range mouseWheelKeys {
+		if wheel.Key == key {
+			return wheel.Description, true
+		}
+	}
+	return "", false
+}
+
+var (
+	switchKeyPattern = regexp.MustCompile(`^switch:(?:(on|off):)?(.+)$`)
+	// keysymValuePattern matches keysyms written as their value, which XKB accepts in place of their name
+	keysymValuePattern = regexp.MustCompile(`^(?:0x[0-9a-fA-F]+|U[0-9a-fA-F]{4,6})$`)
+)
+
+// maxKeyCompletions is the number of keys suggested at once, out of the thousands of keysyms.
+const maxKeyCompletions = 50
+
+// isKnownKey is true when Hyprland recognises key as the key of a keybinding: a keysym name, matched case-insensitively,
+// code:NN or a keycode above 9, mouse:NNN, a mouse wheel direction, switch:NAME, or catchall in submaps.
+func isKnownKey(key string) bool {
+	if key == "" || key == "catchall" || keycodePattern.MatchString(key) || mouseButtonPattern.MatchString(key) || switchKeyPattern.MatchString(key) || keysymValuePattern.MatchString(key) {
+		return true
+	}
+	if _, isWheel := mouseWheelDescription(key); isWheel {
+		return true
+	}
+	if code, err := strconv.Atoi(key); err == nil && code > 9 {
+		return true
+	}
+	_, found := parser_data.FindKeysym(key)
+	return found
+}
+
+// bindKeys splits the key argument of a bind into its keys: binds with the s flag combine several keys with &.
+func bindKeys(keyword parser.Keyword, key string) []string {
+	if flags, _ := strings.CutPrefix(string(keyword), "bind"); strings.ContainsRune(flags, 's') {
+		return strings.Split(key, "&")
+	}
+	return []string{key}
+}
+
+// unknownKeyProblems warns about keys of binds that are not keysyms, since Hyprland never triggers such keybindings.
+func unknownKeyProblems(uri protocol.URI, document parser.Section, lines []string) []configProblem {
+	problems := make([]configProblem, 0)
+	variables := graphVariables(configGraph(uri))
+	document.WalkStatements(func(stmt *parser.Statement) {
+		arguments := keywordArgumentsOf(string(stmt.Keyword))
+		index := slices.IndexFunc(arguments, func(a keywordArgument) bool { return a.Name == argumentKey })
+		if index < 0 || index >= len(stmt.Arguments) {
+			return
+		}
+		raw := stmt.RawArguments()[index]
+		for _, key := range bindKeys(stmt.Keyword, strings.TrimSpace(expandCustomVariables(raw, variables))) {
+			key = strings.TrimSpace(key)
+			if strings.Contains(key, "$") || isKnownKey(key) {
+				continue
+			}
+			problem := configProblem{Diagnostic: statementDiagnostic(*stmt, index, protocol.DiagnosticSeverityWarning, "unknown key %s, the keybinding will never be triggered", key)}
+			if suggestion, found := closestName(key, keyNames()); found {
+				problem.Diagnostic.Message = fmt.Sprintf("unknown key %s, did you mean %s?", key, suggestion)
+				if strings.TrimSpace(raw) == key {
+					problem.Fixes = []quickFix{{
+						Title: fmt.Sprintf("Replace with %s", suggestion),
+						Edits: []protocol.TextEdit{{Range: stmt.Arguments[index].LSPRange(), NewText: suggestion}},
+					}}
+				}
+			}
+			problems = append(problems, problem)
+		}
+	})
+	return problems
+}
+
+// keyNames are the names of the keysyms and of the mouse wheel keys.
+func keyNames() []string {
+	names := make([]string, 0, len(parser_data.Keysyms)+len(mouseWheelKeys))
+	for _, keysym := range parser_data.Keysyms {
+		names = append(names, keysym.Name)
+	}
+	for _, wheel := range mouseWheelKeys {
+		names = append(names, wheel.Key)
+	}
+	return names
+}
+
+// keysymCompletions suggests the keys that fuzzily match the word being written, best matches first.
+// incomplete is true when there were more than maxKeyCompletions of them.
+func (c completionContext) keysymCompletions() (items []protocol.CompletionItem, incomplete bool) {
+	type candidate struct {
+		Key, Description string
+		Score            int
+	}
+	candidates := make([]candidate, 0)
+	add := func(key, description string) {
+		if score, matches := fuzzyScore(c.Word, key); matches {
+			candidates = append(candidates, candidate{key, description, score})
+		}
+	}
+	for _, code := range slices.Sorted(maps.Keys(mouseButtons)) {
+		add(fmt.Sprintf("mouse:%d", code), "mouse "+mouseButtons[code])
+	}
+	for _, wheel := range mouseWheelKeys {
+		add(wheel.Key, wheel.Description)
+	}
+	for _, keysym := range parser_data.Keysyms {
+		if keysym.Value != 0 && keysym.Value != 0xffffff {
+			add(keysym.Name, fmt.Sprintf("keysym %#x", keysym.Value))
+		}
+	}
+	if c.Word != "" {
+		slices.SortStableFunc(candidates, func(a, b candidate) int {
+			if a.Score != b.Score {
+				return b.Score - a.Score
+			}
+			return len(a.Key) - len(b.Key)
+		})
+	}
+
+	items = make([]protocol.CompletionItem, 0, min(len(candidates), maxKeyCompletions))
+	for i, candidate := range candidates[:min(len(candidates), maxKeyCompletions)] {
+		item := c.item(candidate.Key, protocol.CompletionItemKindEnumMember, candidate.Description)
+		item.SortText = fmt.Sprintf("%03d", i)
+		items = append(items, item)
+	}
+	return items, len(candidates) > maxKeyCompletions
+}
+
+// fuzzyScore tells whether the characters of pattern appear in order in candidate, case-insensitively, and how well they match:
+// exact and prefix matches come first, then matches of consecutive characters and of the start of words.
+func fuzzyScore(pattern, candidate string) (score int, matches bool) {
+	lowerPattern, lowerCandidate := strings.ToLower(pattern), strings.ToLower(candidate)
+	switch {
+	case lowerPattern == lowerCandidate:
+		return 1000, true
+	case strings.HasPrefix(lowerCandidate, lowerPattern):
+		return 500 + len(pattern), true
+	}
+	next, previous := 0, -2
+	for i := 0; i < len(lowerCandidate) && next < len(lowerPattern); i++ {
+		if lowerCandidate[i] != lowerPattern[next] {
+			continue
+		}
+		score++
+		if i == previous+1 {
+			score += 5
+		}
+		if i == 0 || candidate[i-1] == '_' || (isUpper(candidate[i]) && !isUpper(candidate[i-1])) {
+			score += 3
+		}
+		next, previous = next+1, i
+	}
+	return score, next == len(lowerPattern)
+}
+
+func isUpper(b byte) bool {
+	return 'A' <= b && b <= 'Z'
+}
@@ -0,0 +1,73 @@
+package hyprls
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIsKnownKey(t *testing.T) {
+	for _, key := range []string{"Return", "return", "XF86AudioRaiseVolume", "xf86audioraisevolume", "code:24", "42", "mouse:272", "mouse_up", "mouse_down", "switch:Lid Switch", "switch:on:Lid Switch", "catchall", "0x1008ff13"} {
+		if !isKnownKey(key) {
+			t.Errorf("expected %q to be a known key", key)
+		}
+	}
+	for _, key := range []string{"XF86AudioRaiseVolum", "Retrun", "mouse", "code:"} {
+		if isKnownKey(key) {
+			t.Errorf("expected %q to be an unknown key", key)
+		}
+	}
+}
+
+func TestFuzzyScore(t *testing.T) {
+	if _, matches := fuzzyScore("xarv", "XF86AudioRaiseVolume"); !matches {
+		t.Error("expected xarv to match XF86AudioRaiseVolume")
+	}
+	if _, matches := fuzzyScore("vx", "XF86AudioRaiseVolume"); matches {
+		t.Error("expected vx not to match XF86AudioRaiseVolume")
+	}
+	prefix, _ := fuzzyScore("ret", "Return")
+	scattered, _ := fuzzyScore("ret", "Hangul_RieulTieut")
+	if prefix <= scattered {
+		t.Errorf("expected prefix matches to score higher, got %d and %d", prefix, scattered)
+	}
+}
+
+func TestUnknownKeyProblems(t *testing.T) {
+	contents := `$volume = XF86AudioLowerVolume
+bind = , XF86AudioRaiseVolum, exec, wpctl set-volume @DEFAULT_AUDIO_SINK@ 5%+
+bind = , $volume, exec, wpctl set-volume @DEFAULT_AUDIO_SINK@ 5%-
+bind = SUPER, return, exec, kitty
+binds = SUPER_L, Super_L&Retrun, exec, kitty
+bindm = SUPER, mouse:272, movewindow
+bind = SUPER, mouse_down, workspace, e+1
+bindl = , switch:on:Lid Switch, exec, systemctl suspend
+bind = SUPER, code:10, workspace, 1
+unbind = SUPER, Qq
+`
+	uri := openTestConfig(t, contents)
+	document, err := parse(uri)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reported := make(map[uint32]configProblem)
+	for _, problem := range unknownKeyProblems(uri, document, strings.Split(contents, "\n")) {
+		reported[problem.Diagnostic.Range.Start.Line] = problem
+	}
+	if len(reported) != 3 {
+		t.Errorf("expected unknown keys on 3 lines, got %+v", reported)
+	}
+	typo := reported[1]
+	if typo.Diagnostic.Message != "unknown key XF86AudioRaiseVolum, did you mean XF86AudioRaiseVolume?" {
+		t.Errorf("unexpected message %q", typo.Diagnostic.Message)
+	}
+	if len(typo.Fixes) != 1 || typo.Fixes[0].Edits[0].NewText != "XF86AudioRaiseVolume" || typo.Fixes[0].Edits[0].Range.Start.Character != 9 {
+		t.Errorf("expected a fix replacing the key, got %+v", typo.Fixes)
+	}
+	if combo := reported[4]; combo.Diagnostic.Message != "unknown key Retrun, did you mean Return?" || len(combo.Fixes) != 0 {
+		t.Errorf("expected the misspelled key of the combination to be reported without a fix, got %+v", combo)
+	}
+	if _, found := reported[9]; !found {
+		t.Error("expected the key of unbind to be checked")
+	}
+}
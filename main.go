@@ -21,12 +21,12 @@ func StartServer(logger *zap.Logger, logClientIn string) {
 		writer: os.Stdout,
 		logAt:  logClientIn,
 	}))
-	handler, ctx, err := NewHandler(context.Background(), protocol.ServerDispatcher(conn, logger), logger)
+	handler, ctx, err := NewHandler(context.Background(), protocol.ServerDispatcher(conn, logger), protocol.ClientDispatcher(conn, logger), logger)
 	if err != nil {
 		logger.Sugar().Fatalf("while initializing handler: %w", err)
 	}
 
-	conn.Go(ctx, protocol.ServerHandler(handler, jsonrpc2.MethodNotFoundHandler))
+	conn.Go(ctx, advertiseInlayHints(protocol.ServerHandler(handler, jsonrpc2.MethodNotFoundHandler)))
 	<-conn.Done()
 	logger.Info("server stopped")
 }
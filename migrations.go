@@ -0,0 +1,227 @@
+package hyprls
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hyprland-community/hyprls/parser"
+	parser_data "github.com/hyprland-community/hyprls/parser/data"
+	"go.lsp.dev/protocol"
+	lspuri "go.lsp.dev/uri"
+)
+
+// windowRuleFieldPattern matches the field: prefix of windowrulev2 window matchers, such as class: or title:
+var windowRuleFieldPattern = regexp.MustCompile(`^\w+:`)
+
+// isWindowRuleV1 tells whether a windowrule statement uses the windowrule = RULE, REGEX syntax, where REGEX matches the window class.
+func isWindowRuleV1(stmt parser.Statement) bool {
+	args := stmt.RawArguments()
+	return stmt.Keyword == "windowrule" && len(args) == 2 && !windowRuleFieldPattern.MatchString(args[0]) && !windowRuleFieldPattern.MatchString(args[1])
+}
+
+// isWindowRuleV2 tells whether a window rule matches windows with PROP:REGEX matchers, such as windowrule = float, class:kitty.
+func isWindowRuleV2(stmt parser.Statement) bool {
+	if stmt.Keyword == "windowrulev2" {
+		return true
+	}
+	return stmt.Keyword == "windowrule" && slices.ContainsFunc(stmt.RawArguments(), func(arg string) bool {
+		arg = strings.TrimSpace(arg)
+		return windowRuleFieldPattern.MatchString(arg) && !strings.HasPrefix(arg, "match:")
+	})
+}
+
+// isMigratedOption tells whether path is an option that was renamed or removed by a Hyprland release.
+func isMigratedOption(path []string) bool {
+	return slices.ContainsFunc(parser_data.Migrations, func(m parser_data.Migration) bool {
+		return (m.Kind == parser_data.OptionRenamed || m.Kind == parser_data.OptionRemoved) && m.Option == strings.Join(path, ":")
+	})
+}
+
+// fullKeyRange spans the key of an assignment, including its category: prefixes.
+func fullKeyRange(lines []string, assignment parser.Assignment) protocol.Range {
+	start := len(indentation(lines[assignment.Position.Line]))
+	return protocol.Range{
+		Start: protocol.Position{Line: uint32(assignment.Position.Line), Character: uint32(start)},
+		End:   protocol.Position{Line: uint32(assignment.Position.Line), Character: uint32(start + len(assignment.Key))},
+	}
+}
+
+// migrationProblems finds usages of options and keywords that the given migrations change, with fixes that apply them.
+func migrationProblems(document parser.Section, lines []string, migrations []parser_data.Migration) []configProblem {
+	problems := make([]configProblem, 0)
+	deprecation := func(rang protocol.Range, message string, fmtArgs ...any) protocol.Diagnostic {
+		return protocol.Diagnostic{
+			Range:    rang,
+			Severity: protocol.DiagnosticSeverityWarning,
+			Source:   diagnosticsSource,
+			Message:  fmt.Sprintf(message, fmtArgs...),
+			Tags:     []protocol.DiagnosticTag{protocol.DiagnosticTagDeprecated},
+		}
+	}
+
+	walkAssignmentsWithPath(document, []string{}, func(path []string, assignment parser.Assignment) {
+		for _, migration := range migrations {
+			if strings.Join(path, ":") != migration.Option {
+				continue
+			}
+			keyRange := fullKeyRange(lines, assignment)
+
+			switch migration.Kind {
+			case parser_data.OptionRemoved:
+				problems = append(problems, configProblem{
+					Diagnostic: deprecation(keyRange, "%s was removed in Hyprland %s, %s", migration.Option, migration.Version, migration.Note),
+					Fixes: []quickFix{{
+						Title: fmt.Sprintf("Remove %s", migration.Option),
+						Edits: []protocol.TextEdit{{Range: lineRange(assignment.Position.Line), NewText: ""}},
+					}},
+				})
+
+			case parser_data.OptionRenamed:
+				problems = append(problems, configProblem{
+					Diagnostic: deprecation(keyRange, "%s was renamed to %s in Hyprland %s", migration.Option, migration.RenamedTo, migration.Version),
+					Fixes: []quickFix{{
+						Title: fmt.Sprintf("Rename to %s", migration.RenamedTo),
+						Edits: renameOptionEdits(document, lines, assignment, path, migration),
+					}},
+				})
+			}
+		}
+	})
+
+	document.WalkStatements(func(stmt *parser.Statement) {
+		// The earlier window rule migrations would produce rules that Hyprland 0.53 deprecated, and rules can't be rewritten with match: automatically
+		if i := slices.IndexFunc(migrations, func(m parser_data.Migration) bool { return m.Kind == parser_data.WindowRuleMatchSyntax }); i >= 0 && (isWindowRuleV1(*stmt) || isWindowRuleV2(*stmt)) {
+			start := len(indentation(lines[stmt.Position.Line]))
+			problems = append(problems, configProblem{
+				Diagnostic: deprecation(protocol.Range{
+					Start: protocol.Position{Line: uint32(stmt.Position.Line), Character: uint32(start)},
+					End:   protocol.Position{Line: uint32(stmt.Position.Line), Character: uint32(start + len(stmt.Keyword))},
+				}, "this window rule syntax was replaced by windowrule = RULE VALUE, match:PROP REGEX in Hyprland %s, %s", migrations[i].Version, migrations[i].Note),
+			})
+			return
+		}
+		for _, migration := range migrations {
+			switch {
+			case migration.Kind == parser_data.KeywordRenamed && string(stmt.Keyword) == migration.Option:
+				start := len(indentation(lines[stmt.Position.Line]))
+				rang := protocol.Range{
+					Start: protocol.Position{Line: uint32(stmt.Position.Line), Character: uint32(start)},
+					End:   protocol.Position{Line: uint32(stmt.Position.Line), Character: uint32(start + len(migration.Option))},
+				}
+				problems = append(problems, configProblem{
+					Diagnostic: deprecation(rang, "%s was renamed to %s in Hyprland %s", migration.Option, migration.RenamedTo, migration.Version),
+					Fixes: []quickFix{{
+						Title: fmt.Sprintf("Rename to %s", migration.RenamedTo),
+						Edits: []protocol.TextEdit{{Range: rang, NewText: migration.RenamedTo}},
+					}},
+				})
+
+			case migration.Kind == parser_data.WindowRuleV1Syntax && isWindowRuleV1(*stmt):
+				problems = append(problems, configProblem{
+					Diagnostic: deprecation(stmt.Arguments[1].LSPRange(), "windowrule = RULE, REGEX was replaced by windowrule = RULE, class:REGEX in Hyprland %s", migration.Version),
+					Fixes: []quickFix{{
+						Title: "Match the window class with class:",
+						Edits: []protocol.TextEdit{{Range: collapsedRange(stmt.Arguments[1].Start.LSP()), NewText: "class:"}},
+					}},
+				})
+			}
+		}
+	})
+
+	return problems
+}
+
+// renameOptionEdits renames the key of an assignment, keeping it in its section if the new option is still in it, and converts its value if needed.
+func renameOptionEdits(document parser.Section, lines []string, assignment parser.Assignment, path []string, migration parser_data.Migration) []protocol.TextEdit {
+	sections := path[:len(path)-len(strings.Split(assignment.Key, ":"))]
+	newPath := strings.Split(migration.RenamedTo, ":")
+	value, valueRange := valueRange(lines, assignment.Position.Line)
+	converted, valueChanged := migration.Values[value]
+	if valueChanged {
+		value = converted
+	}
+
+	if len(newPath) > len(sections) && slices.Equal(newPath[:len(sections)], sections) {
+		edits := []protocol.TextEdit{{Range: fullKeyRange(lines, assignment), NewText: strings.Join(newPath[len(sections):], ":")}}
+		if valueChanged {
+			edits = append(edits, protocol.TextEdit{Range: valueRange, NewText: value})
+		}
+		return edits
+	}
+
+	// The option moved out of its section, move the assignment after the top-level section
+	after := assignment.Position.Line + 1
+	for _, section := range document.Subsections {
+		if section.Start.Line <= assignment.Position.Line && assignment.Position.Line <= section.End.Line {
+			after = section.End.Line + 1
+		}
+	}
+	return []protocol.TextEdit{
+		{Range: lineRange(assignment.Position.Line), NewText: ""},
+		{Range: collapsedRange(protocol.Position{Line: uint32(after)}), NewText: fmt.Sprintf("%s = %s\n", migration.RenamedTo, value)},
+	}
+}
+
+// deprecationProblems reports options and keywords that don't exist anymore in the Hyprland version the document is written for.
+func deprecationProblems(uri protocol.URI, document parser.Section, lines []string) []configProblem {
+	return migrationProblems(document, lines, parser_data.MigrationsBetween("0", documentVersion(uri)))
+}
+
+// MigrateConfig rewrites the configuration that configFile is part of, from a configuration written for Hyprland from to one for Hyprland to.
+// Every change is reported to out. Files are only modified when write is true.
+func MigrateConfig(out io.Writer, configFile, from, to string, write bool) error {
+	for _, version := range []string{from, to} {
+		if !parser_data.ValidVersion(version) {
+			return fmt.Errorf("invalid Hyprland version %q, expected something like 0.45 or 0.45.2", version)
+		}
+	}
+	migrations := parser_data.MigrationsBetween(from, to)
+
+	absolute, err := filepath.Abs(configFile)
+	if err != nil {
+		return fmt.Errorf("while resolving %s: %w", configFile, err)
+	}
+	uri := lspuri.File(absolute)
+	if _, err := parseGraphFile(uri); err != nil {
+		return fmt.Errorf("while reading %s: %w", configFile, err)
+	}
+
+	for _, doc := range configGraph(uri) {
+		contents, err := graphFile(doc.URI)
+		if err != nil {
+			return fmt.Errorf("while reading %s: %w", doc.URI.Filename(), err)
+		}
+
+		problems := migrationProblems(doc.Document, strings.Split(contents, "\n"), migrations)
+		slices.SortStableFunc(problems, func(a, b configProblem) int {
+			return int(a.Diagnostic.Range.Start.Line) - int(b.Diagnostic.Range.Start.Line)
+		})
+
+		edits := make([]protocol.TextEdit, 0)
+		for _, problem := range problems {
+			fmt.Fprintf(out, "%s:%d: %s\n", doc.URI.Filename(), problem.Diagnostic.Range.Start.Line+1, problem.Diagnostic.Message)
+			if len(problem.Fixes) == 0 {
+				fmt.Fprintf(out, "%s:%d: not migrated, rewrite it by hand\n", doc.URI.Filename(), problem.Diagnostic.Range.Start.Line+1)
+				continue
+			}
+			edits = append(edits, problem.Fixes[0].Edits...)
+		}
+		if !write || len(edits) == 0 {
+			continue
+		}
+
+		info, err := os.Stat(doc.URI.Filename())
+		if err != nil {
+			return fmt.Errorf("while writing %s: %w", doc.URI.Filename(), err)
+		}
+		if err := os.WriteFile(doc.URI.Filename(), []byte(applyTextEdits(contents, edits)), info.Mode().Perm()); err != nil {
+			return fmt.Errorf("while writing %s: %w", doc.URI.Filename(), err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package hyprls
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hyprland-community/hyprls/parser"
+	parser_data "github.com/hyprland-community/hyprls/parser/data"
+	"go.lsp.dev/protocol"
+)
+
+func TestMigrationsBetween(t *testing.T) {
+	if parser_data.CompareVersions("0.45", "0.45.0") != 0 || parser_data.CompareVersions("0.9", "0.45.1") != -1 {
+		t.Error("unexpected version comparison")
+	}
+	for _, migration := range parser_data.MigrationsBetween("0.45", "0.48.1") {
+		if migration.Version != "0.48.0" {
+			t.Errorf("unexpected migration from %s", migration.Version)
+		}
+	}
+}
+
+func TestMigrationProblems(t *testing.T) {
+	before := `decoration {
+    drop_shadow = yes
+}
+master:new_is_master = false
+dwindle {
+    no_gaps_when_only = 1
+}
+windowrule = float, ^(pavucontrol)$
+windowrulev2 = float, class:^(kitty)$
+`
+	after := `decoration {
+    shadow:enabled = yes
+}
+master:new_status = slave
+dwindle {
+}
+windowrule = float, class:^(pavucontrol)$
+windowrule = float, class:^(kitty)$
+`
+	document, err := parser.Parse(before)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	problems := migrationProblems(document, strings.Split(before, "\n"), parser_data.MigrationsBetween("0.40", "0.48"))
+	if len(problems) != 5 {
+		t.Fatalf("expected 5 problems, got %d", len(problems))
+	}
+	edits := make([]protocol.TextEdit, 0)
+	for _, problem := range problems {
+		if len(problem.Diagnostic.Tags) != 1 || problem.Diagnostic.Tags[0] != protocol.DiagnosticTagDeprecated {
+			t.Errorf("expected %q to be tagged as deprecated", problem.Diagnostic.Message)
+		}
+		edits = append(edits, problem.Fixes[0].Edits...)
+	}
+	if migrated := applyTextEdits(before, edits); migrated != after {
+		t.Errorf("expected\n%s\ngot\n%s", after, migrated)
+	}
+}
+
+func TestWindowRuleMatchSyntax(t *testing.T) {
+	contents := `windowrule = float, ^(pavucontrol)$
+windowrulev2 = float, class:^(kitty)$
+windowrule = float, class:^(kitty)$
+windowrule = float on, match:class ^(kitty)$
+`
+	document, err := parser.Parse(contents)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	problems := migrationProblems(document, strings.Split(contents, "\n"), parser_data.MigrationsBetween("0.40", "0.55"))
+	if len(problems) != 3 {
+		t.Fatalf("expected the 3 rules written in the old syntax to be reported, got %d problems", len(problems))
+	}
+	for _, problem := range problems {
+		if !strings.Contains(problem.Diagnostic.Message, "match:PROP REGEX") || len(problem.Fixes) != 0 {
+			t.Errorf("expected %q to point to the match: syntax without a fix", problem.Diagnostic.Message)
+		}
+	}
+}
+
+func TestMigrateConfig(t *testing.T) {
+	directory := t.TempDir()
+	mainFile := filepath.Join(directory, "hyprland.conf")
+	os.WriteFile(mainFile, []byte("general {\n    gaps_in = 4\n}\ngeneral:cursor_inactive_timeout = 3\ndecoration {\n    shadow_range = 4\n}\nwindowrulev2 = float, class:kitty\n"), 0644)
+
+	var report strings.Builder
+	if err := MigrateConfig(&report, mainFile, "0.44", "0.55", true); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(report.String(), "hyprland.conf:6: decoration:shadow_range was renamed") || !strings.Contains(report.String(), "hyprland.conf:8: not migrated") {
+		t.Errorf("unexpected report: %s", report.String())
+	}
+	contents, _ := os.ReadFile(mainFile)
+	if string(contents) != "general {\n    gaps_in = 4\n}\ngeneral:cursor_inactive_timeout = 3\ndecoration {\n    shadow:range = 4\n}\nwindowrulev2 = float, class:kitty\n" {
+		t.Errorf("unexpected migrated config:\n%s", contents)
+	}
+}
@@ -0,0 +1,54 @@
+package parser_data
+
+type AnimationDefinition struct {
+	Name        string
+	Description string
+	// Parent is the name of the animation this one inherits its values from. Empty for the root animation ("global")
+	Parent string
+	// Styles are the styles this animation accepts, not including the ones inherited from its parent.
+	Styles []string
+}
+
+// BezierCurves are the curves that are always available, without needing a bezier = ... declaration.
+// Values are the two control points X0, Y0, X1, Y1 of the cubic bezier curve.
+var BezierCurves = map[string][4]float64{
+	"default": {0.0, 0.75, 0.15, 1.0},
+	"linear":  {0.0, 0.0, 1.0, 1.0},
+}
+
+var Animations = []AnimationDefinition{}
+
+func FindAnimation(name string) (animation AnimationDefinition, found bool) {
+	for _, a := range Animations {
+		if a.Name == name {
+			return a, true
+		}
+	}
+	return AnimationDefinition{}, false
+}
+
+// Ancestors returns the chain of animations this animation inherits from, starting with its parent and ending with the root animation.
+func (a AnimationDefinition) Ancestors() []AnimationDefinition {
+	ancestors := make([]AnimationDefinition, 0)
+	for parent, found := FindAnimation(a.Parent); found; parent, found = FindAnimation(parent.Parent) {
+		ancestors = append(ancestors, parent)
+	}
+	return ancestors
+}
+
+// AvailableStyles returns the styles accepted by the animation, inheriting them from the closest ancestor that declares some if the animation doesn't.
+func (a AnimationDefinition) AvailableStyles() []string {
+	if len(a.Styles) > 0 {
+		return a.Styles
+	}
+	for _, ancestor := range a.Ancestors() {
+		if len(ancestor.Styles) > 0 {
+			return ancestor.Styles
+		}
+	}
+	return []string{}
+}
+
+func (a AnimationDefinition) DocumentationLink() string {
+	return "https://wiki.hyprland.org/Configuring/Animations/#animation-tree"
+}
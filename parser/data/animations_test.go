@@ -0,0 +1,41 @@
+package parser_data
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestAnimationTree(t *testing.T) {
+	windowsIn, found := FindAnimation("windowsIn")
+	if !found {
+		t.Fatal("windowsIn not found")
+	}
+	if windowsIn.Parent != "windows" {
+		t.Errorf("unexpected parent for windowsIn: %q", windowsIn.Parent)
+	}
+	if !slices.Equal(windowsIn.AvailableStyles(), []string{"slide", "popin", "gnomed"}) {
+		t.Errorf("unexpected styles for windowsIn: %v", windowsIn.AvailableStyles())
+	}
+
+	fadePopupsIn, found := FindAnimation("fadePopupsIn")
+	if !found {
+		t.Fatal("fadePopupsIn not found")
+	}
+	ancestors := make([]string, 0)
+	for _, a := range fadePopupsIn.Ancestors() {
+		ancestors = append(ancestors, a.Name)
+	}
+	if !slices.Equal(ancestors, []string{"fadePopups", "fade", "global"}) {
+		t.Errorf("unexpected ancestors for fadePopupsIn: %v", ancestors)
+	}
+
+	borderangle, _ := FindAnimation("borderangle")
+	if !slices.Equal(borderangle.Styles, []string{"once", "loop"}) {
+		t.Errorf("unexpected styles for borderangle: %v", borderangle.Styles)
+	}
+
+	layersOut, _ := FindAnimation("layersOut")
+	if !slices.Equal(layersOut.AvailableStyles(), []string{"slide", "popin", "fade"}) {
+		t.Errorf("unexpected inherited styles for layersOut: %v", layersOut.AvailableStyles())
+	}
+}
@@ -0,0 +1,25 @@
+package parser_data
+
+// DispatcherDefinition is a dispatcher that keybindings can run, such as workspace or exec.
+type DispatcherDefinition struct {
+	Name        string
+	Description string
+	// Params describes the parameters the dispatcher takes, as written on the wiki
+	Params string
+}
+
+var Dispatchers = []DispatcherDefinition{}
+
+func FindDispatcher(name string) (dispatcher DispatcherDefinition, found bool) {
+	for _, d := range Dispatchers {
+		if d.Name == name {
+			return d, true
+		}
+	}
+	return DispatcherDefinition{}, false
+}
+
+// TakesNoParams is true when the dispatcher is documented as taking no parameters.
+func (d DispatcherDefinition) TakesNoParams() bool {
+	return d.Params == "none"
+}
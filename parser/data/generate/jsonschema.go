@@ -0,0 +1,120 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	. "github.com/hyprland-community/hyprls/parser/data"
+)
+
+// jsonSchema is the subset of JSON Schema (draft 2020-12) needed to describe the configuration.
+type jsonSchema struct {
+	Schema               string                `json:"$schema,omitempty"`
+	Title                string                `json:"title,omitempty"`
+	Description          string                `json:"description,omitempty"`
+	Type                 string                `json:"type,omitempty"`
+	AnyOf                []jsonSchema          `json:"anyOf,omitempty"`
+	Enum                 []any                 `json:"enum,omitempty"`
+	Minimum              *float64              `json:"minimum,omitempty"`
+	Maximum              *float64              `json:"maximum,omitempty"`
+	Default              any                   `json:"default,omitempty"`
+	Properties           map[string]jsonSchema `json:"properties,omitempty"`
+	AdditionalProperties *bool                 `json:"additionalProperties,omitempty"`
+}
+
+// jsonSchemaSource renders the configuration sections as a JSON Schema, with the allowed values and bounds of options as enums and minimums and maximums, to validate configurations generated from JSON, YAML or Nix.
+// Keywords such as bind or exec-once and custom variables are allowed but not validated.
+func jsonSchemaSource(schema Schema) ([]byte, error) {
+	root := jsonSchema{
+		Schema:      "https://json-schema.org/draft/2020-12/schema",
+		Title:       fmt.Sprintf("Hyprland %s configuration", schema.Version),
+		Type:        "object",
+		Properties:  map[string]jsonSchema{},
+		Description: "Generated by hyprls from the Hyprland wiki",
+	}
+
+	for _, section := range schema.Sections {
+		parent := root.Properties
+		for _, name := range section.Path {
+			name = strings.ToLower(name)
+			object, ok := parent[name]
+			if !ok {
+				closed := false
+				object = jsonSchema{Type: "object", Properties: map[string]jsonSchema{}, AdditionalProperties: &closed}
+				parent[name] = object
+			}
+			parent = object.Properties
+		}
+		for _, variable := range section.Variables {
+			parent[variable.Name] = variableJSONSchema(variable)
+		}
+	}
+
+	return json.MarshalIndent(root, "", "  ")
+}
+
+func variableJSONSchema(variable VariableDefinition) jsonSchema {
+	schema := jsonSchema{Description: variable.Description}
+	switch variable.Type {
+	case "bool":
+		schema.Type = "boolean"
+	case "int":
+		schema.Type = "integer"
+	case "float", "floatvalue":
+		schema.Type = "number"
+	case "font_weight":
+		schema.AnyOf = []jsonSchema{{Type: "integer"}, {Type: "string"}}
+	default:
+		schema.Type = "string"
+	}
+
+	for _, allowed := range variable.AllowedValues {
+		if converted, ok := jsonValue(schema.Type, allowed.Value); ok && allowed.Parameters == "" {
+			schema.Enum = append(schema.Enum, converted)
+		} else {
+			schema.Enum = nil
+			break
+		}
+	}
+
+	if variable.Bounds != nil && (schema.Type == "integer" || schema.Type == "number") {
+		schema.Minimum, schema.Maximum = &variable.Bounds.Min, &variable.Bounds.Max
+	}
+
+	if variable.Default == "[[Empty]]" {
+		// Leaving the option empty is valid too
+		schema.Default = ""
+		if schema.Enum != nil {
+			schema.Enum = append(schema.Enum, "")
+		}
+	} else if converted, ok := jsonValue(schema.Type, variable.Default); ok {
+		schema.Default = converted
+	}
+	return schema
+}
+
+// jsonValue converts a value as written in a Hyprland configuration to a JSON value of the given JSON Schema type.
+func jsonValue(typ string, value string) (any, bool) {
+	value = strings.TrimSpace(value)
+	switch typ {
+	case "boolean":
+		switch value {
+		case "true", "yes", "on", "1":
+			return true, true
+		case "false", "no", "off", "0":
+			return false, true
+		}
+		return nil, false
+	case "integer":
+		converted, err := strconv.Atoi(value)
+		return converted, err == nil
+	case "number":
+		converted, err := strconv.ParseFloat(value, 64)
+		return converted, err == nil
+	case "string":
+		return value, true
+	}
+	return nil, false
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	. "github.com/hyprland-community/hyprls/parser/data"
+)
+
+func allowed(values ...string) []AllowedValue {
+	allowedValues := make([]AllowedValue, 0, len(values))
+	for _, value := range values {
+		allowedValues = append(allowedValues, AllowedValue{Value: value})
+	}
+	return allowedValues
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
+
+func TestVariableJSONSchema(t *testing.T) {
+	for _, test := range []struct {
+		variable VariableDefinition
+		expected jsonSchema
+	}{
+		{
+			VariableDefinition{Name: "layout", Description: "which layout to use. [dwindle/master/scrolling/monocle]", Type: "str", Default: "dwindle", AllowedValues: allowed("dwindle", "master", "scrolling", "monocle")},
+			jsonSchema{Description: "which layout to use. [dwindle/master/scrolling/monocle]", Type: "string", Enum: []any{"dwindle", "master", "scrolling", "monocle"}, Default: "dwindle"},
+		},
+		{
+			VariableDefinition{Name: "follow_mouse", Description: "[0/1/2/3]", Type: "int", Default: "1", AllowedValues: allowed("0", "1", "2", "3")},
+			jsonSchema{Description: "[0/1/2/3]", Type: "integer", Enum: []any{0, 1, 2, 3}, Default: 1},
+		},
+		{
+			VariableDefinition{Name: "tap_button_map", Description: "[lrm/lmr]", Type: "str", Default: "[[Empty]]", AllowedValues: allowed("lrm", "lmr")},
+			jsonSchema{Description: "[lrm/lmr]", Type: "string", Enum: []any{"lrm", "lmr", ""}, Default: ""},
+		},
+		{
+			VariableDefinition{Name: "active_opacity", Description: "[0.0 - 1.0]", Type: "float", Default: "1.0", Bounds: &ValueBounds{Min: 0, Max: 1}},
+			jsonSchema{Description: "[0.0 - 1.0]", Type: "number", Minimum: ptr(0.0), Maximum: ptr(1.0), Default: 1.0},
+		},
+		{
+			VariableDefinition{Name: "resize_on_border", Type: "bool", Default: "yes"},
+			jsonSchema{Type: "boolean", Default: true},
+		},
+		{
+			VariableDefinition{Name: "single_window_aspect_ratio_tolerance", Description: "[0 - 1]", Type: "int", Default: "0.1"},
+			jsonSchema{Description: "[0 - 1]", Type: "integer"},
+		},
+	} {
+		if actual := variableJSONSchema(test.variable); !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("%s: expected %+v, got %+v", test.variable.Name, test.expected, actual)
+		}
+	}
+}
+
+func TestJSONSchemaSource(t *testing.T) {
+	source, err := jsonSchemaSource(SchemaFor(HyprlandVersion))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var schema jsonSchema
+	if err := json.Unmarshal(source, &schema); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := schema.Properties["decoration"].Properties["shadow"].Properties["range"]; !ok {
+		t.Error("expected decoration:shadow:range to be described")
+	}
+	if schema.Properties["input"].Properties["touchpad"].AdditionalProperties == nil {
+		t.Error("expected sections to not allow unknown options")
+	}
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"go/format"
+	"regexp"
+	"strconv"
+)
+
+// xkbcommonKeysyms is vendored from libxkbcommon, see the comment at its top.
+//
+//go:embed sources/xkbcommon-keysyms.h
+var xkbcommonKeysyms []byte
+
+var keysymDefinePattern = regexp.MustCompile(`(?m)^#define XKB_KEY_(\w+)\s+0x([0-9a-fA-F]+)`)
+
+// keysymsSource renders the keysyms defined in header as parser/data/keysyms_generated.go, in the order of the header.
+func keysymsSource(header []byte) ([]byte, error) {
+	var out bytes.Buffer
+	out.WriteString("// Code generated by parser/data/generate from xkbcommon-keysyms.h; DO NOT EDIT.\n\npackage parser_data\n\n")
+	out.WriteString("var Keysyms = []Keysym{\n")
+	for _, match := range keysymDefinePattern.FindAllSubmatch(header, -1) {
+		value, err := strconv.ParseUint(string(match[2]), 16, 32)
+		if err != nil {
+			return nil, fmt.Errorf("while parsing the value of keysym %s: %w", match[1], err)
+		}
+		fmt.Fprintf(&out, "{Name: %q, Value: %#x},\n", match[1], value)
+	}
+	out.WriteString("}\n")
+	return format.Source(out.Bytes())
+}
@@ -7,11 +7,51 @@ import (
 
 	"github.com/MakeNowJust/heredoc/v2"
 	. "github.com/hyprland-community/hyprls/parser/data"
+	"github.com/hyprland-community/hyprls/parser/data/wiki"
 )
 
+// Usage:
+//
+//	generate AST_JSON > highlevel.go
+//	generate schema DOCUMENTATION_GO
+//	generate jsonschema JSON_SCHEMA
+//	generate keysyms KEYSYMS_GO
+//	generate migrations MIGRATIONS_GO
+//	generate schemas SCHEMAS_GO
 func main() {
+	schema, err := wiki.Parse(Keywords)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "while parsing the wiki: %s\n", err)
+		os.Exit(1)
+	}
+
+	if len(os.Args) > 2 {
+		var source []byte
+		var err error
+		switch os.Args[1] {
+		case "schema":
+			source, err = documentationSource(schema)
+		case "jsonschema":
+			source, err = jsonSchemaSource(schema)
+		case "migrations":
+			source, err = derivedMigrationsSource(schema, snapshotsDirectory)
+		case "schemas":
+			source, err = olderSchemasSource(schema, snapshotsDirectory)
+		case "keysyms":
+			source, err = keysymsSource(xkbcommonKeysyms)
+		}
+		if source != nil || err != nil {
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "while generating %s: %s\n", os.Args[2], err)
+				os.Exit(1)
+			}
+			os.WriteFile(os.Args[2], source, 0644)
+			return
+		}
+	}
+
 	rootSections := make([]SectionDefinition, 0)
-	for _, section := range Sections {
+	for _, section := range schema.Sections {
 		if len(section.Path) == 1 {
 			rootSections = append(rootSections, section)
 		}
@@ -22,7 +62,7 @@ func main() {
 		Keywords []KeywordDefinition `json:"keywords"`
 	}{
 		Sections: rootSections,
-		Keywords: Keywords,
+		Keywords: schema.Keywords,
 	})
 	os.WriteFile(os.Args[1], jsoned, 0644)
 
@@ -38,11 +78,11 @@ func main() {
 		fmt.Printf("\t%s %s `json:\"%s\"`\n", section.Name(), section.TypeName(), section.JSONName())
 	}
 
-	fmt.Println("}\n\n")
+	fmt.Print("}\n\n\n")
 
 	for _, section := range rootSections {
 		fmt.Println(section.Typedef())
 	}
 
-	fmt.Println("\n\n")
+	fmt.Print("\n\n\n")
 }
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	. "github.com/hyprland-community/hyprls/parser/data"
+	"github.com/hyprland-community/hyprls/parser/data/wiki"
+)
+
+// derivedMigrationsSource renders the options removed and added between the wiki snapshots as parser/data/migrations_generated.go.
+func derivedMigrationsSource(documented Schema, snapshots string) ([]byte, error) {
+	schemas, err := snapshotSchemas(documented, snapshots)
+	if err != nil {
+		return nil, err
+	}
+
+	var out bytes.Buffer
+	out.WriteString("// Code generated by parser/data/generate; DO NOT EDIT.\n\npackage parser_data\n\n")
+	out.WriteString("var derivedMigrations = []Migration{\n")
+	for _, migration := range deriveMigrations(append(schemas, documented)) {
+		fmt.Fprintf(&out, "{Kind: %s, Version: %q, Option: %q", migrationKindName(migration.Kind), migration.Version, migration.Option)
+		if migration.Type != "" || migration.Default != "" {
+			fmt.Fprintf(&out, ", Type: %q, Default: %q", migration.Type, migration.Default)
+		}
+		out.WriteString("},\n")
+	}
+	out.WriteString("}\n")
+	return format.Source(out.Bytes())
+}
+
+func migrationKindName(kind MigrationKind) string {
+	switch kind {
+	case OptionRemoved:
+		return "OptionRemoved"
+	case OptionAdded:
+		return "OptionAdded"
+	}
+	panic(fmt.Sprintf("migrations of kind %d are not derived", kind))
+}
+
+// snapshotSchemas parses the wiki snapshots of the versions older than documented, oldest first.
+func snapshotSchemas(documented Schema, snapshots string) ([]Schema, error) {
+	entries, err := os.ReadDir(snapshots)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("while listing wiki snapshots: %w", err)
+	}
+	schemas := make([]Schema, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() && ValidVersion(entry.Name()) && CompareVersions(entry.Name(), documented.Version) < 0 {
+			version := NormalizeVersion(entry.Name())
+			schema, err := wiki.ParseFS(os.DirFS(filepath.Join(snapshots, entry.Name())), version, documented.Keywords)
+			if err != nil {
+				return nil, fmt.Errorf("while parsing the wiki snapshot of %s: %w", version, err)
+			}
+			schemas = append(schemas, schema)
+		}
+	}
+	slices.SortFunc(schemas, func(a, b Schema) int { return CompareVersions(a.Version, b.Version) })
+	return schemas, nil
+}
+
+// deriveMigrations lists the options removed and added between successive schemas, oldest first.
+// The wiki only tells that an option changed somewhere between two snapshots: removals are dated to the newer snapshot
+// and additions to the minor version after the older one, so that no version gets diagnostics for options it has.
+func deriveMigrations(schemas []Schema) []Migration {
+	migrations := make([]Migration, 0)
+	for i := 1; i < len(schemas); i++ {
+		older, newer := schemaOptions(schemas[i-1]), schemaOptions(schemas[i])
+		for _, option := range slices.Sorted(maps.Keys(older)) {
+			if _, kept := newer[option]; !kept {
+				migrations = append(migrations, Migration{Kind: OptionRemoved, Version: schemas[i].Version, Option: option, Type: older[option].Type, Default: older[option].Default})
+			}
+		}
+		for _, option := range slices.Sorted(maps.Keys(newer)) {
+			if _, existed := older[option]; !existed {
+				migrations = append(migrations, Migration{Kind: OptionAdded, Version: nextMinorVersion(schemas[i-1].Version), Option: option})
+			}
+		}
+	}
+	slices.SortStableFunc(migrations, func(a, b Migration) int { return CompareVersions(a.Version, b.Version) })
+	return migrations
+}
+
+// schemaOptions maps the full path of the options of a schema, such as decoration:shadow:range, to their definition.
+func schemaOptions(schema Schema) map[string]VariableDefinition {
+	options := make(map[string]VariableDefinition)
+	for _, section := range schema.Sections {
+		for _, variable := range section.Variables {
+			options[strings.ToLower(strings.Join(append(slices.Clone(section.Path), variable.Name), ":"))] = variable
+		}
+	}
+	return options
+}
+
+func nextMinorVersion(version string) string {
+	var major, minor int
+	fmt.Sscanf(NormalizeVersion(version), "%d.%d", &major, &minor)
+	return fmt.Sprintf("%d.%d.0", major, minor+1)
+}
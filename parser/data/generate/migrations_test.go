@@ -0,0 +1,44 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	. "github.com/hyprland-community/hyprls/parser/data"
+)
+
+func TestDeriveMigrations(t *testing.T) {
+	schemas := []Schema{
+		{Version: "0.44.0", Sections: []SectionDefinition{{Path: []string{"Decoration"}, Variables: []VariableDefinition{
+			{Name: "rounding", Type: "int", Default: "0"},
+			{Name: "drop_shadow", Type: "bool", Default: "true"},
+			{Name: "blur_size", Type: "int", Default: "8"},
+		}}}},
+		{Version: "0.46.0", Sections: []SectionDefinition{
+			{Path: []string{"Decoration"}, Variables: []VariableDefinition{{Name: "rounding", Type: "int", Default: "0"}}},
+			{Path: []string{"Decoration", "Shadow"}, Variables: []VariableDefinition{{Name: "enabled", Type: "bool", Default: "true"}}},
+		}},
+	}
+
+	derived := deriveMigrations(schemas)
+	expected := []Migration{
+		{Kind: OptionRemoved, Version: "0.46.0", Option: "decoration:blur_size", Type: "int", Default: "8"},
+		{Kind: OptionRemoved, Version: "0.46.0", Option: "decoration:drop_shadow", Type: "bool", Default: "true"},
+		{Kind: OptionAdded, Version: "0.45.0", Option: "decoration:shadow:enabled"},
+	}
+	if !reflect.DeepEqual(derived, append(expected[2:], expected[:2]...)) {
+		t.Fatalf("expected %+v, got %+v", expected, derived)
+	}
+
+	merged := MergeMigrations([]Migration{
+		{Kind: OptionRenamed, Version: "0.45.0", Option: "decoration:drop_shadow", RenamedTo: "decoration:shadow:enabled"},
+		{Kind: OptionRemoved, Version: "0.46.0", Option: "decoration:blur_size", Note: "use decoration:blur:size instead"},
+	}, derived)
+	expected = []Migration{
+		{Kind: OptionRenamed, Version: "0.45.0", Option: "decoration:drop_shadow", RenamedTo: "decoration:shadow:enabled"},
+		{Kind: OptionRemoved, Version: "0.46.0", Option: "decoration:blur_size", Type: "int", Default: "8", Note: "use decoration:blur:size instead"},
+	}
+	if !reflect.DeepEqual(merged, expected) {
+		t.Errorf("expected the overlay to replace the rename and describe the removal, got %+v", merged)
+	}
+}
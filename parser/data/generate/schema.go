@@ -0,0 +1,77 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"maps"
+	"slices"
+	"strconv"
+
+	. "github.com/hyprland-community/hyprls/parser/data"
+)
+
+// documentationSource renders the parsed wiki as parser/data/documentation_generated.go,
+// so that the language server doesn't have to parse markdown when starting.
+func documentationSource(schema Schema) ([]byte, error) {
+	var out bytes.Buffer
+	out.WriteString("// Code generated by parser/data/generate; DO NOT EDIT.\n\npackage parser_data\n\n")
+
+	// Subsections are attached when loading, to avoid repeating them
+	out.WriteString("var documentedSections = ")
+	writeSections(&out, schema.Sections)
+	out.WriteString("\n\n")
+
+	out.WriteString("var documentedAnimations = []AnimationDefinition{\n")
+	for _, a := range schema.Animations {
+		fmt.Fprintf(&out, "{Name: %q, Description: %q, Parent: %q, Styles: %#v},\n", a.Name, a.Description, a.Parent, a.Styles)
+	}
+	out.WriteString("}\n\n")
+
+	out.WriteString("var documentedDispatchers = []DispatcherDefinition{\n")
+	for _, d := range schema.Dispatchers {
+		fmt.Fprintf(&out, "{Name: %q, Description: %q, Params: %q},\n", d.Name, d.Description, d.Params)
+	}
+	out.WriteString("}\n\n")
+
+	descriptions := make(map[string]string)
+	for _, kw := range schema.Keywords {
+		descriptions[kw.Name] = kw.Description
+	}
+	out.WriteString("var documentedKeywordDescriptions = map[string]string{\n")
+	for _, name := range slices.Sorted(maps.Keys(descriptions)) {
+		fmt.Fprintf(&out, "%q: %q,\n", name, descriptions[name])
+	}
+	out.WriteString("}\n")
+
+	return format.Source(out.Bytes())
+}
+
+// writeSections renders sections as a []SectionDefinition literal, without their subsections.
+func writeSections(out *bytes.Buffer, sections []SectionDefinition) {
+	out.WriteString("[]SectionDefinition{\n")
+	for _, section := range sections {
+		fmt.Fprintf(out, "{\nPath: %#v,\nDescription: %q,\nDocumentationFile: %q,\nDocumentationHeadingSlug: %q,\nVariables: []VariableDefinition{\n",
+			section.Path, section.Description, section.DocumentationFile, section.DocumentationHeadingSlug)
+		for _, v := range section.Variables {
+			fmt.Fprintf(out, "{Name: %q, Description: %q, Type: %q, Default: %q", v.Name, v.Description, v.Type, v.Default)
+			if v.AllowedValues != nil {
+				out.WriteString(", AllowedValues: []AllowedValue{\n")
+				for _, allowed := range v.AllowedValues {
+					fmt.Fprintf(out, "{Value: %q, Description: %q", allowed.Value, allowed.Description)
+					if allowed.Parameters != "" {
+						fmt.Fprintf(out, ", Parameters: %q", allowed.Parameters)
+					}
+					out.WriteString("},\n")
+				}
+				out.WriteString("}")
+			}
+			if v.Bounds != nil {
+				fmt.Fprintf(out, ", Bounds: &ValueBounds{Min: %s, Max: %s}", strconv.FormatFloat(v.Bounds.Min, 'f', -1, 64), strconv.FormatFloat(v.Bounds.Max, 'f', -1, 64))
+			}
+			out.WriteString("},\n")
+		}
+		out.WriteString("},\n},\n")
+	}
+	out.WriteString("}")
+}
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	. "github.com/hyprland-community/hyprls/parser/data"
+)
+
+// snapshotsDirectory holds wiki snapshots of older Hyprland versions, one Configuring directory per version, such as wiki/versions/0.45.0. See just pull-wiki-versions.
+const snapshotsDirectory = "wiki/versions"
+
+// olderSchemasSource renders the schemas of the Hyprland versions older than documented as parser/data/schemas_generated.go.
+// There is a schema per version that has a snapshot in snapshotsDirectory, and per version that a migration happened in.
+// Versions without a snapshot are derived from the next newer schema by undoing the migrations between them,
+// so they only differ by the options that migrations list.
+// Only sections are rendered: keywords, animations and dispatchers are shared by every schema.
+func olderSchemasSource(documented Schema, snapshots string) ([]byte, error) {
+	schemas, err := olderSchemas(documented, snapshots)
+	if err != nil {
+		return nil, err
+	}
+
+	var out bytes.Buffer
+	out.WriteString("// Code generated by parser/data/generate; DO NOT EDIT.\n\npackage parser_data\n\n")
+	out.WriteString("var olderSchemas = []Schema{\n")
+	for _, schema := range schemas {
+		fmt.Fprintf(&out, "{\nVersion: %q,\nUntil: %q,\nSections: ", schema.Version, schema.Until)
+		writeSections(&out, schema.Sections)
+		out.WriteString(",\n},\n")
+	}
+	out.WriteString("}\n")
+	return format.Source(out.Bytes())
+}
+
+// olderSchemas returns the schemas of the versions older than documented, oldest first.
+func olderSchemas(documented Schema, snapshots string) ([]Schema, error) {
+	snapshotted, err := snapshotSchemas(documented, snapshots)
+	if err != nil {
+		return nil, err
+	}
+	migrations := MergeMigrations(MigrationOverlay, deriveMigrations(append(slices.Clone(snapshotted), documented)))
+
+	versions := make([]string, 0)
+	addVersion := func(version string) {
+		if ValidVersion(version) && CompareVersions(version, documented.Version) < 0 && !slices.ContainsFunc(versions, func(v string) bool { return CompareVersions(v, version) == 0 }) {
+			versions = append(versions, NormalizeVersion(version))
+		}
+	}
+	for _, schema := range snapshotted {
+		addVersion(schema.Version)
+	}
+	for _, migration := range migrations {
+		addVersion(migration.Version)
+	}
+	if len(versions) == 0 {
+		return []Schema{}, nil
+	}
+	slices.SortFunc(versions, CompareVersions)
+	snapshotOf := func(version string) (Schema, bool) {
+		i := slices.IndexFunc(snapshotted, func(s Schema) bool { return s.Version == version })
+		if i < 0 {
+			return Schema{}, false
+		}
+		return snapshotted[i], true
+	}
+	// The oldest schema documents the versions before the oldest change
+	if _, ok := snapshotOf(versions[0]); !ok {
+		versions = slices.Insert(versions, 0, previousMinorVersion(versions[0]))
+	}
+
+	schemas := make([]Schema, 0, len(versions))
+	newer := documented
+	for _, version := range slices.Backward(versions) {
+		schema, ok := snapshotOf(version)
+		if !ok {
+			schema = cloneSchema(newer)
+			for _, migration := range slices.Backward(migrations) {
+				if CompareVersions(migration.Version, version) > 0 && CompareVersions(migration.Version, newer.Version) <= 0 {
+					undoMigration(&schema, migration)
+				}
+			}
+			if reflect.DeepEqual(schema.Sections, cloneSchema(newer).Sections) {
+				// No option changed, the newer schema documents this version too
+				if len(schemas) > 0 {
+					schemas[0].Version = version
+				}
+				newer.Version = version
+				continue
+			}
+		}
+		schema.Version, schema.Until = version, newer.Version
+		schemas = slices.Insert(schemas, 0, schema)
+		newer = schema
+	}
+	return schemas, nil
+}
+
+func previousMinorVersion(version string) string {
+	var major, minor int
+	fmt.Sscanf(NormalizeVersion(version), "%d.%d", &major, &minor)
+	return fmt.Sprintf("%d.%d.0", major, max(0, minor-1))
+}
+
+func cloneSchema(s Schema) Schema {
+	clone := s
+	clone.Sections = make([]SectionDefinition, 0, len(s.Sections))
+	for _, section := range s.Sections {
+		section.Path = slices.Clone(section.Path)
+		section.Variables = slices.Clone(section.Variables)
+		section.Subsections = nil
+		clone.Sections = append(clone.Sections, section)
+	}
+	return clone
+}
+
+// sectionOf returns the index of the section that holds the option at path, such as decoration:shadow:range, creating the section if needed.
+func sectionOf(s *Schema, path []string) int {
+	sectionPath := path[:len(path)-1]
+	for i, section := range s.Sections {
+		if slices.EqualFunc(section.Path, sectionPath, strings.EqualFold) {
+			return i
+		}
+	}
+
+	section := SectionDefinition{Variables: []VariableDefinition{}}
+	for _, name := range sectionPath {
+		section.Path = append(section.Path, strings.ToUpper(name[:1])+name[1:])
+	}
+	s.Sections = append(s.Sections, section)
+	return len(s.Sections) - 1
+}
+
+// removeOption removes the option at path, and its section if it has no options left.
+func removeOption(s *Schema, path []string) (removed VariableDefinition, found bool) {
+	i := sectionOf(s, path)
+	j := slices.IndexFunc(s.Sections[i].Variables, func(v VariableDefinition) bool { return v.Name == path[len(path)-1] })
+	if j >= 0 {
+		removed, found = s.Sections[i].Variables[j], true
+		s.Sections[i].Variables = slices.Delete(s.Sections[i].Variables, j, j+1)
+	}
+	if len(s.Sections[i].Variables) == 0 && len(s.Sections[i].Path) > 1 {
+		s.Sections = slices.Delete(s.Sections, i, i+1)
+	}
+	return
+}
+
+func addOption(s *Schema, path []string, variable VariableDefinition) {
+	i := sectionOf(s, path)
+	variable.Name = path[len(path)-1]
+	s.Sections[i].Variables = append(s.Sections[i].Variables, variable)
+}
+
+// undoMigration changes the schema to the one of the Hyprland version before the migration.
+// Keywords are not changed: renamed keywords stay known so that configurations using them can be parsed.
+func undoMigration(s *Schema, migration Migration) {
+	switch migration.Kind {
+	case OptionRenamed:
+		variable, found := removeOption(s, strings.Split(migration.RenamedTo, ":"))
+		if !found {
+			return
+		}
+		if len(migration.Values) > 0 {
+			variable.Type, variable.Default = oldValueType(migration.Values, variable.Default)
+			variable.Description = fmt.Sprintf("Became %s in Hyprland %s, with other values. %s", migration.RenamedTo, migration.Version, variable.Description)
+			variable.AllowedValues, variable.Bounds = nil, nil
+		}
+		addOption(s, strings.Split(migration.Option, ":"), variable)
+
+	case OptionRemoved:
+		addOption(s, strings.Split(migration.Option, ":"), VariableDefinition{
+			Description: fmt.Sprintf("Removed in Hyprland %s, %s", migration.Version, migration.Note),
+			Type:        migration.Type,
+			Default:     migration.Default,
+		})
+
+	case OptionAdded:
+		removeOption(s, strings.Split(migration.Option, ":"))
+	}
+}
+
+// oldValueType guesses the type and default value of a renamed option before a rename that changed its values.
+func oldValueType(values map[string]string, newDefault string) (typ string, defaultValue string) {
+	typ = "str"
+	if _, ok := values["true"]; ok {
+		typ = "bool"
+	}
+	for _, old := range slices.Sorted(maps.Keys(values)) {
+		if values[old] == newDefault {
+			return typ, old
+		}
+	}
+	return typ, ""
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/hyprland-community/hyprls/parser/data"
+)
+
+func TestOlderSchemas(t *testing.T) {
+	snapshots := t.TempDir()
+	snapshot := filepath.Join(snapshots, "0.47")
+	os.MkdirAll(snapshot, 0755)
+	os.WriteFile(filepath.Join(snapshot, "Variables.md"), []byte(`### General
+
+| name | description | type | default |
+| --- | --- | --- | --- |
+| border_size | size of the border around windows | int | 1 |
+
+### Decoration
+
+| name | description | type | default |
+| --- | --- | --- | --- |
+| rounding | rounded corners' radius (in layout px) | int | 0 |
+| drop_shadow | enable drop shadows on windows | bool | true |
+`), 0644)
+
+	schemas, err := olderSchemas(Documented(), snapshots)
+	if err != nil {
+		t.Fatal(err)
+	}
+	versions := make([]string, 0)
+	for _, schema := range schemas {
+		versions = append(versions, schema.Version+"-"+schema.Until)
+	}
+	// Options didn't change between 0.45 and 0.47, so the snapshot documents 0.45 too
+	expected := []string{"0.40.0-0.45.0", "0.45.0-0.48.0", "0.48.0-0.51.0"}
+	if len(versions) != len(expected) {
+		t.Fatalf("expected schemas for %v, got %v", expected, versions)
+	}
+	for i := range expected {
+		if versions[i] != expected[i] {
+			t.Errorf("expected schemas for %v, got %v", expected, versions)
+			break
+		}
+	}
+
+	fromSnapshot := Schema{Sections: schemas[1].Sections}
+	if fromSnapshot.FindVariableDefinitionInSection("decoration", "drop_shadow") == nil || fromSnapshot.FindVariableDefinitionInSection("general", "gaps_in") != nil {
+		t.Error("expected the schema of 0.47 to be read from its snapshot")
+	}
+	derived := Schema{Sections: schemas[0].Sections}
+	if derived.FindVariableDefinitionInSection("general", "gaps_in") != nil || derived.FindVariableDefinitionInSection("dwindle", "no_gaps_when_only") == nil {
+		t.Error("expected the schema of 0.40 to be derived from the snapshot of 0.47")
+	}
+}
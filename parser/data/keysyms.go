@@ -0,0 +1,33 @@
+package parser_data
+
+import "strings"
+
+//go:generate go run ./generate keysyms keysyms_generated.go
+
+// Keysym is an XKB key symbol, which binds use as their key, such as Return or XF86AudioRaiseVolume.
+type Keysym struct {
+	Name  string
+	Value uint32
+}
+
+var keysymsByName, keysymsByLowercaseName = indexKeysyms(Keysyms)
+
+func indexKeysyms(keysyms []Keysym) (byName, byLowercaseName map[string]Keysym) {
+	byName, byLowercaseName = make(map[string]Keysym, len(keysyms)), make(map[string]Keysym, len(keysyms))
+	for _, keysym := range keysyms {
+		byName[keysym.Name] = keysym
+		if _, taken := byLowercaseName[strings.ToLower(keysym.Name)]; !taken {
+			byLowercaseName[strings.ToLower(keysym.Name)] = keysym
+		}
+	}
+	return
+}
+
+// FindKeysym looks up a keysym by name. Like Hyprland, names are matched case-insensitively when no keysym has the exact name.
+func FindKeysym(name string) (keysym Keysym, found bool) {
+	if keysym, found = keysymsByName[name]; found {
+		return
+	}
+	keysym, found = keysymsByLowercaseName[strings.ToLower(name)]
+	return
+}
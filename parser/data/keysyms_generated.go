@@ -0,0 +1,2457 @@
+// Code generated by parser/data/generate from xkbcommon-keysyms.h; DO NOT EDIT.
+
+package parser_data
+
+var Keysyms = []Keysym{
+	{Name: "NoSymbol", Value: 0x0},
+	{Name: "VoidSymbol", Value: 0xffffff},
+	{Name: "BackSpace", Value: 0xff08},
+	{Name: "Tab", Value: 0xff09},
+	{Name: "Linefeed", Value: 0xff0a},
+	{Name: "Clear", Value: 0xff0b},
+	{Name: "Return", Value: 0xff0d},
+	{Name: "Pause", Value: 0xff13},
+	{Name: "Scroll_Lock", Value: 0xff14},
+	{Name: "Sys_Req", Value: 0xff15},
+	{Name: "Escape", Value: 0xff1b},
+	{Name: "Delete", Value: 0xffff},
+	{Name: "Multi_key", Value: 0xff20},
+	{Name: "Codeinput", Value: 0xff37},
+	{Name: "SingleCandidate", Value: 0xff3c},
+	{Name: "MultipleCandidate", Value: 0xff3d},
+	{Name: "PreviousCandidate", Value: 0xff3e},
+	{Name: "Kanji", Value: 0xff21},
+	{Name: "Muhenkan", Value: 0xff22},
+	{Name: "Henkan_Mode", Value: 0xff23},
+	{Name: "Henkan", Value: 0xff23},
+	{Name: "Romaji", Value: 0xff24},
+	{Name: "Hiragana", Value: 0xff25},
+	{Name: "Katakana", Value: 0xff26},
+	{Name: "Hiragana_Katakana", Value: 0xff27},
+	{Name: "Zenkaku", Value: 0xff28},
+	{Name: "Hankaku", Value: 0xff29},
+	{Name: "Zenkaku_Hankaku", Value: 0xff2a},
+	{Name: "Touroku", Value: 0xff2b},
+	{Name: "Massyo", Value: 0xff2c},
+	{Name: "Kana_Lock", Value: 0xff2d},
+	{Name: "Kana_Shift", Value: 0xff2e},
+	{Name: "Eisu_Shift", Value: 0xff2f},
+	{Name: "Eisu_toggle", Value: 0xff30},
+	{Name: "Kanji_Bangou", Value: 0xff37},
+	{Name: "Zen_Koho", Value: 0xff3d},
+	{Name: "Mae_Koho", Value: 0xff3e},
+	{Name: "Home", Value: 0xff50},
+	{Name: "Left", Value: 0xff51},
+	{Name: "Up", Value: 0xff52},
+	{Name: "Right", Value: 0xff53},
+	{Name: "Down", Value: 0xff54},
+	{Name: "Prior", Value: 0xff55},
+	{Name: "Page_Up", Value: 0xff55},
+	{Name: "Next", Value: 0xff56},
+	{Name: "Page_Down", Value: 0xff56},
+	{Name: "End", Value: 0xff57},
+	{Name: "Begin", Value: 0xff58},
+	{Name: "Select", Value: 0xff60},
+	{Name: "Print", Value: 0xff61},
+	{Name: "Execute", Value: 0xff62},
+	{Name: "Insert", Value: 0xff63},
+	{Name: "Undo", Value: 0xff65},
+	{Name: "Redo", Value: 0xff66},
+	{Name: "Menu", Value: 0xff67},
+	{Name: "Find", Value: 0xff68},
+	{Name: "Cancel", Value: 0xff69},
+	{Name: "Help", Value: 0xff6a},
+	{Name: "Break", Value: 0xff6b},
+	{Name: "Mode_switch", Value: 0xff7e},
+	{Name: "script_switch", Value: 0xff7e},
+	{Name: "Num_Lock", Value: 0xff7f},
+	{Name: "KP_Space", Value: 0xff80},
+	{Name: "KP_Tab", Value: 0xff89},
+	{Name: "KP_Enter", Value: 0xff8d},
+	{Name: "KP_F1", Value: 0xff91},
+	{Name: "KP_F2", Value: 0xff92},
+	{Name: "KP_F3", Value: 0xff93},
+	{Name: "KP_F4", Value: 0xff94},
+	{Name: "KP_Home", Value: 0xff95},
+	{Name: "KP_Left", Value: 0xff96},
+	{Name: "KP_Up", Value: 0xff97},
+	{Name: "KP_Right", Value: 0xff98},
+	{Name: "KP_Down", Value: 0xff99},
+	{Name: "KP_Prior", Value: 0xff9a},
+	{Name: "KP_Page_Up", Value: 0xff9a},
+	{Name: "KP_Next", Value: 0xff9b},
+	{Name: "KP_Page_Down", Value: 0xff9b},
+	{Name: "KP_End", Value: 0xff9c},
+	{Name: "KP_Begin", Value: 0xff9d},
+	{Name: "KP_Insert", Value: 0xff9e},
+	{Name: "KP_Delete", Value: 0xff9f},
+	{Name: "KP_Equal", Value: 0xffbd},
+	{Name: "KP_Multiply", Value: 0xffaa},
+	{Name: "KP_Add", Value: 0xffab},
+	{Name: "KP_Separator", Value: 0xffac},
+	{Name: "KP_Subtract", Value: 0xffad},
+	{Name: "KP_Decimal", Value: 0xffae},
+	{Name: "KP_Divide", Value: 0xffaf},
+	{Name: "KP_0", Value: 0xffb0},
+	{Name: "KP_1", Value: 0xffb1},
+	{Name: "KP_2", Value: 0xffb2},
+	{Name: "KP_3", Value: 0xffb3},
+	{Name: "KP_4", Value: 0xffb4},
+	{Name: "KP_5", Value: 0xffb5},
+	{Name: "KP_6", Value: 0xffb6},
+	{Name: "KP_7", Value: 0xffb7},
+	{Name: "KP_8", Value: 0xffb8},
+	{Name: "KP_9", Value: 0xffb9},
+	{Name: "F1", Value: 0xffbe},
+	{Name: "F2", Value: 0xffbf},
+	{Name: "F3", Value: 0xffc0},
+	{Name: "F4", Value: 0xffc1},
+	{Name: "F5", Value: 0xffc2},
+	{Name: "F6", Value: 0xffc3},
+	{Name: "F7", Value: 0xffc4},
+	{Name: "F8", Value: 0xffc5},
+	{Name: "F9", Value: 0xffc6},
+	{Name: "F10", Value: 0xffc7},
+	{Name: "F11", Value: 0xffc8},
+	{Name: "L1", Value: 0xffc8},
+	{Name: "F12", Value: 0xffc9},
+	{Name: "L2", Value: 0xffc9},
+	{Name: "F13", Value: 0xffca},
+	{Name: "L3", Value: 0xffca},
+	{Name: "F14", Value: 0xffcb},
+	{Name: "L4", Value: 0xffcb},
+	{Name: "F15", Value: 0xffcc},
+	{Name: "L5", Value: 0xffcc},
+	{Name: "F16", Value: 0xffcd},
+	{Name: "L6", Value: 0xffcd},
+	{Name: "F17", Value: 0xffce},
+	{Name: "L7", Value: 0xffce},
+	{Name: "F18", Value: 0xffcf},
+	{Name: "L8", Value: 0xffcf},
+	{Name: "F19", Value: 0xffd0},
+	{Name: "L9", Value: 0xffd0},
+	{Name: "F20", Value: 0xffd1},
+	{Name: "L10", Value: 0xffd1},
+	{Name: "F21", Value: 0xffd2},
+	{Name: "R1", Value: 0xffd2},
+	{Name: "F22", Value: 0xffd3},
+	{Name: "R2", Value: 0xffd3},
+	{Name: "F23", Value: 0xffd4},
+	{Name: "R3", Value: 0xffd4},
+	{Name: "F24", Value: 0xffd5},
+	{Name: "R4", Value: 0xffd5},
+	{Name: "F25", Value: 0xffd6},
+	{Name: "R5", Value: 0xffd6},
+	{Name: "F26", Value: 0xffd7},
+	{Name: "R6", Value: 0xffd7},
+	{Name: "F27", Value: 0xffd8},
+	{Name: "R7", Value: 0xffd8},
+	{Name: "F28", Value: 0xffd9},
+	{Name: "R8", Value: 0xffd9},
+	{Name: "F29", Value: 0xffda},
+	{Name: "R9", Value: 0xffda},
+	{Name: "F30", Value: 0xffdb},
+	{Name: "R10", Value: 0xffdb},
+	{Name: "F31", Value: 0xffdc},
+	{Name: "R11", Value: 0xffdc},
+	{Name: "F32", Value: 0xffdd},
+	{Name: "R12", Value: 0xffdd},
+	{Name: "F33", Value: 0xffde},
+	{Name: "R13", Value: 0xffde},
+	{Name: "F34", Value: 0xffdf},
+	{Name: "R14", Value: 0xffdf},
+	{Name: "F35", Value: 0xffe0},
+	{Name: "R15", Value: 0xffe0},
+	{Name: "Shift_L", Value: 0xffe1},
+	{Name: "Shift_R", Value: 0xffe2},
+	{Name: "Control_L", Value: 0xffe3},
+	{Name: "Control_R", Value: 0xffe4},
+	{Name: "Caps_Lock", Value: 0xffe5},
+	{Name: "Shift_Lock", Value: 0xffe6},
+	{Name: "Meta_L", Value: 0xffe7},
+	{Name: "Meta_R", Value: 0xffe8},
+	{Name: "Alt_L", Value: 0xffe9},
+	{Name: "Alt_R", Value: 0xffea},
+	{Name: "Super_L", Value: 0xffeb},
+	{Name: "Super_R", Value: 0xffec},
+	{Name: "Hyper_L", Value: 0xffed},
+	{Name: "Hyper_R", Value: 0xffee},
+	{Name: "ISO_Lock", Value: 0xfe01},
+	{Name: "ISO_Level2_Latch", Value: 0xfe02},
+	{Name: "ISO_Level3_Shift", Value: 0xfe03},
+	{Name: "ISO_Level3_Latch", Value: 0xfe04},
+	{Name: "ISO_Level3_Lock", Value: 0xfe05},
+	{Name: "ISO_Level5_Shift", Value: 0xfe11},
+	{Name: "ISO_Level5_Latch", Value: 0xfe12},
+	{Name: "ISO_Level5_Lock", Value: 0xfe13},
+	{Name: "ISO_Group_Shift", Value: 0xff7e},
+	{Name: "ISO_Group_Latch", Value: 0xfe06},
+	{Name: "ISO_Group_Lock", Value: 0xfe07},
+	{Name: "ISO_Next_Group", Value: 0xfe08},
+	{Name: "ISO_Next_Group_Lock", Value: 0xfe09},
+	{Name: "ISO_Prev_Group", Value: 0xfe0a},
+	{Name: "ISO_Prev_Group_Lock", Value: 0xfe0b},
+	{Name: "ISO_First_Group", Value: 0xfe0c},
+	{Name: "ISO_First_Group_Lock", Value: 0xfe0d},
+	{Name: "ISO_Last_Group", Value: 0xfe0e},
+	{Name: "ISO_Last_Group_Lock", Value: 0xfe0f},
+	{Name: "ISO_Left_Tab", Value: 0xfe20},
+	{Name: "ISO_Move_Line_Up", Value: 0xfe21},
+	{Name: "ISO_Move_Line_Down", Value: 0xfe22},
+	{Name: "ISO_Partial_Line_Up", Value: 0xfe23},
+	{Name: "ISO_Partial_Line_Down", Value: 0xfe24},
+	{Name: "ISO_Partial_Space_Left", Value: 0xfe25},
+	{Name: "ISO_Partial_Space_Right", Value: 0xfe26},
+	{Name: "ISO_Set_Margin_Left", Value: 0xfe27},
+	{Name: "ISO_Set_Margin_Right", Value: 0xfe28},
+	{Name: "ISO_Release_Margin_Left", Value: 0xfe29},
+	{Name: "ISO_Release_Margin_Right", Value: 0xfe2a},
+	{Name: "ISO_Release_Both_Margins", Value: 0xfe2b},
+	{Name: "ISO_Fast_Cursor_Left", Value: 0xfe2c},
+	{Name: "ISO_Fast_Cursor_Right", Value: 0xfe2d},
+	{Name: "ISO_Fast_Cursor_Up", Value: 0xfe2e},
+	{Name: "ISO_Fast_Cursor_Down", Value: 0xfe2f},
+	{Name: "ISO_Continuous_Underline", Value: 0xfe30},
+	{Name: "ISO_Discontinuous_Underline", Value: 0xfe31},
+	{Name: "ISO_Emphasize", Value: 0xfe32},
+	{Name: "ISO_Center_Object", Value: 0xfe33},
+	{Name: "ISO_Enter", Value: 0xfe34},
+	{Name: "dead_grave", Value: 0xfe50},
+	{Name: "dead_acute", Value: 0xfe51},
+	{Name: "dead_circumflex", Value: 0xfe52},
+	{Name: "dead_tilde", Value: 0xfe53},
+	{Name: "dead_perispomeni", Value: 0xfe53},
+	{Name: "dead_macron", Value: 0xfe54},
+	{Name: "dead_breve", Value: 0xfe55},
+	{Name: "dead_abovedot", Value: 0xfe56},
+	{Name: "dead_diaeresis", Value: 0xfe57},
+	{Name: "dead_abovering", Value: 0xfe58},
+	{Name: "dead_doubleacute", Value: 0xfe59},
+	{Name: "dead_caron", Value: 0xfe5a},
+	{Name: "dead_cedilla", Value: 0xfe5b},
+	{Name: "dead_ogonek", Value: 0xfe5c},
+	{Name: "dead_iota", Value: 0xfe5d},
+	{Name: "dead_voiced_sound", Value: 0xfe5e},
+	{Name: "dead_semivoiced_sound", Value: 0xfe5f},
+	{Name: "dead_belowdot", Value: 0xfe60},
+	{Name: "dead_hook", Value: 0xfe61},
+	{Name: "dead_horn", Value: 0xfe62},
+	{Name: "dead_stroke", Value: 0xfe63},
+	{Name: "dead_abovecomma", Value: 0xfe64},
+	{Name: "dead_psili", Value: 0xfe64},
+	{Name: "dead_abovereversedcomma", Value: 0xfe65},
+	{Name: "dead_dasia", Value: 0xfe65},
+	{Name: "dead_doublegrave", Value: 0xfe66},
+	{Name: "dead_belowring", Value: 0xfe67},
+	{Name: "dead_belowmacron", Value: 0xfe68},
+	{Name: "dead_belowcircumflex", Value: 0xfe69},
+	{Name: "dead_belowtilde", Value: 0xfe6a},
+	{Name: "dead_belowbreve", Value: 0xfe6b},
+	{Name: "dead_belowdiaeresis", Value: 0xfe6c},
+	{Name: "dead_invertedbreve", Value: 0xfe6d},
+	{Name: "dead_belowcomma", Value: 0xfe6e},
+	{Name: "dead_currency", Value: 0xfe6f},
+	{Name: "dead_lowline", Value: 0xfe90},
+	{Name: "dead_aboveverticalline", Value: 0xfe91},
+	{Name: "dead_belowverticalline", Value: 0xfe92},
+	{Name: "dead_longsolidusoverlay", Value: 0xfe93},
+	{Name: "dead_a", Value: 0xfe80},
+	{Name: "dead_A", Value: 0xfe81},
+	{Name: "dead_e", Value: 0xfe82},
+	{Name: "dead_E", Value: 0xfe83},
+	{Name: "dead_i", Value: 0xfe84},
+	{Name: "dead_I", Value: 0xfe85},
+	{Name: "dead_o", Value: 0xfe86},
+	{Name: "dead_O", Value: 0xfe87},
+	{Name: "dead_u", Value: 0xfe88},
+	{Name: "dead_U", Value: 0xfe89},
+	{Name: "dead_small_schwa", Value: 0xfe8a},
+	{Name: "dead_schwa", Value: 0xfe8a},
+	{Name: "dead_capital_schwa", Value: 0xfe8b},
+	{Name: "dead_SCHWA", Value: 0xfe8b},
+	{Name: "dead_greek", Value: 0xfe8c},
+	{Name: "dead_hamza", Value: 0xfe8d},
+	{Name: "First_Virtual_Screen", Value: 0xfed0},
+	{Name: "Prev_Virtual_Screen", Value: 0xfed1},
+	{Name: "Next_Virtual_Screen", Value: 0xfed2},
+	{Name: "Last_Virtual_Screen", Value: 0xfed4},
+	{Name: "Terminate_Server", Value: 0xfed5},
+	{Name: "AccessX_Enable", Value: 0xfe70},
+	{Name: "AccessX_Feedback_Enable", Value: 0xfe71},
+	{Name: "RepeatKeys_Enable", Value: 0xfe72},
+	{Name: "SlowKeys_Enable", Value: 0xfe73},
+	{Name: "BounceKeys_Enable", Value: 0xfe74},
+	{Name: "StickyKeys_Enable", Value: 0xfe75},
+	{Name: "MouseKeys_Enable", Value: 0xfe76},
+	{Name: "MouseKeys_Accel_Enable", Value: 0xfe77},
+	{Name: "Overlay1_Enable", Value: 0xfe78},
+	{Name: "Overlay2_Enable", Value: 0xfe79},
+	{Name: "AudibleBell_Enable", Value: 0xfe7a},
+	{Name: "Pointer_Left", Value: 0xfee0},
+	{Name: "Pointer_Right", Value: 0xfee1},
+	{Name: "Pointer_Up", Value: 0xfee2},
+	{Name: "Pointer_Down", Value: 0xfee3},
+	{Name: "Pointer_UpLeft", Value: 0xfee4},
+	{Name: "Pointer_UpRight", Value: 0xfee5},
+	{Name: "Pointer_DownLeft", Value: 0xfee6},
+	{Name: "Pointer_DownRight", Value: 0xfee7},
+	{Name: "Pointer_Button_Dflt", Value: 0xfee8},
+	{Name: "Pointer_Button1", Value: 0xfee9},
+	{Name: "Pointer_Button2", Value: 0xfeea},
+	{Name: "Pointer_Button3", Value: 0xfeeb},
+	{Name: "Pointer_Button4", Value: 0xfeec},
+	{Name: "Pointer_Button5", Value: 0xfeed},
+	{Name: "Pointer_DblClick_Dflt", Value: 0xfeee},
+	{Name: "Pointer_DblClick1", Value: 0xfeef},
+	{Name: "Pointer_DblClick2", Value: 0xfef0},
+	{Name: "Pointer_DblClick3", Value: 0xfef1},
+	{Name: "Pointer_DblClick4", Value: 0xfef2},
+	{Name: "Pointer_DblClick5", Value: 0xfef3},
+	{Name: "Pointer_Drag_Dflt", Value: 0xfef4},
+	{Name: "Pointer_Drag1", Value: 0xfef5},
+	{Name: "Pointer_Drag2", Value: 0xfef6},
+	{Name: "Pointer_Drag3", Value: 0xfef7},
+	{Name: "Pointer_Drag4", Value: 0xfef8},
+	{Name: "Pointer_Drag5", Value: 0xfefd},
+	{Name: "Pointer_EnableKeys", Value: 0xfef9},
+	{Name: "Pointer_Accelerate", Value: 0xfefa},
+	{Name: "Pointer_DfltBtnNext", Value: 0xfefb},
+	{Name: "Pointer_DfltBtnPrev", Value: 0xfefc},
+	{Name: "ch", Value: 0xfea0},
+	{Name: "Ch", Value: 0xfea1},
+	{Name: "CH", Value: 0xfea2},
+	{Name: "c_h", Value: 0xfea3},
+	{Name: "C_h", Value: 0xfea4},
+	{Name: "C_H", Value: 0xfea5},
+	{Name: "3270_Duplicate", Value: 0xfd01},
+	{Name: "3270_FieldMark", Value: 0xfd02},
+	{Name: "3270_Right2", Value: 0xfd03},
+	{Name: "3270_Left2", Value: 0xfd04},
+	{Name: "3270_BackTab", Value: 0xfd05},
+	{Name: "3270_EraseEOF", Value: 0xfd06},
+	{Name: "3270_EraseInput", Value: 0xfd07},
+	{Name: "3270_Reset", Value: 0xfd08},
+	{Name: "3270_Quit", Value: 0xfd09},
+	{Name: "3270_PA1", Value: 0xfd0a},
+	{Name: "3270_PA2", Value: 0xfd0b},
+	{Name: "3270_PA3", Value: 0xfd0c},
+	{Name: "3270_Test", Value: 0xfd0d},
+	{Name: "3270_Attn", Value: 0xfd0e},
+	{Name: "3270_CursorBlink", Value: 0xfd0f},
+	{Name: "3270_AltCursor", Value: 0xfd10},
+	{Name: "3270_KeyClick", Value: 0xfd11},
+	{Name: "3270_Jump", Value: 0xfd12},
+	{Name: "3270_Ident", Value: 0xfd13},
+	{Name: "3270_Rule", Value: 0xfd14},
+	{Name: "3270_Copy", Value: 0xfd15},
+	{Name: "3270_Play", Value: 0xfd16},
+	{Name: "3270_Setup", Value: 0xfd17},
+	{Name: "3270_Record", Value: 0xfd18},
+	{Name: "3270_ChangeScreen", Value: 0xfd19},
+	{Name: "3270_DeleteWord", Value: 0xfd1a},
+	{Name: "3270_ExSelect", Value: 0xfd1b},
+	{Name: "3270_CursorSelect", Value: 0xfd1c},
+	{Name: "3270_PrintScreen", Value: 0xfd1d},
+	{Name: "3270_Enter", Value: 0xfd1e},
+	{Name: "space", Value: 0x20},
+	{Name: "exclam", Value: 0x21},
+	{Name: "quotedbl", Value: 0x22},
+	{Name: "numbersign", Value: 0x23},
+	{Name: "dollar", Value: 0x24},
+	{Name: "percent", Value: 0x25},
+	{Name: "ampersand", Value: 0x26},
+	{Name: "apostrophe", Value: 0x27},
+	{Name: "quoteright", Value: 0x27},
+	{Name: "parenleft", Value: 0x28},
+	{Name: "parenright", Value: 0x29},
+	{Name: "asterisk", Value: 0x2a},
+	{Name: "plus", Value: 0x2b},
+	{Name: "comma", Value: 0x2c},
+	{Name: "minus", Value: 0x2d},
+	{Name: "period", Value: 0x2e},
+	{Name: "slash", Value: 0x2f},
+	{Name: "0", Value: 0x30},
+	{Name: "1", Value: 0x31},
+	{Name: "2", Value: 0x32},
+	{Name: "3", Value: 0x33},
+	{Name: "4", Value: 0x34},
+	{Name: "5", Value: 0x35},
+	{Name: "6", Value: 0x36},
+	{Name: "7", Value: 0x37},
+	{Name: "8", Value: 0x38},
+	{Name: "9", Value: 0x39},
+	{Name: "colon", Value: 0x3a},
+	{Name: "semicolon", Value: 0x3b},
+	{Name: "less", Value: 0x3c},
+	{Name: "equal", Value: 0x3d},
+	{Name: "greater", Value: 0x3e},
+	{Name: "question", Value: 0x3f},
+	{Name: "at", Value: 0x40},
+	{Name: "A", Value: 0x41},
+	{Name: "B", Value: 0x42},
+	{Name: "C", Value: 0x43},
+	{Name: "D", Value: 0x44},
+	{Name: "E", Value: 0x45},
+	{Name: "F", Value: 0x46},
+	{Name: "G", Value: 0x47},
+	{Name: "H", Value: 0x48},
+	{Name: "I", Value: 0x49},
+	{Name: "J", Value: 0x4a},
+	{Name: "K", Value: 0x4b},
+	{Name: "L", Value: 0x4c},
+	{Name: "M", Value: 0x4d},
+	{Name: "N", Value: 0x4e},
+	{Name: "O", Value: 0x4f},
+	{Name: "P", Value: 0x50},
+	{Name: "Q", Value: 0x51},
+	{Name: "R", Value: 0x52},
+	{Name: "S", Value: 0x53},
+	{Name: "T", Value: 0x54},
+	{Name: "U", Value: 0x55},
+	{Name: "V", Value: 0x56},
+	{Name: "W", Value: 0x57},
+	{Name: "X", Value: 0x58},
+	{Name: "Y", Value: 0x59},
+	{Name: "Z", Value: 0x5a},
+	{Name: "bracketleft", Value: 0x5b},
+	{Name: "backslash", Value: 0x5c},
+	{Name: "bracketright", Value: 0x5d},
+	{Name: "asciicircum", Value: 0x5e},
+	{Name: "underscore", Value: 0x5f},
+	{Name: "grave", Value: 0x60},
+	{Name: "quoteleft", Value: 0x60},
+	{Name: "a", Value: 0x61},
+	{Name: "b", Value: 0x62},
+	{Name: "c", Value: 0x63},
+	{Name: "d", Value: 0x64},
+	{Name: "e", Value: 0x65},
+	{Name: "f", Value: 0x66},
+	{Name: "g", Value: 0x67},
+	{Name: "h", Value: 0x68},
+	{Name: "i", Value: 0x69},
+	{Name: "j", Value: 0x6a},
+	{Name: "k", Value: 0x6b},
+	{Name: "l", Value: 0x6c},
+	{Name: "m", Value: 0x6d},
+	{Name: "n", Value: 0x6e},
+	{Name: "o", Value: 0x6f},
+	{Name: "p", Value: 0x70},
+	{Name: "q", Value: 0x71},
+	{Name: "r", Value: 0x72},
+	{Name: "s", Value: 0x73},
+	{Name: "t", Value: 0x74},
+	{Name: "u", Value: 0x75},
+	{Name: "v", Value: 0x76},
+	{Name: "w", Value: 0x77},
+	{Name: "x", Value: 0x78},
+	{Name: "y", Value: 0x79},
+	{Name: "z", Value: 0x7a},
+	{Name: "braceleft", Value: 0x7b},
+	{Name: "bar", Value: 0x7c},
+	{Name: "braceright", Value: 0x7d},
+	{Name: "asciitilde", Value: 0x7e},
+	{Name: "nobreakspace", Value: 0xa0},
+	{Name: "exclamdown", Value: 0xa1},
+	{Name: "cent", Value: 0xa2},
+	{Name: "sterling", Value: 0xa3},
+	{Name: "currency", Value: 0xa4},
+	{Name: "yen", Value: 0xa5},
+	{Name: "brokenbar", Value: 0xa6},
+	{Name: "section", Value: 0xa7},
+	{Name: "diaeresis", Value: 0xa8},
+	{Name: "copyright", Value: 0xa9},
+	{Name: "ordfeminine", Value: 0xaa},
+	{Name: "guillemotleft", Value: 0xab},
+	{Name: "guillemetleft", Value: 0xab},
+	{Name: "notsign", Value: 0xac},
+	{Name: "hyphen", Value: 0xad},
+	{Name: "registered", Value: 0xae},
+	{Name: "macron", Value: 0xaf},
+	{Name: "degree", Value: 0xb0},
+	{Name: "plusminus", Value: 0xb1},
+	{Name: "twosuperior", Value: 0xb2},
+	{Name: "threesuperior", Value: 0xb3},
+	{Name: "acute", Value: 0xb4},
+	{Name: "mu", Value: 0xb5},
+	{Name: "paragraph", Value: 0xb6},
+	{Name: "periodcentered", Value: 0xb7},
+	{Name: "cedilla", Value: 0xb8},
+	{Name: "onesuperior", Value: 0xb9},
+	{Name: "masculine", Value: 0xba},
+	{Name: "ordmasculine", Value: 0xba},
+	{Name: "guillemotright", Value: 0xbb},
+	{Name: "guillemetright", Value: 0xbb},
+	{Name: "onequarter", Value: 0xbc},
+	{Name: "onehalf", Value: 0xbd},
+	{Name: "threequarters", Value: 0xbe},
+	{Name: "questiondown", Value: 0xbf},
+	{Name: "Agrave", Value: 0xc0},
+	{Name: "Aacute", Value: 0xc1},
+	{Name: "Acircumflex", Value: 0xc2},
+	{Name: "Atilde", Value: 0xc3},
+	{Name: "Adiaeresis", Value: 0xc4},
+	{Name: "Aring", Value: 0xc5},
+	{Name: "AE", Value: 0xc6},
+	{Name: "Ccedilla", Value: 0xc7},
+	{Name: "Egrave", Value: 0xc8},
+	{Name: "Eacute", Value: 0xc9},
+	{Name: "Ecircumflex", Value: 0xca},
+	{Name: "Ediaeresis", Value: 0xcb},
+	{Name: "Igrave", Value: 0xcc},
+	{Name: "Iacute", Value: 0xcd},
+	{Name: "Icircumflex", Value: 0xce},
+	{Name: "Idiaeresis", Value: 0xcf},
+	{Name: "ETH", Value: 0xd0},
+	{Name: "Eth", Value: 0xd0},
+	{Name: "Ntilde", Value: 0xd1},
+	{Name: "Ograve", Value: 0xd2},
+	{Name: "Oacute", Value: 0xd3},
+	{Name: "Ocircumflex", Value: 0xd4},
+	{Name: "Otilde", Value: 0xd5},
+	{Name: "Odiaeresis", Value: 0xd6},
+	{Name: "multiply", Value: 0xd7},
+	{Name: "Oslash", Value: 0xd8},
+	{Name: "Ooblique", Value: 0xd8},
+	{Name: "Ugrave", Value: 0xd9},
+	{Name: "Uacute", Value: 0xda},
+	{Name: "Ucircumflex", Value: 0xdb},
+	{Name: "Udiaeresis", Value: 0xdc},
+	{Name: "Yacute", Value: 0xdd},
+	{Name: "THORN", Value: 0xde},
+	{Name: "Thorn", Value: 0xde},
+	{Name: "ssharp", Value: 0xdf},
+	{Name: "agrave", Value: 0xe0},
+	{Name: "aacute", Value: 0xe1},
+	{Name: "acircumflex", Value: 0xe2},
+	{Name: "atilde", Value: 0xe3},
+	{Name: "adiaeresis", Value: 0xe4},
+	{Name: "aring", Value: 0xe5},
+	{Name: "ae", Value: 0xe6},
+	{Name: "ccedilla", Value: 0xe7},
+	{Name: "egrave", Value: 0xe8},
+	{Name: "eacute", Value: 0xe9},
+	{Name: "ecircumflex", Value: 0xea},
+	{Name: "ediaeresis", Value: 0xeb},
+	{Name: "igrave", Value: 0xec},
+	{Name: "iacute", Value: 0xed},
+	{Name: "icircumflex", Value: 0xee},
+	{Name: "idiaeresis", Value: 0xef},
+	{Name: "eth", Value: 0xf0},
+	{Name: "ntilde", Value: 0xf1},
+	{Name: "ograve", Value: 0xf2},
+	{Name: "oacute", Value: 0xf3},
+	{Name: "ocircumflex", Value: 0xf4},
+	{Name: "otilde", Value: 0xf5},
+	{Name: "odiaeresis", Value: 0xf6},
+	{Name: "division", Value: 0xf7},
+	{Name: "oslash", Value: 0xf8},
+	{Name: "ooblique", Value: 0xf8},
+	{Name: "ugrave", Value: 0xf9},
+	{Name: "uacute", Value: 0xfa},
+	{Name: "ucircumflex", Value: 0xfb},
+	{Name: "udiaeresis", Value: 0xfc},
+	{Name: "yacute", Value: 0xfd},
+	{Name: "thorn", Value: 0xfe},
+	{Name: "ydiaeresis", Value: 0xff},
+	{Name: "Aogonek", Value: 0x1a1},
+	{Name: "breve", Value: 0x1a2},
+	{Name: "Lstroke", Value: 0x1a3},
+	{Name: "Lcaron", Value: 0x1a5},
+	{Name: "Sacute", Value: 0x1a6},
+	{Name: "Scaron", Value: 0x1a9},
+	{Name: "Scedilla", Value: 0x1aa},
+	{Name: "Tcaron", Value: 0x1ab},
+	{Name: "Zacute", Value: 0x1ac},
+	{Name: "Zcaron", Value: 0x1ae},
+	{Name: "Zabovedot", Value: 0x1af},
+	{Name: "aogonek", Value: 0x1b1},
+	{Name: "ogonek", Value: 0x1b2},
+	{Name: "lstroke", Value: 0x1b3},
+	{Name: "lcaron", Value: 0x1b5},
+	{Name: "sacute", Value: 0x1b6},
+	{Name: "caron", Value: 0x1b7},
+	{Name: "scaron", Value: 0x1b9},
+	{Name: "scedilla", Value: 0x1ba},
+	{Name: "tcaron", Value: 0x1bb},
+	{Name: "zacute", Value: 0x1bc},
+	{Name: "doubleacute", Value: 0x1bd},
+	{Name: "zcaron", Value: 0x1be},
+	{Name: "zabovedot", Value: 0x1bf},
+	{Name: "Racute", Value: 0x1c0},
+	{Name: "Abreve", Value: 0x1c3},
+	{Name: "Lacute", Value: 0x1c5},
+	{Name: "Cacute", Value: 0x1c6},
+	{Name: "Ccaron", Value: 0x1c8},
+	{Name: "Eogonek", Value: 0x1ca},
+	{Name: "Ecaron", Value: 0x1cc},
+	{Name: "Dcaron", Value: 0x1cf},
+	{Name: "Dstroke", Value: 0x1d0},
+	{Name: "Nacute", Value: 0x1d1},
+	{Name: "Ncaron", Value: 0x1d2},
+	{Name: "Odoubleacute", Value: 0x1d5},
+	{Name: "Rcaron", Value: 0x1d8},
+	{Name: "Uring", Value: 0x1d9},
+	{Name: "Udoubleacute", Value: 0x1db},
+	{Name: "Tcedilla", Value: 0x1de},
+	{Name: "racute", Value: 0x1e0},
+	{Name: "abreve", Value: 0x1e3},
+	{Name: "lacute", Value: 0x1e5},
+	{Name: "cacute", Value: 0x1e6},
+	{Name: "ccaron", Value: 0x1e8},
+	{Name: "eogonek", Value: 0x1ea},
+	{Name: "ecaron", Value: 0x1ec},
+	{Name: "dcaron", Value: 0x1ef},
+	{Name: "dstroke", Value: 0x1f0},
+	{Name: "nacute", Value: 0x1f1},
+	{Name: "ncaron", Value: 0x1f2},
+	{Name: "odoubleacute", Value: 0x1f5},
+	{Name: "rcaron", Value: 0x1f8},
+	{Name: "uring", Value: 0x1f9},
+	{Name: "udoubleacute", Value: 0x1fb},
+	{Name: "tcedilla", Value: 0x1fe},
+	{Name: "abovedot", Value: 0x1ff},
+	{Name: "Hstroke", Value: 0x2a1},
+	{Name: "Hcircumflex", Value: 0x2a6},
+	{Name: "Iabovedot", Value: 0x2a9},
+	{Name: "Gbreve", Value: 0x2ab},
+	{Name: "Jcircumflex", Value: 0x2ac},
+	{Name: "hstroke", Value: 0x2b1},
+	{Name: "hcircumflex", Value: 0x2b6},
+	{Name: "idotless", Value: 0x2b9},
+	{Name: "gbreve", Value: 0x2bb},
+	{Name: "jcircumflex", Value: 0x2bc},
+	{Name: "Cabovedot", Value: 0x2c5},
+	{Name: "Ccircumflex", Value: 0x2c6},
+	{Name: "Gabovedot", Value: 0x2d5},
+	{Name: "Gcircumflex", Value: 0x2d8},
+	{Name: "Ubreve", Value: 0x2dd},
+	{Name: "Scircumflex", Value: 0x2de},
+	{Name: "cabovedot", Value: 0x2e5},
+	{Name: "ccircumflex", Value: 0x2e6},
+	{Name: "gabovedot", Value: 0x2f5},
+	{Name: "gcircumflex", Value: 0x2f8},
+	{Name: "ubreve", Value: 0x2fd},
+	{Name: "scircumflex", Value: 0x2fe},
+	{Name: "kra", Value: 0x3a2},
+	{Name: "kappa", Value: 0x3a2},
+	{Name: "Rcedilla", Value: 0x3a3},
+	{Name: "Itilde", Value: 0x3a5},
+	{Name: "Lcedilla", Value: 0x3a6},
+	{Name: "Emacron", Value: 0x3aa},
+	{Name: "Gcedilla", Value: 0x3ab},
+	{Name: "Tslash", Value: 0x3ac},
+	{Name: "rcedilla", Value: 0x3b3},
+	{Name: "itilde", Value: 0x3b5},
+	{Name: "lcedilla", Value: 0x3b6},
+	{Name: "emacron", Value: 0x3ba},
+	{Name: "gcedilla", Value: 0x3bb},
+	{Name: "tslash", Value: 0x3bc},
+	{Name: "ENG", Value: 0x3bd},
+	{Name: "eng", Value: 0x3bf},
+	{Name: "Amacron", Value: 0x3c0},
+	{Name: "Iogonek", Value: 0x3c7},
+	{Name: "Eabovedot", Value: 0x3cc},
+	{Name: "Imacron", Value: 0x3cf},
+	{Name: "Ncedilla", Value: 0x3d1},
+	{Name: "Omacron", Value: 0x3d2},
+	{Name: "Kcedilla", Value: 0x3d3},
+	{Name: "Uogonek", Value: 0x3d9},
+	{Name: "Utilde", Value: 0x3dd},
+	{Name: "Umacron", Value: 0x3de},
+	{Name: "amacron", Value: 0x3e0},
+	{Name: "iogonek", Value: 0x3e7},
+	{Name: "eabovedot", Value: 0x3ec},
+	{Name: "imacron", Value: 0x3ef},
+	{Name: "ncedilla", Value: 0x3f1},
+	{Name: "omacron", Value: 0x3f2},
+	{Name: "kcedilla", Value: 0x3f3},
+	{Name: "uogonek", Value: 0x3f9},
+	{Name: "utilde", Value: 0x3fd},
+	{Name: "umacron", Value: 0x3fe},
+	{Name: "Wcircumflex", Value: 0x1000174},
+	{Name: "wcircumflex", Value: 0x1000175},
+	{Name: "Ycircumflex", Value: 0x1000176},
+	{Name: "ycircumflex", Value: 0x1000177},
+	{Name: "Babovedot", Value: 0x1001e02},
+	{Name: "babovedot", Value: 0x1001e03},
+	{Name: "Dabovedot", Value: 0x1001e0a},
+	{Name: "dabovedot", Value: 0x1001e0b},
+	{Name: "Fabovedot", Value: 0x1001e1e},
+	{Name: "fabovedot", Value: 0x1001e1f},
+	{Name: "Mabovedot", Value: 0x1001e40},
+	{Name: "mabovedot", Value: 0x1001e41},
+	{Name: "Pabovedot", Value: 0x1001e56},
+	{Name: "pabovedot", Value: 0x1001e57},
+	{Name: "Sabovedot", Value: 0x1001e60},
+	{Name: "sabovedot", Value: 0x1001e61},
+	{Name: "Tabovedot", Value: 0x1001e6a},
+	{Name: "tabovedot", Value: 0x1001e6b},
+	{Name: "Wgrave", Value: 0x1001e80},
+	{Name: "wgrave", Value: 0x1001e81},
+	{Name: "Wacute", Value: 0x1001e82},
+	{Name: "wacute", Value: 0x1001e83},
+	{Name: "Wdiaeresis", Value: 0x1001e84},
+	{Name: "wdiaeresis", Value: 0x1001e85},
+	{Name: "Ygrave", Value: 0x1001ef2},
+	{Name: "ygrave", Value: 0x1001ef3},
+	{Name: "OE", Value: 0x13bc},
+	{Name: "oe", Value: 0x13bd},
+	{Name: "Ydiaeresis", Value: 0x13be},
+	{Name: "overline", Value: 0x47e},
+	{Name: "kana_fullstop", Value: 0x4a1},
+	{Name: "kana_openingbracket", Value: 0x4a2},
+	{Name: "kana_closingbracket", Value: 0x4a3},
+	{Name: "kana_comma", Value: 0x4a4},
+	{Name: "kana_conjunctive", Value: 0x4a5},
+	{Name: "kana_middledot", Value: 0x4a5},
+	{Name: "kana_WO", Value: 0x4a6},
+	{Name: "kana_a", Value: 0x4a7},
+	{Name: "kana_i", Value: 0x4a8},
+	{Name: "kana_u", Value: 0x4a9},
+	{Name: "kana_e", Value: 0x4aa},
+	{Name: "kana_o", Value: 0x4ab},
+	{Name: "kana_ya", Value: 0x4ac},
+	{Name: "kana_yu", Value: 0x4ad},
+	{Name: "kana_yo", Value: 0x4ae},
+	{Name: "kana_tsu", Value: 0x4af},
+	{Name: "kana_tu", Value: 0x4af},
+	{Name: "prolongedsound", Value: 0x4b0},
+	{Name: "kana_A", Value: 0x4b1},
+	{Name: "kana_I", Value: 0x4b2},
+	{Name: "kana_U", Value: 0x4b3},
+	{Name: "kana_E", Value: 0x4b4},
+	{Name: "kana_O", Value: 0x4b5},
+	{Name: "kana_KA", Value: 0x4b6},
+	{Name: "kana_KI", Value: 0x4b7},
+	{Name: "kana_KU", Value: 0x4b8},
+	{Name: "kana_KE", Value: 0x4b9},
+	{Name: "kana_KO", Value: 0x4ba},
+	{Name: "kana_SA", Value: 0x4bb},
+	{Name: "kana_SHI", Value: 0x4bc},
+	{Name: "kana_SU", Value: 0x4bd},
+	{Name: "kana_SE", Value: 0x4be},
+	{Name: "kana_SO", Value: 0x4bf},
+	{Name: "kana_TA", Value: 0x4c0},
+	{Name: "kana_CHI", Value: 0x4c1},
+	{Name: "kana_TI", Value: 0x4c1},
+	{Name: "kana_TSU", Value: 0x4c2},
+	{Name: "kana_TU", Value: 0x4c2},
+	{Name: "kana_TE", Value: 0x4c3},
+	{Name: "kana_TO", Value: 0x4c4},
+	{Name: "kana_NA", Value: 0x4c5},
+	{Name: "kana_NI", Value: 0x4c6},
+	{Name: "kana_NU", Value: 0x4c7},
+	{Name: "kana_NE", Value: 0x4c8},
+	{Name: "kana_NO", Value: 0x4c9},
+	{Name: "kana_HA", Value: 0x4ca},
+	{Name: "kana_HI", Value: 0x4cb},
+	{Name: "kana_FU", Value: 0x4cc},
+	{Name: "kana_HU", Value: 0x4cc},
+	{Name: "kana_HE", Value: 0x4cd},
+	{Name: "kana_HO", Value: 0x4ce},
+	{Name: "kana_MA", Value: 0x4cf},
+	{Name: "kana_MI", Value: 0x4d0},
+	{Name: "kana_MU", Value: 0x4d1},
+	{Name: "kana_ME", Value: 0x4d2},
+	{Name: "kana_MO", Value: 0x4d3},
+	{Name: "kana_YA", Value: 0x4d4},
+	{Name: "kana_YU", Value: 0x4d5},
+	{Name: "kana_YO", Value: 0x4d6},
+	{Name: "kana_RA", Value: 0x4d7},
+	{Name: "kana_RI", Value: 0x4d8},
+	{Name: "kana_RU", Value: 0x4d9},
+	{Name: "kana_RE", Value: 0x4da},
+	{Name: "kana_RO", Value: 0x4db},
+	{Name: "kana_WA", Value: 0x4dc},
+	{Name: "kana_N", Value: 0x4dd},
+	{Name: "voicedsound", Value: 0x4de},
+	{Name: "semivoicedsound", Value: 0x4df},
+	{Name: "kana_switch", Value: 0xff7e},
+	{Name: "Farsi_0", Value: 0x10006f0},
+	{Name: "Farsi_1", Value: 0x10006f1},
+	{Name: "Farsi_2", Value: 0x10006f2},
+	{Name: "Farsi_3", Value: 0x10006f3},
+	{Name: "Farsi_4", Value: 0x10006f4},
+	{Name: "Farsi_5", Value: 0x10006f5},
+	{Name: "Farsi_6", Value: 0x10006f6},
+	{Name: "Farsi_7", Value: 0x10006f7},
+	{Name: "Farsi_8", Value: 0x10006f8},
+	{Name: "Farsi_9", Value: 0x10006f9},
+	{Name: "Arabic_percent", Value: 0x100066a},
+	{Name: "Arabic_superscript_alef", Value: 0x1000670},
+	{Name: "Arabic_tteh", Value: 0x1000679},
+	{Name: "Arabic_peh", Value: 0x100067e},
+	{Name: "Arabic_tcheh", Value: 0x1000686},
+	{Name: "Arabic_ddal", Value: 0x1000688},
+	{Name: "Arabic_rreh", Value: 0x1000691},
+	{Name: "Arabic_comma", Value: 0x5ac},
+	{Name: "Arabic_fullstop", Value: 0x10006d4},
+	{Name: "Arabic_0", Value: 0x1000660},
+	{Name: "Arabic_1", Value: 0x1000661},
+	{Name: "Arabic_2", Value: 0x1000662},
+	{Name: "Arabic_3", Value: 0x1000663},
+	{Name: "Arabic_4", Value: 0x1000664},
+	{Name: "Arabic_5", Value: 0x1000665},
+	{Name: "Arabic_6", Value: 0x1000666},
+	{Name: "Arabic_7", Value: 0x1000667},
+	{Name: "Arabic_8", Value: 0x1000668},
+	{Name: "Arabic_9", Value: 0x1000669},
+	{Name: "Arabic_semicolon", Value: 0x5bb},
+	{Name: "Arabic_question_mark", Value: 0x5bf},
+	{Name: "Arabic_hamza", Value: 0x5c1},
+	{Name: "Arabic_maddaonalef", Value: 0x5c2},
+	{Name: "Arabic_hamzaonalef", Value: 0x5c3},
+	{Name: "Arabic_hamzaonwaw", Value: 0x5c4},
+	{Name: "Arabic_hamzaunderalef", Value: 0x5c5},
+	{Name: "Arabic_hamzaonyeh", Value: 0x5c6},
+	{Name: "Arabic_alef", Value: 0x5c7},
+	{Name: "Arabic_beh", Value: 0x5c8},
+	{Name: "Arabic_tehmarbuta", Value: 0x5c9},
+	{Name: "Arabic_teh", Value: 0x5ca},
+	{Name: "Arabic_theh", Value: 0x5cb},
+	{Name: "Arabic_jeem", Value: 0x5cc},
+	{Name: "Arabic_hah", Value: 0x5cd},
+	{Name: "Arabic_khah", Value: 0x5ce},
+	{Name: "Arabic_dal", Value: 0x5cf},
+	{Name: "Arabic_thal", Value: 0x5d0},
+	{Name: "Arabic_ra", Value: 0x5d1},
+	{Name: "Arabic_zain", Value: 0x5d2},
+	{Name: "Arabic_seen", Value: 0x5d3},
+	{Name: "Arabic_sheen", Value: 0x5d4},
+	{Name: "Arabic_sad", Value: 0x5d5},
+	{Name: "Arabic_dad", Value: 0x5d6},
+	{Name: "Arabic_tah", Value: 0x5d7},
+	{Name: "Arabic_zah", Value: 0x5d8},
+	{Name: "Arabic_ain", Value: 0x5d9},
+	{Name: "Arabic_ghain", Value: 0x5da},
+	{Name: "Arabic_tatweel", Value: 0x5e0},
+	{Name: "Arabic_feh", Value: 0x5e1},
+	{Name: "Arabic_qaf", Value: 0x5e2},
+	{Name: "Arabic_kaf", Value: 0x5e3},
+	{Name: "Arabic_lam", Value: 0x5e4},
+	{Name: "Arabic_meem", Value: 0x5e5},
+	{Name: "Arabic_noon", Value: 0x5e6},
+	{Name: "Arabic_ha", Value: 0x5e7},
+	{Name: "Arabic_heh", Value: 0x5e7},
+	{Name: "Arabic_waw", Value: 0x5e8},
+	{Name: "Arabic_alefmaksura", Value: 0x5e9},
+	{Name: "Arabic_yeh", Value: 0x5ea},
+	{Name: "Arabic_fathatan", Value: 0x5eb},
+	{Name: "Arabic_dammatan", Value: 0x5ec},
+	{Name: "Arabic_kasratan", Value: 0x5ed},
+	{Name: "Arabic_fatha", Value: 0x5ee},
+	{Name: "Arabic_damma", Value: 0x5ef},
+	{Name: "Arabic_kasra", Value: 0x5f0},
+	{Name: "Arabic_shadda", Value: 0x5f1},
+	{Name: "Arabic_sukun", Value: 0x5f2},
+	{Name: "Arabic_madda_above", Value: 0x1000653},
+	{Name: "Arabic_hamza_above", Value: 0x1000654},
+	{Name: "Arabic_hamza_below", Value: 0x1000655},
+	{Name: "Arabic_jeh", Value: 0x1000698},
+	{Name: "Arabic_veh", Value: 0x10006a4},
+	{Name: "Arabic_keheh", Value: 0x10006a9},
+	{Name: "Arabic_gaf", Value: 0x10006af},
+	{Name: "Arabic_noon_ghunna", Value: 0x10006ba},
+	{Name: "Arabic_heh_doachashmee", Value: 0x10006be},
+	{Name: "Farsi_yeh", Value: 0x10006cc},
+	{Name: "Arabic_farsi_yeh", Value: 0x10006cc},
+	{Name: "Arabic_yeh_baree", Value: 0x10006d2},
+	{Name: "Arabic_heh_goal", Value: 0x10006c1},
+	{Name: "Arabic_switch", Value: 0xff7e},
+	{Name: "Cyrillic_GHE_bar", Value: 0x1000492},
+	{Name: "Cyrillic_ghe_bar", Value: 0x1000493},
+	{Name: "Cyrillic_ZHE_descender", Value: 0x1000496},
+	{Name: "Cyrillic_zhe_descender", Value: 0x1000497},
+	{Name: "Cyrillic_KA_descender", Value: 0x100049a},
+	{Name: "Cyrillic_ka_descender", Value: 0x100049b},
+	{Name: "Cyrillic_KA_vertstroke", Value: 0x100049c},
+	{Name: "Cyrillic_ka_vertstroke", Value: 0x100049d},
+	{Name: "Cyrillic_EN_descender", Value: 0x10004a2},
+	{Name: "Cyrillic_en_descender", Value: 0x10004a3},
+	{Name: "Cyrillic_U_straight", Value: 0x10004ae},
+	{Name: "Cyrillic_u_straight", Value: 0x10004af},
+	{Name: "Cyrillic_U_straight_bar", Value: 0x10004b0},
+	{Name: "Cyrillic_u_straight_bar", Value: 0x10004b1},
+	{Name: "Cyrillic_HA_descender", Value: 0x10004b2},
+	{Name: "Cyrillic_ha_descender", Value: 0x10004b3},
+	{Name: "Cyrillic_CHE_descender", Value: 0x10004b6},
+	{Name: "Cyrillic_che_descender", Value: 0x10004b7},
+	{Name: "Cyrillic_CHE_vertstroke", Value: 0x10004b8},
+	{Name: "Cyrillic_che_vertstroke", Value: 0x10004b9},
+	{Name: "Cyrillic_SHHA", Value: 0x10004ba},
+	{Name: "Cyrillic_shha", Value: 0x10004bb},
+	{Name: "Cyrillic_SCHWA", Value: 0x10004d8},
+	{Name: "Cyrillic_schwa", Value: 0x10004d9},
+	{Name: "Cyrillic_I_macron", Value: 0x10004e2},
+	{Name: "Cyrillic_i_macron", Value: 0x10004e3},
+	{Name: "Cyrillic_O_bar", Value: 0x10004e8},
+	{Name: "Cyrillic_o_bar", Value: 0x10004e9},
+	{Name: "Cyrillic_U_macron", Value: 0x10004ee},
+	{Name: "Cyrillic_u_macron", Value: 0x10004ef},
+	{Name: "Serbian_dje", Value: 0x6a1},
+	{Name: "Macedonia_gje", Value: 0x6a2},
+	{Name: "Cyrillic_io", Value: 0x6a3},
+	{Name: "Ukrainian_ie", Value: 0x6a4},
+	{Name: "Ukranian_je", Value: 0x6a4},
+	{Name: "Macedonia_dse", Value: 0x6a5},
+	{Name: "Ukrainian_i", Value: 0x6a6},
+	{Name: "Ukranian_i", Value: 0x6a6},
+	{Name: "Ukrainian_yi", Value: 0x6a7},
+	{Name: "Ukranian_yi", Value: 0x6a7},
+	{Name: "Cyrillic_je", Value: 0x6a8},
+	{Name: "Serbian_je", Value: 0x6a8},
+	{Name: "Cyrillic_lje", Value: 0x6a9},
+	{Name: "Serbian_lje", Value: 0x6a9},
+	{Name: "Cyrillic_nje", Value: 0x6aa},
+	{Name: "Serbian_nje", Value: 0x6aa},
+	{Name: "Serbian_tshe", Value: 0x6ab},
+	{Name: "Macedonia_kje", Value: 0x6ac},
+	{Name: "Ukrainian_ghe_with_upturn", Value: 0x6ad},
+	{Name: "Byelorussian_shortu", Value: 0x6ae},
+	{Name: "Cyrillic_dzhe", Value: 0x6af},
+	{Name: "Serbian_dze", Value: 0x6af},
+	{Name: "numerosign", Value: 0x6b0},
+	{Name: "Serbian_DJE", Value: 0x6b1},
+	{Name: "Macedonia_GJE", Value: 0x6b2},
+	{Name: "Cyrillic_IO", Value: 0x6b3},
+	{Name: "Ukrainian_IE", Value: 0x6b4},
+	{Name: "Ukranian_JE", Value: 0x6b4},
+	{Name: "Macedonia_DSE", Value: 0x6b5},
+	{Name: "Ukrainian_I", Value: 0x6b6},
+	{Name: "Ukranian_I", Value: 0x6b6},
+	{Name: "Ukrainian_YI", Value: 0x6b7},
+	{Name: "Ukranian_YI", Value: 0x6b7},
+	{Name: "Cyrillic_JE", Value: 0x6b8},
+	{Name: "Serbian_JE", Value: 0x6b8},
+	{Name: "Cyrillic_LJE", Value: 0x6b9},
+	{Name: "Serbian_LJE", Value: 0x6b9},
+	{Name: "Cyrillic_NJE", Value: 0x6ba},
+	{Name: "Serbian_NJE", Value: 0x6ba},
+	{Name: "Serbian_TSHE", Value: 0x6bb},
+	{Name: "Macedonia_KJE", Value: 0x6bc},
+	{Name: "Ukrainian_GHE_WITH_UPTURN", Value: 0x6bd},
+	{Name: "Byelorussian_SHORTU", Value: 0x6be},
+	{Name: "Cyrillic_DZHE", Value: 0x6bf},
+	{Name: "Serbian_DZE", Value: 0x6bf},
+	{Name: "Cyrillic_yu", Value: 0x6c0},
+	{Name: "Cyrillic_a", Value: 0x6c1},
+	{Name: "Cyrillic_be", Value: 0x6c2},
+	{Name: "Cyrillic_tse", Value: 0x6c3},
+	{Name: "Cyrillic_de", Value: 0x6c4},
+	{Name: "Cyrillic_ie", Value: 0x6c5},
+	{Name: "Cyrillic_ef", Value: 0x6c6},
+	{Name: "Cyrillic_ghe", Value: 0x6c7},
+	{Name: "Cyrillic_ha", Value: 0x6c8},
+	{Name: "Cyrillic_i", Value: 0x6c9},
+	{Name: "Cyrillic_shorti", Value: 0x6ca},
+	{Name: "Cyrillic_ka", Value: 0x6cb},
+	{Name: "Cyrillic_el", Value: 0x6cc},
+	{Name: "Cyrillic_em", Value: 0x6cd},
+	{Name: "Cyrillic_en", Value: 0x6ce},
+	{Name: "Cyrillic_o", Value: 0x6cf},
+	{Name: "Cyrillic_pe", Value: 0x6d0},
+	{Name: "Cyrillic_ya", Value: 0x6d1},
+	{Name: "Cyrillic_er", Value: 0x6d2},
+	{Name: "Cyrillic_es", Value: 0x6d3},
+	{Name: "Cyrillic_te", Value: 0x6d4},
+	{Name: "Cyrillic_u", Value: 0x6d5},
+	{Name: "Cyrillic_zhe", Value: 0x6d6},
+	{Name: "Cyrillic_ve", Value: 0x6d7},
+	{Name: "Cyrillic_softsign", Value: 0x6d8},
+	{Name: "Cyrillic_yeru", Value: 0x6d9},
+	{Name: "Cyrillic_ze", Value: 0x6da},
+	{Name: "Cyrillic_sha", Value: 0x6db},
+	{Name: "Cyrillic_e", Value: 0x6dc},
+	{Name: "Cyrillic_shcha", Value: 0x6dd},
+	{Name: "Cyrillic_che", Value: 0x6de},
+	{Name: "Cyrillic_hardsign", Value: 0x6df},
+	{Name: "Cyrillic_YU", Value: 0x6e0},
+	{Name: "Cyrillic_A", Value: 0x6e1},
+	{Name: "Cyrillic_BE", Value: 0x6e2},
+	{Name: "Cyrillic_TSE", Value: 0x6e3},
+	{Name: "Cyrillic_DE", Value: 0x6e4},
+	{Name: "Cyrillic_IE", Value: 0x6e5},
+	{Name: "Cyrillic_EF", Value: 0x6e6},
+	{Name: "Cyrillic_GHE", Value: 0x6e7},
+	{Name: "Cyrillic_HA", Value: 0x6e8},
+	{Name: "Cyrillic_I", Value: 0x6e9},
+	{Name: "Cyrillic_SHORTI", Value: 0x6ea},
+	{Name: "Cyrillic_KA", Value: 0x6eb},
+	{Name: "Cyrillic_EL", Value: 0x6ec},
+	{Name: "Cyrillic_EM", Value: 0x6ed},
+	{Name: "Cyrillic_EN", Value: 0x6ee},
+	{Name: "Cyrillic_O", Value: 0x6ef},
+	{Name: "Cyrillic_PE", Value: 0x6f0},
+	{Name: "Cyrillic_YA", Value: 0x6f1},
+	{Name: "Cyrillic_ER", Value: 0x6f2},
+	{Name: "Cyrillic_ES", Value: 0x6f3},
+	{Name: "Cyrillic_TE", Value: 0x6f4},
+	{Name: "Cyrillic_U", Value: 0x6f5},
+	{Name: "Cyrillic_ZHE", Value: 0x6f6},
+	{Name: "Cyrillic_VE", Value: 0x6f7},
+	{Name: "Cyrillic_SOFTSIGN", Value: 0x6f8},
+	{Name: "Cyrillic_YERU", Value: 0x6f9},
+	{Name: "Cyrillic_ZE", Value: 0x6fa},
+	{Name: "Cyrillic_SHA", Value: 0x6fb},
+	{Name: "Cyrillic_E", Value: 0x6fc},
+	{Name: "Cyrillic_SHCHA", Value: 0x6fd},
+	{Name: "Cyrillic_CHE", Value: 0x6fe},
+	{Name: "Cyrillic_HARDSIGN", Value: 0x6ff},
+	{Name: "Greek_ALPHAaccent", Value: 0x7a1},
+	{Name: "Greek_EPSILONaccent", Value: 0x7a2},
+	{Name: "Greek_ETAaccent", Value: 0x7a3},
+	{Name: "Greek_IOTAaccent", Value: 0x7a4},
+	{Name: "Greek_IOTAdieresis", Value: 0x7a5},
+	{Name: "Greek_IOTAdiaeresis", Value: 0x7a5},
+	{Name: "Greek_OMICRONaccent", Value: 0x7a7},
+	{Name: "Greek_UPSILONaccent", Value: 0x7a8},
+	{Name: "Greek_UPSILONdieresis", Value: 0x7a9},
+	{Name: "Greek_OMEGAaccent", Value: 0x7ab},
+	{Name: "Greek_accentdieresis", Value: 0x7ae},
+	{Name: "Greek_horizbar", Value: 0x7af},
+	{Name: "Greek_alphaaccent", Value: 0x7b1},
+	{Name: "Greek_epsilonaccent", Value: 0x7b2},
+	{Name: "Greek_etaaccent", Value: 0x7b3},
+	{Name: "Greek_iotaaccent", Value: 0x7b4},
+	{Name: "Greek_iotadieresis", Value: 0x7b5},
+	{Name: "Greek_iotaaccentdieresis", Value: 0x7b6},
+	{Name: "Greek_omicronaccent", Value: 0x7b7},
+	{Name: "Greek_upsilonaccent", Value: 0x7b8},
+	{Name: "Greek_upsilondieresis", Value: 0x7b9},
+	{Name: "Greek_upsilonaccentdieresis", Value: 0x7ba},
+	{Name: "Greek_omegaaccent", Value: 0x7bb},
+	{Name: "Greek_ALPHA", Value: 0x7c1},
+	{Name: "Greek_BETA", Value: 0x7c2},
+	{Name: "Greek_GAMMA", Value: 0x7c3},
+	{Name: "Greek_DELTA", Value: 0x7c4},
+	{Name: "Greek_EPSILON", Value: 0x7c5},
+	{Name: "Greek_ZETA", Value: 0x7c6},
+	{Name: "Greek_ETA", Value: 0x7c7},
+	{Name: "Greek_THETA", Value: 0x7c8},
+	{Name: "Greek_IOTA", Value: 0x7c9},
+	{Name: "Greek_KAPPA", Value: 0x7ca},
+	{Name: "Greek_LAMDA", Value: 0x7cb},
+	{Name: "Greek_LAMBDA", Value: 0x7cb},
+	{Name: "Greek_MU", Value: 0x7cc},
+	{Name: "Greek_NU", Value: 0x7cd},
+	{Name: "Greek_XI", Value: 0x7ce},
+	{Name: "Greek_OMICRON", Value: 0x7cf},
+	{Name: "Greek_PI", Value: 0x7d0},
+	{Name: "Greek_RHO", Value: 0x7d1},
+	{Name: "Greek_SIGMA", Value: 0x7d2},
+	{Name: "Greek_TAU", Value: 0x7d4},
+	{Name: "Greek_UPSILON", Value: 0x7d5},
+	{Name: "Greek_PHI", Value: 0x7d6},
+	{Name: "Greek_CHI", Value: 0x7d7},
+	{Name: "Greek_PSI", Value: 0x7d8},
+	{Name: "Greek_OMEGA", Value: 0x7d9},
+	{Name: "Greek_alpha", Value: 0x7e1},
+	{Name: "Greek_beta", Value: 0x7e2},
+	{Name: "Greek_gamma", Value: 0x7e3},
+	{Name: "Greek_delta", Value: 0x7e4},
+	{Name: "Greek_epsilon", Value: 0x7e5},
+	{Name: "Greek_zeta", Value: 0x7e6},
+	{Name: "Greek_eta", Value: 0x7e7},
+	{Name: "Greek_theta", Value: 0x7e8},
+	{Name: "Greek_iota", Value: 0x7e9},
+	{Name: "Greek_kappa", Value: 0x7ea},
+	{Name: "Greek_lamda", Value: 0x7eb},
+	{Name: "Greek_lambda", Value: 0x7eb},
+	{Name: "Greek_mu", Value: 0x7ec},
+	{Name: "Greek_nu", Value: 0x7ed},
+	{Name: "Greek_xi", Value: 0x7ee},
+	{Name: "Greek_omicron", Value: 0x7ef},
+	{Name: "Greek_pi", Value: 0x7f0},
+	{Name: "Greek_rho", Value: 0x7f1},
+	{Name: "Greek_sigma", Value: 0x7f2},
+	{Name: "Greek_finalsmallsigma", Value: 0x7f3},
+	{Name: "Greek_tau", Value: 0x7f4},
+	{Name: "Greek_upsilon", Value: 0x7f5},
+	{Name: "Greek_phi", Value: 0x7f6},
+	{Name: "Greek_chi", Value: 0x7f7},
+	{Name: "Greek_psi", Value: 0x7f8},
+	{Name: "Greek_omega", Value: 0x7f9},
+	{Name: "Greek_switch", Value: 0xff7e},
+	{Name: "leftradical", Value: 0x8a1},
+	{Name: "topleftradical", Value: 0x8a2},
+	{Name: "horizconnector", Value: 0x8a3},
+	{Name: "topintegral", Value: 0x8a4},
+	{Name: "botintegral", Value: 0x8a5},
+	{Name: "vertconnector", Value: 0x8a6},
+	{Name: "topleftsqbracket", Value: 0x8a7},
+	{Name: "botleftsqbracket", Value: 0x8a8},
+	{Name: "toprightsqbracket", Value: 0x8a9},
+	{Name: "botrightsqbracket", Value: 0x8aa},
+	{Name: "topleftparens", Value: 0x8ab},
+	{Name: "botleftparens", Value: 0x8ac},
+	{Name: "toprightparens", Value: 0x8ad},
+	{Name: "botrightparens", Value: 0x8ae},
+	{Name: "leftmiddlecurlybrace", Value: 0x8af},
+	{Name: "rightmiddlecurlybrace", Value: 0x8b0},
+	{Name: "topleftsummation", Value: 0x8b1},
+	{Name: "botleftsummation", Value: 0x8b2},
+	{Name: "topvertsummationconnector", Value: 0x8b3},
+	{Name: "botvertsummationconnector", Value: 0x8b4},
+	{Name: "toprightsummation", Value: 0x8b5},
+	{Name: "botrightsummation", Value: 0x8b6},
+	{Name: "rightmiddlesummation", Value: 0x8b7},
+	{Name: "lessthanequal", Value: 0x8bc},
+	{Name: "notequal", Value: 0x8bd},
+	{Name: "greaterthanequal", Value: 0x8be},
+	{Name: "integral", Value: 0x8bf},
+	{Name: "therefore", Value: 0x8c0},
+	{Name: "variation", Value: 0x8c1},
+	{Name: "infinity", Value: 0x8c2},
+	{Name: "nabla", Value: 0x8c5},
+	{Name: "approximate", Value: 0x8c8},
+	{Name: "similarequal", Value: 0x8c9},
+	{Name: "ifonlyif", Value: 0x8cd},
+	{Name: "implies", Value: 0x8ce},
+	{Name: "identical", Value: 0x8cf},
+	{Name: "radical", Value: 0x8d6},
+	{Name: "includedin", Value: 0x8da},
+	{Name: "includes", Value: 0x8db},
+	{Name: "intersection", Value: 0x8dc},
+	{Name: "union", Value: 0x8dd},
+	{Name: "logicaland", Value: 0x8de},
+	{Name: "logicalor", Value: 0x8df},
+	{Name: "partialderivative", Value: 0x8ef},
+	{Name: "function", Value: 0x8f6},
+	{Name: "leftarrow", Value: 0x8fb},
+	{Name: "uparrow", Value: 0x8fc},
+	{Name: "rightarrow", Value: 0x8fd},
+	{Name: "downarrow", Value: 0x8fe},
+	{Name: "blank", Value: 0x9df},
+	{Name: "soliddiamond", Value: 0x9e0},
+	{Name: "checkerboard", Value: 0x9e1},
+	{Name: "ht", Value: 0x9e2},
+	{Name: "ff", Value: 0x9e3},
+	{Name: "cr", Value: 0x9e4},
+	{Name: "lf", Value: 0x9e5},
+	{Name: "nl", Value: 0x9e8},
+	{Name: "vt", Value: 0x9e9},
+	{Name: "lowrightcorner", Value: 0x9ea},
+	{Name: "uprightcorner", Value: 0x9eb},
+	{Name: "upleftcorner", Value: 0x9ec},
+	{Name: "lowleftcorner", Value: 0x9ed},
+	{Name: "crossinglines", Value: 0x9ee},
+	{Name: "horizlinescan1", Value: 0x9ef},
+	{Name: "horizlinescan3", Value: 0x9f0},
+	{Name: "horizlinescan5", Value: 0x9f1},
+	{Name: "horizlinescan7", Value: 0x9f2},
+	{Name: "horizlinescan9", Value: 0x9f3},
+	{Name: "leftt", Value: 0x9f4},
+	{Name: "rightt", Value: 0x9f5},
+	{Name: "bott", Value: 0x9f6},
+	{Name: "topt", Value: 0x9f7},
+	{Name: "vertbar", Value: 0x9f8},
+	{Name: "emspace", Value: 0xaa1},
+	{Name: "enspace", Value: 0xaa2},
+	{Name: "em3space", Value: 0xaa3},
+	{Name: "em4space", Value: 0xaa4},
+	{Name: "digitspace", Value: 0xaa5},
+	{Name: "punctspace", Value: 0xaa6},
+	{Name: "thinspace", Value: 0xaa7},
+	{Name: "hairspace", Value: 0xaa8},
+	{Name: "emdash", Value: 0xaa9},
+	{Name: "endash", Value: 0xaaa},
+	{Name: "signifblank", Value: 0xaac},
+	{Name: "ellipsis", Value: 0xaae},
+	{Name: "doubbaselinedot", Value: 0xaaf},
+	{Name: "onethird", Value: 0xab0},
+	{Name: "twothirds", Value: 0xab1},
+	{Name: "onefifth", Value: 0xab2},
+	{Name: "twofifths", Value: 0xab3},
+	{Name: "threefifths", Value: 0xab4},
+	{Name: "fourfifths", Value: 0xab5},
+	{Name: "onesixth", Value: 0xab6},
+	{Name: "fivesixths", Value: 0xab7},
+	{Name: "careof", Value: 0xab8},
+	{Name: "figdash", Value: 0xabb},
+	{Name: "leftanglebracket", Value: 0xabc},
+	{Name: "decimalpoint", Value: 0xabd},
+	{Name: "rightanglebracket", Value: 0xabe},
+	{Name: "marker", Value: 0xabf},
+	{Name: "oneeighth", Value: 0xac3},
+	{Name: "threeeighths", Value: 0xac4},
+	{Name: "fiveeighths", Value: 0xac5},
+	{Name: "seveneighths", Value: 0xac6},
+	{Name: "trademark", Value: 0xac9},
+	{Name: "signaturemark", Value: 0xaca},
+	{Name: "trademarkincircle", Value: 0xacb},
+	{Name: "leftopentriangle", Value: 0xacc},
+	{Name: "rightopentriangle", Value: 0xacd},
+	{Name: "emopencircle", Value: 0xace},
+	{Name: "emopenrectangle", Value: 0xacf},
+	{Name: "leftsinglequotemark", Value: 0xad0},
+	{Name: "rightsinglequotemark", Value: 0xad1},
+	{Name: "leftdoublequotemark", Value: 0xad2},
+	{Name: "rightdoublequotemark", Value: 0xad3},
+	{Name: "prescription", Value: 0xad4},
+	{Name: "permille", Value: 0xad5},
+	{Name: "minutes", Value: 0xad6},
+	{Name: "seconds", Value: 0xad7},
+	{Name: "latincross", Value: 0xad9},
+	{Name: "hexagram", Value: 0xada},
+	{Name: "filledrectbullet", Value: 0xadb},
+	{Name: "filledlefttribullet", Value: 0xadc},
+	{Name: "filledrighttribullet", Value: 0xadd},
+	{Name: "emfilledcircle", Value: 0xade},
+	{Name: "emfilledrect", Value: 0xadf},
+	{Name: "enopencircbullet", Value: 0xae0},
+	{Name: "enopensquarebullet", Value: 0xae1},
+	{Name: "openrectbullet", Value: 0xae2},
+	{Name: "opentribulletup", Value: 0xae3},
+	{Name: "opentribulletdown", Value: 0xae4},
+	{Name: "openstar", Value: 0xae5},
+	{Name: "enfilledcircbullet", Value: 0xae6},
+	{Name: "enfilledsqbullet", Value: 0xae7},
+	{Name: "filledtribulletup", Value: 0xae8},
+	{Name: "filledtribulletdown", Value: 0xae9},
+	{Name: "leftpointer", Value: 0xaea},
+	{Name: "rightpointer", Value: 0xaeb},
+	{Name: "club", Value: 0xaec},
+	{Name: "diamond", Value: 0xaed},
+	{Name: "heart", Value: 0xaee},
+	{Name: "maltesecross", Value: 0xaf0},
+	{Name: "dagger", Value: 0xaf1},
+	{Name: "doubledagger", Value: 0xaf2},
+	{Name: "checkmark", Value: 0xaf3},
+	{Name: "ballotcross", Value: 0xaf4},
+	{Name: "musicalsharp", Value: 0xaf5},
+	{Name: "musicalflat", Value: 0xaf6},
+	{Name: "malesymbol", Value: 0xaf7},
+	{Name: "femalesymbol", Value: 0xaf8},
+	{Name: "telephone", Value: 0xaf9},
+	{Name: "telephonerecorder", Value: 0xafa},
+	{Name: "phonographcopyright", Value: 0xafb},
+	{Name: "caret", Value: 0xafc},
+	{Name: "singlelowquotemark", Value: 0xafd},
+	{Name: "doublelowquotemark", Value: 0xafe},
+	{Name: "cursor", Value: 0xaff},
+	{Name: "leftcaret", Value: 0xba3},
+	{Name: "rightcaret", Value: 0xba6},
+	{Name: "downcaret", Value: 0xba8},
+	{Name: "upcaret", Value: 0xba9},
+	{Name: "overbar", Value: 0xbc0},
+	{Name: "downtack", Value: 0xbc2},
+	{Name: "upshoe", Value: 0xbc3},
+	{Name: "downstile", Value: 0xbc4},
+	{Name: "underbar", Value: 0xbc6},
+	{Name: "jot", Value: 0xbca},
+	{Name: "quad", Value: 0xbcc},
+	{Name: "uptack", Value: 0xbce},
+	{Name: "circle", Value: 0xbcf},
+	{Name: "upstile", Value: 0xbd3},
+	{Name: "downshoe", Value: 0xbd6},
+	{Name: "rightshoe", Value: 0xbd8},
+	{Name: "leftshoe", Value: 0xbda},
+	{Name: "lefttack", Value: 0xbdc},
+	{Name: "righttack", Value: 0xbfc},
+	{Name: "hebrew_doublelowline", Value: 0xcdf},
+	{Name: "hebrew_aleph", Value: 0xce0},
+	{Name: "hebrew_bet", Value: 0xce1},
+	{Name: "hebrew_beth", Value: 0xce1},
+	{Name: "hebrew_gimel", Value: 0xce2},
+	{Name: "hebrew_gimmel", Value: 0xce2},
+	{Name: "hebrew_dalet", Value: 0xce3},
+	{Name: "hebrew_daleth", Value: 0xce3},
+	{Name: "hebrew_he", Value: 0xce4},
+	{Name: "hebrew_waw", Value: 0xce5},
+	{Name: "hebrew_zain", Value: 0xce6},
+	{Name: "hebrew_zayin", Value: 0xce6},
+	{Name: "hebrew_chet", Value: 0xce7},
+	{Name: "hebrew_het", Value: 0xce7},
+	{Name: "hebrew_tet", Value: 0xce8},
+	{Name: "hebrew_teth", Value: 0xce8},
+	{Name: "hebrew_yod", Value: 0xce9},
+	{Name: "hebrew_finalkaph", Value: 0xcea},
+	{Name: "hebrew_kaph", Value: 0xceb},
+	{Name: "hebrew_lamed", Value: 0xcec},
+	{Name: "hebrew_finalmem", Value: 0xced},
+	{Name: "hebrew_mem", Value: 0xcee},
+	{Name: "hebrew_finalnun", Value: 0xcef},
+	{Name: "hebrew_nun", Value: 0xcf0},
+	{Name: "hebrew_samech", Value: 0xcf1},
+	{Name: "hebrew_samekh", Value: 0xcf1},
+	{Name: "hebrew_ayin", Value: 0xcf2},
+	{Name: "hebrew_finalpe", Value: 0xcf3},
+	{Name: "hebrew_pe", Value: 0xcf4},
+	{Name: "hebrew_finalzade", Value: 0xcf5},
+	{Name: "hebrew_finalzadi", Value: 0xcf5},
+	{Name: "hebrew_zade", Value: 0xcf6},
+	{Name: "hebrew_zadi", Value: 0xcf6},
+	{Name: "hebrew_qoph", Value: 0xcf7},
+	{Name: "hebrew_kuf", Value: 0xcf7},
+	{Name: "hebrew_resh", Value: 0xcf8},
+	{Name: "hebrew_shin", Value: 0xcf9},
+	{Name: "hebrew_taw", Value: 0xcfa},
+	{Name: "hebrew_taf", Value: 0xcfa},
+	{Name: "Hebrew_switch", Value: 0xff7e},
+	{Name: "Thai_kokai", Value: 0xda1},
+	{Name: "Thai_khokhai", Value: 0xda2},
+	{Name: "Thai_khokhuat", Value: 0xda3},
+	{Name: "Thai_khokhwai", Value: 0xda4},
+	{Name: "Thai_khokhon", Value: 0xda5},
+	{Name: "Thai_khorakhang", Value: 0xda6},
+	{Name: "Thai_ngongu", Value: 0xda7},
+	{Name: "Thai_chochan", Value: 0xda8},
+	{Name: "Thai_choching", Value: 0xda9},
+	{Name: "Thai_chochang", Value: 0xdaa},
+	{Name: "Thai_soso", Value: 0xdab},
+	{Name: "Thai_chochoe", Value: 0xdac},
+	{Name: "Thai_yoying", Value: 0xdad},
+	{Name: "Thai_dochada", Value: 0xdae},
+	{Name: "Thai_topatak", Value: 0xdaf},
+	{Name: "Thai_thothan", Value: 0xdb0},
+	{Name: "Thai_thonangmontho", Value: 0xdb1},
+	{Name: "Thai_thophuthao", Value: 0xdb2},
+	{Name: "Thai_nonen", Value: 0xdb3},
+	{Name: "Thai_dodek", Value: 0xdb4},
+	{Name: "Thai_totao", Value: 0xdb5},
+	{Name: "Thai_thothung", Value: 0xdb6},
+	{Name: "Thai_thothahan", Value: 0xdb7},
+	{Name: "Thai_thothong", Value: 0xdb8},
+	{Name: "Thai_nonu", Value: 0xdb9},
+	{Name: "Thai_bobaimai", Value: 0xdba},
+	{Name: "Thai_popla", Value: 0xdbb},
+	{Name: "Thai_phophung", Value: 0xdbc},
+	{Name: "Thai_fofa", Value: 0xdbd},
+	{Name: "Thai_phophan", Value: 0xdbe},
+	{Name: "Thai_fofan", Value: 0xdbf},
+	{Name: "Thai_phosamphao", Value: 0xdc0},
+	{Name: "Thai_moma", Value: 0xdc1},
+	{Name: "Thai_yoyak", Value: 0xdc2},
+	{Name: "Thai_rorua", Value: 0xdc3},
+	{Name: "Thai_ru", Value: 0xdc4},
+	{Name: "Thai_loling", Value: 0xdc5},
+	{Name: "Thai_lu", Value: 0xdc6},
+	{Name: "Thai_wowaen", Value: 0xdc7},
+	{Name: "Thai_sosala", Value: 0xdc8},
+	{Name: "Thai_sorusi", Value: 0xdc9},
+	{Name: "Thai_sosua", Value: 0xdca},
+	{Name: "Thai_hohip", Value: 0xdcb},
+	{Name: "Thai_lochula", Value: 0xdcc},
+	{Name: "Thai_oang", Value: 0xdcd},
+	{Name: "Thai_honokhuk", Value: 0xdce},
+	{Name: "Thai_paiyannoi", Value: 0xdcf},
+	{Name: "Thai_saraa", Value: 0xdd0},
+	{Name: "Thai_maihanakat", Value: 0xdd1},
+	{Name: "Thai_saraaa", Value: 0xdd2},
+	{Name: "Thai_saraam", Value: 0xdd3},
+	{Name: "Thai_sarai", Value: 0xdd4},
+	{Name: "Thai_saraii", Value: 0xdd5},
+	{Name: "Thai_saraue", Value: 0xdd6},
+	{Name: "Thai_sarauee", Value: 0xdd7},
+	{Name: "Thai_sarau", Value: 0xdd8},
+	{Name: "Thai_sarauu", Value: 0xdd9},
+	{Name: "Thai_phinthu", Value: 0xdda},
+	{Name: "Thai_maihanakat_maitho", Value: 0xdde},
+	{Name: "Thai_baht", Value: 0xddf},
+	{Name: "Thai_sarae", Value: 0xde0},
+	{Name: "Thai_saraae", Value: 0xde1},
+	{Name: "Thai_sarao", Value: 0xde2},
+	{Name: "Thai_saraaimaimuan", Value: 0xde3},
+	{Name: "Thai_saraaimaimalai", Value: 0xde4},
+	{Name: "Thai_lakkhangyao", Value: 0xde5},
+	{Name: "Thai_maiyamok", Value: 0xde6},
+	{Name: "Thai_maitaikhu", Value: 0xde7},
+	{Name: "Thai_maiek", Value: 0xde8},
+	{Name: "Thai_maitho", Value: 0xde9},
+	{Name: "Thai_maitri", Value: 0xdea},
+	{Name: "Thai_maichattawa", Value: 0xdeb},
+	{Name: "Thai_thanthakhat", Value: 0xdec},
+	{Name: "Thai_nikhahit", Value: 0xded},
+	{Name: "Thai_leksun", Value: 0xdf0},
+	{Name: "Thai_leknung", Value: 0xdf1},
+	{Name: "Thai_leksong", Value: 0xdf2},
+	{Name: "Thai_leksam", Value: 0xdf3},
+	{Name: "Thai_leksi", Value: 0xdf4},
+	{Name: "Thai_lekha", Value: 0xdf5},
+	{Name: "Thai_lekhok", Value: 0xdf6},
+	{Name: "Thai_lekchet", Value: 0xdf7},
+	{Name: "Thai_lekpaet", Value: 0xdf8},
+	{Name: "Thai_lekkao", Value: 0xdf9},
+	{Name: "Hangul", Value: 0xff31},
+	{Name: "Hangul_Start", Value: 0xff32},
+	{Name: "Hangul_End", Value: 0xff33},
+	{Name: "Hangul_Hanja", Value: 0xff34},
+	{Name: "Hangul_Jamo", Value: 0xff35},
+	{Name: "Hangul_Romaja", Value: 0xff36},
+	{Name: "Hangul_Codeinput", Value: 0xff37},
+	{Name: "Hangul_Jeonja", Value: 0xff38},
+	{Name: "Hangul_Banja", Value: 0xff39},
+	{Name: "Hangul_PreHanja", Value: 0xff3a},
+	{Name: "Hangul_PostHanja", Value: 0xff3b},
+	{Name: "Hangul_SingleCandidate", Value: 0xff3c},
+	{Name: "Hangul_MultipleCandidate", Value: 0xff3d},
+	{Name: "Hangul_PreviousCandidate", Value: 0xff3e},
+	{Name: "Hangul_Special", Value: 0xff3f},
+	{Name: "Hangul_switch", Value: 0xff7e},
+	{Name: "Hangul_Kiyeog", Value: 0xea1},
+	{Name: "Hangul_SsangKiyeog", Value: 0xea2},
+	{Name: "Hangul_KiyeogSios", Value: 0xea3},
+	{Name: "Hangul_Nieun", Value: 0xea4},
+	{Name: "Hangul_NieunJieuj", Value: 0xea5},
+	{Name: "Hangul_NieunHieuh", Value: 0xea6},
+	{Name: "Hangul_Dikeud", Value: 0xea7},
+	{Name: "Hangul_SsangDikeud", Value: 0xea8},
+	{Name: "Hangul_Rieul", Value: 0xea9},
+	{Name: "Hangul_RieulKiyeog", Value: 0xeaa},
+	{Name: "Hangul_RieulMieum", Value: 0xeab},
+	{Name: "Hangul_RieulPieub", Value: 0xeac},
+	{Name: "Hangul_RieulSios", Value: 0xead},
+	{Name: "Hangul_RieulTieut", Value: 0xeae},
+	{Name: "Hangul_RieulPhieuf", Value: 0xeaf},
+	{Name: "Hangul_RieulHieuh", Value: 0xeb0},
+	{Name: "Hangul_Mieum", Value: 0xeb1},
+	{Name: "Hangul_Pieub", Value: 0xeb2},
+	{Name: "Hangul_SsangPieub", Value: 0xeb3},
+	{Name: "Hangul_PieubSios", Value: 0xeb4},
+	{Name: "Hangul_Sios", Value: 0xeb5},
+	{Name: "Hangul_SsangSios", Value: 0xeb6},
+	{Name: "Hangul_Ieung", Value: 0xeb7},
+	{Name: "Hangul_Jieuj", Value: 0xeb8},
+	{Name: "Hangul_SsangJieuj", Value: 0xeb9},
+	{Name: "Hangul_Cieuc", Value: 0xeba},
+	{Name: "Hangul_Khieuq", Value: 0xebb},
+	{Name: "Hangul_Tieut", Value: 0xebc},
+	{Name: "Hangul_Phieuf", Value: 0xebd},
+	{Name: "Hangul_Hieuh", Value: 0xebe},
+	{Name: "Hangul_A", Value: 0xebf},
+	{Name: "Hangul_AE", Value: 0xec0},
+	{Name: "Hangul_YA", Value: 0xec1},
+	{Name: "Hangul_YAE", Value: 0xec2},
+	{Name: "Hangul_EO", Value: 0xec3},
+	{Name: "Hangul_E", Value: 0xec4},
+	{Name: "Hangul_YEO", Value: 0xec5},
+	{Name: "Hangul_YE", Value: 0xec6},
+	{Name: "Hangul_O", Value: 0xec7},
+	{Name: "Hangul_WA", Value: 0xec8},
+	{Name: "Hangul_WAE", Value: 0xec9},
+	{Name: "Hangul_OE", Value: 0xeca},
+	{Name: "Hangul_YO", Value: 0xecb},
+	{Name: "Hangul_U", Value: 0xecc},
+	{Name: "Hangul_WEO", Value: 0xecd},
+	{Name: "Hangul_WE", Value: 0xece},
+	{Name: "Hangul_WI", Value: 0xecf},
+	{Name: "Hangul_YU", Value: 0xed0},
+	{Name: "Hangul_EU", Value: 0xed1},
+	{Name: "Hangul_YI", Value: 0xed2},
+	{Name: "Hangul_I", Value: 0xed3},
+	{Name: "Hangul_J_Kiyeog", Value: 0xed4},
+	{Name: "Hangul_J_SsangKiyeog", Value: 0xed5},
+	{Name: "Hangul_J_KiyeogSios", Value: 0xed6},
+	{Name: "Hangul_J_Nieun", Value: 0xed7},
+	{Name: "Hangul_J_NieunJieuj", Value: 0xed8},
+	{Name: "Hangul_J_NieunHieuh", Value: 0xed9},
+	{Name: "Hangul_J_Dikeud", Value: 0xeda},
+	{Name: "Hangul_J_Rieul", Value: 0xedb},
+	{Name: "Hangul_J_RieulKiyeog", Value: 0xedc},
+	{Name: "Hangul_J_RieulMieum", Value: 0xedd},
+	{Name: "Hangul_J_RieulPieub", Value: 0xede},
+	{Name: "Hangul_J_RieulSios", Value: 0xedf},
+	{Name: "Hangul_J_RieulTieut", Value: 0xee0},
+	{Name: "Hangul_J_RieulPhieuf", Value: 0xee1},
+	{Name: "Hangul_J_RieulHieuh", Value: 0xee2},
+	{Name: "Hangul_J_Mieum", Value: 0xee3},
+	{Name: "Hangul_J_Pieub", Value: 0xee4},
+	{Name: "Hangul_J_PieubSios", Value: 0xee5},
+	{Name: "Hangul_J_Sios", Value: 0xee6},
+	{Name: "Hangul_J_SsangSios", Value: 0xee7},
+	{Name: "Hangul_J_Ieung", Value: 0xee8},
+	{Name: "Hangul_J_Jieuj", Value: 0xee9},
+	{Name: "Hangul_J_Cieuc", Value: 0xeea},
+	{Name: "Hangul_J_Khieuq", Value: 0xeeb},
+	{Name: "Hangul_J_Tieut", Value: 0xeec},
+	{Name: "Hangul_J_Phieuf", Value: 0xeed},
+	{Name: "Hangul_J_Hieuh", Value: 0xeee},
+	{Name: "Hangul_RieulYeorinHieuh", Value: 0xeef},
+	{Name: "Hangul_SunkyeongeumMieum", Value: 0xef0},
+	{Name: "Hangul_SunkyeongeumPieub", Value: 0xef1},
+	{Name: "Hangul_PanSios", Value: 0xef2},
+	{Name: "Hangul_KkogjiDalrinIeung", Value: 0xef3},
+	{Name: "Hangul_SunkyeongeumPhieuf", Value: 0xef4},
+	{Name: "Hangul_YeorinHieuh", Value: 0xef5},
+	{Name: "Hangul_AraeA", Value: 0xef6},
+	{Name: "Hangul_AraeAE", Value: 0xef7},
+	{Name: "Hangul_J_PanSios", Value: 0xef8},
+	{Name: "Hangul_J_KkogjiDalrinIeung", Value: 0xef9},
+	{Name: "Hangul_J_YeorinHieuh", Value: 0xefa},
+	{Name: "Korean_Won", Value: 0xeff},
+	{Name: "Armenian_ligature_ew", Value: 0x1000587},
+	{Name: "Armenian_full_stop", Value: 0x1000589},
+	{Name: "Armenian_verjaket", Value: 0x1000589},
+	{Name: "Armenian_separation_mark", Value: 0x100055d},
+	{Name: "Armenian_but", Value: 0x100055d},
+	{Name: "Armenian_hyphen", Value: 0x100058a},
+	{Name: "Armenian_yentamna", Value: 0x100058a},
+	{Name: "Armenian_exclam", Value: 0x100055c},
+	{Name: "Armenian_amanak", Value: 0x100055c},
+	{Name: "Armenian_accent", Value: 0x100055b},
+	{Name: "Armenian_shesht", Value: 0x100055b},
+	{Name: "Armenian_question", Value: 0x100055e},
+	{Name: "Armenian_paruyk", Value: 0x100055e},
+	{Name: "Armenian_AYB", Value: 0x1000531},
+	{Name: "Armenian_ayb", Value: 0x1000561},
+	{Name: "Armenian_BEN", Value: 0x1000532},
+	{Name: "Armenian_ben", Value: 0x1000562},
+	{Name: "Armenian_GIM", Value: 0x1000533},
+	{Name: "Armenian_gim", Value: 0x1000563},
+	{Name: "Armenian_DA", Value: 0x1000534},
+	{Name: "Armenian_da", Value: 0x1000564},
+	{Name: "Armenian_YECH", Value: 0x1000535},
+	{Name: "Armenian_yech", Value: 0x1000565},
+	{Name: "Armenian_ZA", Value: 0x1000536},
+	{Name: "Armenian_za", Value: 0x1000566},
+	{Name: "Armenian_E", Value: 0x1000537},
+	{Name: "Armenian_e", Value: 0x1000567},
+	{Name: "Armenian_AT", Value: 0x1000538},
+	{Name: "Armenian_at", Value: 0x1000568},
+	{Name: "Armenian_TO", Value: 0x1000539},
+	{Name: "Armenian_to", Value: 0x1000569},
+	{Name: "Armenian_ZHE", Value: 0x100053a},
+	{Name: "Armenian_zhe", Value: 0x100056a},
+	{Name: "Armenian_INI", Value: 0x100053b},
+	{Name: "Armenian_ini", Value: 0x100056b},
+	{Name: "Armenian_LYUN", Value: 0x100053c},
+	{Name: "Armenian_lyun", Value: 0x100056c},
+	{Name: "Armenian_KHE", Value: 0x100053d},
+	{Name: "Armenian_khe", Value: 0x100056d},
+	{Name: "Armenian_TSA", Value: 0x100053e},
+	{Name: "Armenian_tsa", Value: 0x100056e},
+	{Name: "Armenian_KEN", Value: 0x100053f},
+	{Name: "Armenian_ken", Value: 0x100056f},
+	{Name: "Armenian_HO", Value: 0x1000540},
+	{Name: "Armenian_ho", Value: 0x1000570},
+	{Name: "Armenian_DZA", Value: 0x1000541},
+	{Name: "Armenian_dza", Value: 0x1000571},
+	{Name: "Armenian_GHAT", Value: 0x1000542},
+	{Name: "Armenian_ghat", Value: 0x1000572},
+	{Name: "Armenian_TCHE", Value: 0x1000543},
+	{Name: "Armenian_tche", Value: 0x1000573},
+	{Name: "Armenian_MEN", Value: 0x1000544},
+	{Name: "Armenian_men", Value: 0x1000574},
+	{Name: "Armenian_HI", Value: 0x1000545},
+	{Name: "Armenian_hi", Value: 0x1000575},
+	{Name: "Armenian_NU", Value: 0x1000546},
+	{Name: "Armenian_nu", Value: 0x1000576},
+	{Name: "Armenian_SHA", Value: 0x1000547},
+	{Name: "Armenian_sha", Value: 0x1000577},
+	{Name: "Armenian_VO", Value: 0x1000548},
+	{Name: "Armenian_vo", Value: 0x1000578},
+	{Name: "Armenian_CHA", Value: 0x1000549},
+	{Name: "Armenian_cha", Value: 0x1000579},
+	{Name: "Armenian_PE", Value: 0x100054a},
+	{Name: "Armenian_pe", Value: 0x100057a},
+	{Name: "Armenian_JE", Value: 0x100054b},
+	{Name: "Armenian_je", Value: 0x100057b},
+	{Name: "Armenian_RA", Value: 0x100054c},
+	{Name: "Armenian_ra", Value: 0x100057c},
+	{Name: "Armenian_SE", Value: 0x100054d},
+	{Name: "Armenian_se", Value: 0x100057d},
+	{Name: "Armenian_VEV", Value: 0x100054e},
+	{Name: "Armenian_vev", Value: 0x100057e},
+	{Name: "Armenian_TYUN", Value: 0x100054f},
+	{Name: "Armenian_tyun", Value: 0x100057f},
+	{Name: "Armenian_RE", Value: 0x1000550},
+	{Name: "Armenian_re", Value: 0x1000580},
+	{Name: "Armenian_TSO", Value: 0x1000551},
+	{Name: "Armenian_tso", Value: 0x1000581},
+	{Name: "Armenian_VYUN", Value: 0x1000552},
+	{Name: "Armenian_vyun", Value: 0x1000582},
+	{Name: "Armenian_PYUR", Value: 0x1000553},
+	{Name: "Armenian_pyur", Value: 0x1000583},
+	{Name: "Armenian_KE", Value: 0x1000554},
+	{Name: "Armenian_ke", Value: 0x1000584},
+	{Name: "Armenian_O", Value: 0x1000555},
+	{Name: "Armenian_o", Value: 0x1000585},
+	{Name: "Armenian_FE", Value: 0x1000556},
+	{Name: "Armenian_fe", Value: 0x1000586},
+	{Name: "Armenian_apostrophe", Value: 0x100055a},
+	{Name: "Georgian_an", Value: 0x10010d0},
+	{Name: "Georgian_ban", Value: 0x10010d1},
+	{Name: "Georgian_gan", Value: 0x10010d2},
+	{Name: "Georgian_don", Value: 0x10010d3},
+	{Name: "Georgian_en", Value: 0x10010d4},
+	{Name: "Georgian_vin", Value: 0x10010d5},
+	{Name: "Georgian_zen", Value: 0x10010d6},
+	{Name: "Georgian_tan", Value: 0x10010d7},
+	{Name: "Georgian_in", Value: 0x10010d8},
+	{Name: "Georgian_kan", Value: 0x10010d9},
+	{Name: "Georgian_las", Value: 0x10010da},
+	{Name: "Georgian_man", Value: 0x10010db},
+	{Name: "Georgian_nar", Value: 0x10010dc},
+	{Name: "Georgian_on", Value: 0x10010dd},
+	{Name: "Georgian_par", Value: 0x10010de},
+	{Name: "Georgian_zhar", Value: 0x10010df},
+	{Name: "Georgian_rae", Value: 0x10010e0},
+	{Name: "Georgian_san", Value: 0x10010e1},
+	{Name: "Georgian_tar", Value: 0x10010e2},
+	{Name: "Georgian_un", Value: 0x10010e3},
+	{Name: "Georgian_phar", Value: 0x10010e4},
+	{Name: "Georgian_khar", Value: 0x10010e5},
+	{Name: "Georgian_ghan", Value: 0x10010e6},
+	{Name: "Georgian_qar", Value: 0x10010e7},
+	{Name: "Georgian_shin", Value: 0x10010e8},
+	{Name: "Georgian_chin", Value: 0x10010e9},
+	{Name: "Georgian_can", Value: 0x10010ea},
+	{Name: "Georgian_jil", Value: 0x10010eb},
+	{Name: "Georgian_cil", Value: 0x10010ec},
+	{Name: "Georgian_char", Value: 0x10010ed},
+	{Name: "Georgian_xan", Value: 0x10010ee},
+	{Name: "Georgian_jhan", Value: 0x10010ef},
+	{Name: "Georgian_hae", Value: 0x10010f0},
+	{Name: "Georgian_he", Value: 0x10010f1},
+	{Name: "Georgian_hie", Value: 0x10010f2},
+	{Name: "Georgian_we", Value: 0x10010f3},
+	{Name: "Georgian_har", Value: 0x10010f4},
+	{Name: "Georgian_hoe", Value: 0x10010f5},
+	{Name: "Georgian_fi", Value: 0x10010f6},
+	{Name: "Xabovedot", Value: 0x1001e8a},
+	{Name: "Ibreve", Value: 0x100012c},
+	{Name: "Zstroke", Value: 0x10001b5},
+	{Name: "Gcaron", Value: 0x10001e6},
+	{Name: "Ocaron", Value: 0x10001d1},
+	{Name: "Obarred", Value: 0x100019f},
+	{Name: "xabovedot", Value: 0x1001e8b},
+	{Name: "ibreve", Value: 0x100012d},
+	{Name: "zstroke", Value: 0x10001b6},
+	{Name: "gcaron", Value: 0x10001e7},
+	{Name: "ocaron", Value: 0x10001d2},
+	{Name: "obarred", Value: 0x1000275},
+	{Name: "SCHWA", Value: 0x100018f},
+	{Name: "schwa", Value: 0x1000259},
+	{Name: "EZH", Value: 0x10001b7},
+	{Name: "ezh", Value: 0x1000292},
+	{Name: "Lbelowdot", Value: 0x1001e36},
+	{Name: "lbelowdot", Value: 0x1001e37},
+	{Name: "Abelowdot", Value: 0x1001ea0},
+	{Name: "abelowdot", Value: 0x1001ea1},
+	{Name: "Ahook", Value: 0x1001ea2},
+	{Name: "ahook", Value: 0x1001ea3},
+	{Name: "Acircumflexacute", Value: 0x1001ea4},
+	{Name: "acircumflexacute", Value: 0x1001ea5},
+	{Name: "Acircumflexgrave", Value: 0x1001ea6},
+	{Name: "acircumflexgrave", Value: 0x1001ea7},
+	{Name: "Acircumflexhook", Value: 0x1001ea8},
+	{Name: "acircumflexhook", Value: 0x1001ea9},
+	{Name: "Acircumflextilde", Value: 0x1001eaa},
+	{Name: "acircumflextilde", Value: 0x1001eab},
+	{Name: "Acircumflexbelowdot", Value: 0x1001eac},
+	{Name: "acircumflexbelowdot", Value: 0x1001ead},
+	{Name: "Abreveacute", Value: 0x1001eae},
+	{Name: "abreveacute", Value: 0x1001eaf},
+	{Name: "Abrevegrave", Value: 0x1001eb0},
+	{Name: "abrevegrave", Value: 0x1001eb1},
+	{Name: "Abrevehook", Value: 0x1001eb2},
+	{Name: "abrevehook", Value: 0x1001eb3},
+	{Name: "Abrevetilde", Value: 0x1001eb4},
+	{Name: "abrevetilde", Value: 0x1001eb5},
+	{Name: "Abrevebelowdot", Value: 0x1001eb6},
+	{Name: "abrevebelowdot", Value: 0x1001eb7},
+	{Name: "Ebelowdot", Value: 0x1001eb8},
+	{Name: "ebelowdot", Value: 0x1001eb9},
+	{Name: "Ehook", Value: 0x1001eba},
+	{Name: "ehook", Value: 0x1001ebb},
+	{Name: "Etilde", Value: 0x1001ebc},
+	{Name: "etilde", Value: 0x1001ebd},
+	{Name: "Ecircumflexacute", Value: 0x1001ebe},
+	{Name: "ecircumflexacute", Value: 0x1001ebf},
+	{Name: "Ecircumflexgrave", Value: 0x1001ec0},
+	{Name: "ecircumflexgrave", Value: 0x1001ec1},
+	{Name: "Ecircumflexhook", Value: 0x1001ec2},
+	{Name: "ecircumflexhook", Value: 0x1001ec3},
+	{Name: "Ecircumflextilde", Value: 0x1001ec4},
+	{Name: "ecircumflextilde", Value: 0x1001ec5},
+	{Name: "Ecircumflexbelowdot", Value: 0x1001ec6},
+	{Name: "ecircumflexbelowdot", Value: 0x1001ec7},
+	{Name: "Ihook", Value: 0x1001ec8},
+	{Name: "ihook", Value: 0x1001ec9},
+	{Name: "Ibelowdot", Value: 0x1001eca},
+	{Name: "ibelowdot", Value: 0x1001ecb},
+	{Name: "Obelowdot", Value: 0x1001ecc},
+	{Name: "obelowdot", Value: 0x1001ecd},
+	{Name: "Ohook", Value: 0x1001ece},
+	{Name: "ohook", Value: 0x1001ecf},
+	{Name: "Ocircumflexacute", Value: 0x1001ed0},
+	{Name: "ocircumflexacute", Value: 0x1001ed1},
+	{Name: "Ocircumflexgrave", Value: 0x1001ed2},
+	{Name: "ocircumflexgrave", Value: 0x1001ed3},
+	{Name: "Ocircumflexhook", Value: 0x1001ed4},
+	{Name: "ocircumflexhook", Value: 0x1001ed5},
+	{Name: "Ocircumflextilde", Value: 0x1001ed6},
+	{Name: "ocircumflextilde", Value: 0x1001ed7},
+	{Name: "Ocircumflexbelowdot", Value: 0x1001ed8},
+	{Name: "ocircumflexbelowdot", Value: 0x1001ed9},
+	{Name: "Ohornacute", Value: 0x1001eda},
+	{Name: "ohornacute", Value: 0x1001edb},
+	{Name: "Ohorngrave", Value: 0x1001edc},
+	{Name: "ohorngrave", Value: 0x1001edd},
+	{Name: "Ohornhook", Value: 0x1001ede},
+	{Name: "ohornhook", Value: 0x1001edf},
+	{Name: "Ohorntilde", Value: 0x1001ee0},
+	{Name: "ohorntilde", Value: 0x1001ee1},
+	{Name: "Ohornbelowdot", Value: 0x1001ee2},
+	{Name: "ohornbelowdot", Value: 0x1001ee3},
+	{Name: "Ubelowdot", Value: 0x1001ee4},
+	{Name: "ubelowdot", Value: 0x1001ee5},
+	{Name: "Uhook", Value: 0x1001ee6},
+	{Name: "uhook", Value: 0x1001ee7},
+	{Name: "Uhornacute", Value: 0x1001ee8},
+	{Name: "uhornacute", Value: 0x1001ee9},
+	{Name: "Uhorngrave", Value: 0x1001eea},
+	{Name: "uhorngrave", Value: 0x1001eeb},
+	{Name: "Uhornhook", Value: 0x1001eec},
+	{Name: "uhornhook", Value: 0x1001eed},
+	{Name: "Uhorntilde", Value: 0x1001eee},
+	{Name: "uhorntilde", Value: 0x1001eef},
+	{Name: "Uhornbelowdot", Value: 0x1001ef0},
+	{Name: "uhornbelowdot", Value: 0x1001ef1},
+	{Name: "Ybelowdot", Value: 0x1001ef4},
+	{Name: "ybelowdot", Value: 0x1001ef5},
+	{Name: "Yhook", Value: 0x1001ef6},
+	{Name: "yhook", Value: 0x1001ef7},
+	{Name: "Ytilde", Value: 0x1001ef8},
+	{Name: "ytilde", Value: 0x1001ef9},
+	{Name: "Ohorn", Value: 0x10001a0},
+	{Name: "ohorn", Value: 0x10001a1},
+	{Name: "Uhorn", Value: 0x10001af},
+	{Name: "uhorn", Value: 0x10001b0},
+	{Name: "combining_tilde", Value: 0x1000303},
+	{Name: "combining_grave", Value: 0x1000300},
+	{Name: "combining_acute", Value: 0x1000301},
+	{Name: "combining_hook", Value: 0x1000309},
+	{Name: "combining_belowdot", Value: 0x1000323},
+	{Name: "EcuSign", Value: 0x10020a0},
+	{Name: "ColonSign", Value: 0x10020a1},
+	{Name: "CruzeiroSign", Value: 0x10020a2},
+	{Name: "FFrancSign", Value: 0x10020a3},
+	{Name: "LiraSign", Value: 0x10020a4},
+	{Name: "MillSign", Value: 0x10020a5},
+	{Name: "NairaSign", Value: 0x10020a6},
+	{Name: "PesetaSign", Value: 0x10020a7},
+	{Name: "RupeeSign", Value: 0x10020a8},
+	{Name: "WonSign", Value: 0x10020a9},
+	{Name: "NewSheqelSign", Value: 0x10020aa},
+	{Name: "DongSign", Value: 0x10020ab},
+	{Name: "EuroSign", Value: 0x20ac},
+	{Name: "zerosuperior", Value: 0x1002070},
+	{Name: "foursuperior", Value: 0x1002074},
+	{Name: "fivesuperior", Value: 0x1002075},
+	{Name: "sixsuperior", Value: 0x1002076},
+	{Name: "sevensuperior", Value: 0x1002077},
+	{Name: "eightsuperior", Value: 0x1002078},
+	{Name: "ninesuperior", Value: 0x1002079},
+	{Name: "zerosubscript", Value: 0x1002080},
+	{Name: "onesubscript", Value: 0x1002081},
+	{Name: "twosubscript", Value: 0x1002082},
+	{Name: "threesubscript", Value: 0x1002083},
+	{Name: "foursubscript", Value: 0x1002084},
+	{Name: "fivesubscript", Value: 0x1002085},
+	{Name: "sixsubscript", Value: 0x1002086},
+	{Name: "sevensubscript", Value: 0x1002087},
+	{Name: "eightsubscript", Value: 0x1002088},
+	{Name: "ninesubscript", Value: 0x1002089},
+	{Name: "partdifferential", Value: 0x1002202},
+	{Name: "emptyset", Value: 0x1002205},
+	{Name: "elementof", Value: 0x1002208},
+	{Name: "notelementof", Value: 0x1002209},
+	{Name: "containsas", Value: 0x100220b},
+	{Name: "squareroot", Value: 0x100221a},
+	{Name: "cuberoot", Value: 0x100221b},
+	{Name: "fourthroot", Value: 0x100221c},
+	{Name: "dintegral", Value: 0x100222c},
+	{Name: "tintegral", Value: 0x100222d},
+	{Name: "because", Value: 0x1002235},
+	{Name: "approxeq", Value: 0x1002248},
+	{Name: "notapproxeq", Value: 0x1002247},
+	{Name: "notidentical", Value: 0x1002262},
+	{Name: "stricteq", Value: 0x1002263},
+	{Name: "braille_dot_1", Value: 0xfff1},
+	{Name: "braille_dot_2", Value: 0xfff2},
+	{Name: "braille_dot_3", Value: 0xfff3},
+	{Name: "braille_dot_4", Value: 0xfff4},
+	{Name: "braille_dot_5", Value: 0xfff5},
+	{Name: "braille_dot_6", Value: 0xfff6},
+	{Name: "braille_dot_7", Value: 0xfff7},
+	{Name: "braille_dot_8", Value: 0xfff8},
+	{Name: "braille_dot_9", Value: 0xfff9},
+	{Name: "braille_dot_10", Value: 0xfffa},
+	{Name: "braille_blank", Value: 0x1002800},
+	{Name: "braille_dots_1", Value: 0x1002801},
+	{Name: "braille_dots_2", Value: 0x1002802},
+	{Name: "braille_dots_12", Value: 0x1002803},
+	{Name: "braille_dots_3", Value: 0x1002804},
+	{Name: "braille_dots_13", Value: 0x1002805},
+	{Name: "braille_dots_23", Value: 0x1002806},
+	{Name: "braille_dots_123", Value: 0x1002807},
+	{Name: "braille_dots_4", Value: 0x1002808},
+	{Name: "braille_dots_14", Value: 0x1002809},
+	{Name: "braille_dots_24", Value: 0x100280a},
+	{Name: "braille_dots_124", Value: 0x100280b},
+	{Name: "braille_dots_34", Value: 0x100280c},
+	{Name: "braille_dots_134", Value: 0x100280d},
+	{Name: "braille_dots_234", Value: 0x100280e},
+	{Name: "braille_dots_1234", Value: 0x100280f},
+	{Name: "braille_dots_5", Value: 0x1002810},
+	{Name: "braille_dots_15", Value: 0x1002811},
+	{Name: "braille_dots_25", Value: 0x1002812},
+	{Name: "braille_dots_125", Value: 0x1002813},
+	{Name: "braille_dots_35", Value: 0x1002814},
+	{Name: "braille_dots_135", Value: 0x1002815},
+	{Name: "braille_dots_235", Value: 0x1002816},
+	{Name: "braille_dots_1235", Value: 0x1002817},
+	{Name: "braille_dots_45", Value: 0x1002818},
+	{Name: "braille_dots_145", Value: 0x1002819},
+	{Name: "braille_dots_245", Value: 0x100281a},
+	{Name: "braille_dots_1245", Value: 0x100281b},
+	{Name: "braille_dots_345", Value: 0x100281c},
+	{Name: "braille_dots_1345", Value: 0x100281d},
+	{Name: "braille_dots_2345", Value: 0x100281e},
+	{Name: "braille_dots_12345", Value: 0x100281f},
+	{Name: "braille_dots_6", Value: 0x1002820},
+	{Name: "braille_dots_16", Value: 0x1002821},
+	{Name: "braille_dots_26", Value: 0x1002822},
+	{Name: "braille_dots_126", Value: 0x1002823},
+	{Name: "braille_dots_36", Value: 0x1002824},
+	{Name: "braille_dots_136", Value: 0x1002825},
+	{Name: "braille_dots_236", Value: 0x1002826},
+	{Name: "braille_dots_1236", Value: 0x1002827},
+	{Name: "braille_dots_46", Value: 0x1002828},
+	{Name: "braille_dots_146", Value: 0x1002829},
+	{Name: "braille_dots_246", Value: 0x100282a},
+	{Name: "braille_dots_1246", Value: 0x100282b},
+	{Name: "braille_dots_346", Value: 0x100282c},
+	{Name: "braille_dots_1346", Value: 0x100282d},
+	{Name: "braille_dots_2346", Value: 0x100282e},
+	{Name: "braille_dots_12346", Value: 0x100282f},
+	{Name: "braille_dots_56", Value: 0x1002830},
+	{Name: "braille_dots_156", Value: 0x1002831},
+	{Name: "braille_dots_256", Value: 0x1002832},
+	{Name: "braille_dots_1256", Value: 0x1002833},
+	{Name: "braille_dots_356", Value: 0x1002834},
+	{Name: "braille_dots_1356", Value: 0x1002835},
+	{Name: "braille_dots_2356", Value: 0x1002836},
+	{Name: "braille_dots_12356", Value: 0x1002837},
+	{Name: "braille_dots_456", Value: 0x1002838},
+	{Name: "braille_dots_1456", Value: 0x1002839},
+	{Name: "braille_dots_2456", Value: 0x100283a},
+	{Name: "braille_dots_12456", Value: 0x100283b},
+	{Name: "braille_dots_3456", Value: 0x100283c},
+	{Name: "braille_dots_13456", Value: 0x100283d},
+	{Name: "braille_dots_23456", Value: 0x100283e},
+	{Name: "braille_dots_123456", Value: 0x100283f},
+	{Name: "braille_dots_7", Value: 0x1002840},
+	{Name: "braille_dots_17", Value: 0x1002841},
+	{Name: "braille_dots_27", Value: 0x1002842},
+	{Name: "braille_dots_127", Value: 0x1002843},
+	{Name: "braille_dots_37", Value: 0x1002844},
+	{Name: "braille_dots_137", Value: 0x1002845},
+	{Name: "braille_dots_237", Value: 0x1002846},
+	{Name: "braille_dots_1237", Value: 0x1002847},
+	{Name: "braille_dots_47", Value: 0x1002848},
+	{Name: "braille_dots_147", Value: 0x1002849},
+	{Name: "braille_dots_247", Value: 0x100284a},
+	{Name: "braille_dots_1247", Value: 0x100284b},
+	{Name: "braille_dots_347", Value: 0x100284c},
+	{Name: "braille_dots_1347", Value: 0x100284d},
+	{Name: "braille_dots_2347", Value: 0x100284e},
+	{Name: "braille_dots_12347", Value: 0x100284f},
+	{Name: "braille_dots_57", Value: 0x1002850},
+	{Name: "braille_dots_157", Value: 0x1002851},
+	{Name: "braille_dots_257", Value: 0x1002852},
+	{Name: "braille_dots_1257", Value: 0x1002853},
+	{Name: "braille_dots_357", Value: 0x1002854},
+	{Name: "braille_dots_1357", Value: 0x1002855},
+	{Name: "braille_dots_2357", Value: 0x1002856},
+	{Name: "braille_dots_12357", Value: 0x1002857},
+	{Name: "braille_dots_457", Value: 0x1002858},
+	{Name: "braille_dots_1457", Value: 0x1002859},
+	{Name: "braille_dots_2457", Value: 0x100285a},
+	{Name: "braille_dots_12457", Value: 0x100285b},
+	{Name: "braille_dots_3457", Value: 0x100285c},
+	{Name: "braille_dots_13457", Value: 0x100285d},
+	{Name: "braille_dots_23457", Value: 0x100285e},
+	{Name: "braille_dots_123457", Value: 0x100285f},
+	{Name: "braille_dots_67", Value: 0x1002860},
+	{Name: "braille_dots_167", Value: 0x1002861},
+	{Name: "braille_dots_267", Value: 0x1002862},
+	{Name: "braille_dots_1267", Value: 0x1002863},
+	{Name: "braille_dots_367", Value: 0x1002864},
+	{Name: "braille_dots_1367", Value: 0x1002865},
+	{Name: "braille_dots_2367", Value: 0x1002866},
+	{Name: "braille_dots_12367", Value: 0x1002867},
+	{Name: "braille_dots_467", Value: 0x1002868},
+	{Name: "braille_dots_1467", Value: 0x1002869},
+	{Name: "braille_dots_2467", Value: 0x100286a},
+	{Name: "braille_dots_12467", Value: 0x100286b},
+	{Name: "braille_dots_3467", Value: 0x100286c},
+	{Name: "braille_dots_13467", Value: 0x100286d},
+	{Name: "braille_dots_23467", Value: 0x100286e},
+	{Name: "braille_dots_123467", Value: 0x100286f},
+	{Name: "braille_dots_567", Value: 0x1002870},
+	{Name: "braille_dots_1567", Value: 0x1002871},
+	{Name: "braille_dots_2567", Value: 0x1002872},
+	{Name: "braille_dots_12567", Value: 0x1002873},
+	{Name: "braille_dots_3567", Value: 0x1002874},
+	{Name: "braille_dots_13567", Value: 0x1002875},
+	{Name: "braille_dots_23567", Value: 0x1002876},
+	{Name: "braille_dots_123567", Value: 0x1002877},
+	{Name: "braille_dots_4567", Value: 0x1002878},
+	{Name: "braille_dots_14567", Value: 0x1002879},
+	{Name: "braille_dots_24567", Value: 0x100287a},
+	{Name: "braille_dots_124567", Value: 0x100287b},
+	{Name: "braille_dots_34567", Value: 0x100287c},
+	{Name: "braille_dots_134567", Value: 0x100287d},
+	{Name: "braille_dots_234567", Value: 0x100287e},
+	{Name: "braille_dots_1234567", Value: 0x100287f},
+	{Name: "braille_dots_8", Value: 0x1002880},
+	{Name: "braille_dots_18", Value: 0x1002881},
+	{Name: "braille_dots_28", Value: 0x1002882},
+	{Name: "braille_dots_128", Value: 0x1002883},
+	{Name: "braille_dots_38", Value: 0x1002884},
+	{Name: "braille_dots_138", Value: 0x1002885},
+	{Name: "braille_dots_238", Value: 0x1002886},
+	{Name: "braille_dots_1238", Value: 0x1002887},
+	{Name: "braille_dots_48", Value: 0x1002888},
+	{Name: "braille_dots_148", Value: 0x1002889},
+	{Name: "braille_dots_248", Value: 0x100288a},
+	{Name: "braille_dots_1248", Value: 0x100288b},
+	{Name: "braille_dots_348", Value: 0x100288c},
+	{Name: "braille_dots_1348", Value: 0x100288d},
+	{Name: "braille_dots_2348", Value: 0x100288e},
+	{Name: "braille_dots_12348", Value: 0x100288f},
+	{Name: "braille_dots_58", Value: 0x1002890},
+	{Name: "braille_dots_158", Value: 0x1002891},
+	{Name: "braille_dots_258", Value: 0x1002892},
+	{Name: "braille_dots_1258", Value: 0x1002893},
+	{Name: "braille_dots_358", Value: 0x1002894},
+	{Name: "braille_dots_1358", Value: 0x1002895},
+	{Name: "braille_dots_2358", Value: 0x1002896},
+	{Name: "braille_dots_12358", Value: 0x1002897},
+	{Name: "braille_dots_458", Value: 0x1002898},
+	{Name: "braille_dots_1458", Value: 0x1002899},
+	{Name: "braille_dots_2458", Value: 0x100289a},
+	{Name: "braille_dots_12458", Value: 0x100289b},
+	{Name: "braille_dots_3458", Value: 0x100289c},
+	{Name: "braille_dots_13458", Value: 0x100289d},
+	{Name: "braille_dots_23458", Value: 0x100289e},
+	{Name: "braille_dots_123458", Value: 0x100289f},
+	{Name: "braille_dots_68", Value: 0x10028a0},
+	{Name: "braille_dots_168", Value: 0x10028a1},
+	{Name: "braille_dots_268", Value: 0x10028a2},
+	{Name: "braille_dots_1268", Value: 0x10028a3},
+	{Name: "braille_dots_368", Value: 0x10028a4},
+	{Name: "braille_dots_1368", Value: 0x10028a5},
+	{Name: "braille_dots_2368", Value: 0x10028a6},
+	{Name: "braille_dots_12368", Value: 0x10028a7},
+	{Name: "braille_dots_468", Value: 0x10028a8},
+	{Name: "braille_dots_1468", Value: 0x10028a9},
+	{Name: "braille_dots_2468", Value: 0x10028aa},
+	{Name: "braille_dots_12468", Value: 0x10028ab},
+	{Name: "braille_dots_3468", Value: 0x10028ac},
+	{Name: "braille_dots_13468", Value: 0x10028ad},
+	{Name: "braille_dots_23468", Value: 0x10028ae},
+	{Name: "braille_dots_123468", Value: 0x10028af},
+	{Name: "braille_dots_568", Value: 0x10028b0},
+	{Name: "braille_dots_1568", Value: 0x10028b1},
+	{Name: "braille_dots_2568", Value: 0x10028b2},
+	{Name: "braille_dots_12568", Value: 0x10028b3},
+	{Name: "braille_dots_3568", Value: 0x10028b4},
+	{Name: "braille_dots_13568", Value: 0x10028b5},
+	{Name: "braille_dots_23568", Value: 0x10028b6},
+	{Name: "braille_dots_123568", Value: 0x10028b7},
+	{Name: "braille_dots_4568", Value: 0x10028b8},
+	{Name: "braille_dots_14568", Value: 0x10028b9},
+	{Name: "braille_dots_24568", Value: 0x10028ba},
+	{Name: "braille_dots_124568", Value: 0x10028bb},
+	{Name: "braille_dots_34568", Value: 0x10028bc},
+	{Name: "braille_dots_134568", Value: 0x10028bd},
+	{Name: "braille_dots_234568", Value: 0x10028be},
+	{Name: "braille_dots_1234568", Value: 0x10028bf},
+	{Name: "braille_dots_78", Value: 0x10028c0},
+	{Name: "braille_dots_178", Value: 0x10028c1},
+	{Name: "braille_dots_278", Value: 0x10028c2},
+	{Name: "braille_dots_1278", Value: 0x10028c3},
+	{Name: "braille_dots_378", Value: 0x10028c4},
+	{Name: "braille_dots_1378", Value: 0x10028c5},
+	{Name: "braille_dots_2378", Value: 0x10028c6},
+	{Name: "braille_dots_12378", Value: 0x10028c7},
+	{Name: "braille_dots_478", Value: 0x10028c8},
+	{Name: "braille_dots_1478", Value: 0x10028c9},
+	{Name: "braille_dots_2478", Value: 0x10028ca},
+	{Name: "braille_dots_12478", Value: 0x10028cb},
+	{Name: "braille_dots_3478", Value: 0x10028cc},
+	{Name: "braille_dots_13478", Value: 0x10028cd},
+	{Name: "braille_dots_23478", Value: 0x10028ce},
+	{Name: "braille_dots_123478", Value: 0x10028cf},
+	{Name: "braille_dots_578", Value: 0x10028d0},
+	{Name: "braille_dots_1578", Value: 0x10028d1},
+	{Name: "braille_dots_2578", Value: 0x10028d2},
+	{Name: "braille_dots_12578", Value: 0x10028d3},
+	{Name: "braille_dots_3578", Value: 0x10028d4},
+	{Name: "braille_dots_13578", Value: 0x10028d5},
+	{Name: "braille_dots_23578", Value: 0x10028d6},
+	{Name: "braille_dots_123578", Value: 0x10028d7},
+	{Name: "braille_dots_4578", Value: 0x10028d8},
+	{Name: "braille_dots_14578", Value: 0x10028d9},
+	{Name: "braille_dots_24578", Value: 0x10028da},
+	{Name: "braille_dots_124578", Value: 0x10028db},
+	{Name: "braille_dots_34578", Value: 0x10028dc},
+	{Name: "braille_dots_134578", Value: 0x10028dd},
+	{Name: "braille_dots_234578", Value: 0x10028de},
+	{Name: "braille_dots_1234578", Value: 0x10028df},
+	{Name: "braille_dots_678", Value: 0x10028e0},
+	{Name: "braille_dots_1678", Value: 0x10028e1},
+	{Name: "braille_dots_2678", Value: 0x10028e2},
+	{Name: "braille_dots_12678", Value: 0x10028e3},
+	{Name: "braille_dots_3678", Value: 0x10028e4},
+	{Name: "braille_dots_13678", Value: 0x10028e5},
+	{Name: "braille_dots_23678", Value: 0x10028e6},
+	{Name: "braille_dots_123678", Value: 0x10028e7},
+	{Name: "braille_dots_4678", Value: 0x10028e8},
+	{Name: "braille_dots_14678", Value: 0x10028e9},
+	{Name: "braille_dots_24678", Value: 0x10028ea},
+	{Name: "braille_dots_124678", Value: 0x10028eb},
+	{Name: "braille_dots_34678", Value: 0x10028ec},
+	{Name: "braille_dots_134678", Value: 0x10028ed},
+	{Name: "braille_dots_234678", Value: 0x10028ee},
+	{Name: "braille_dots_1234678", Value: 0x10028ef},
+	{Name: "braille_dots_5678", Value: 0x10028f0},
+	{Name: "braille_dots_15678", Value: 0x10028f1},
+	{Name: "braille_dots_25678", Value: 0x10028f2},
+	{Name: "braille_dots_125678", Value: 0x10028f3},
+	{Name: "braille_dots_35678", Value: 0x10028f4},
+	{Name: "braille_dots_135678", Value: 0x10028f5},
+	{Name: "braille_dots_235678", Value: 0x10028f6},
+	{Name: "braille_dots_1235678", Value: 0x10028f7},
+	{Name: "braille_dots_45678", Value: 0x10028f8},
+	{Name: "braille_dots_145678", Value: 0x10028f9},
+	{Name: "braille_dots_245678", Value: 0x10028fa},
+	{Name: "braille_dots_1245678", Value: 0x10028fb},
+	{Name: "braille_dots_345678", Value: 0x10028fc},
+	{Name: "braille_dots_1345678", Value: 0x10028fd},
+	{Name: "braille_dots_2345678", Value: 0x10028fe},
+	{Name: "braille_dots_12345678", Value: 0x10028ff},
+	{Name: "Sinh_ng", Value: 0x1000d82},
+	{Name: "Sinh_h2", Value: 0x1000d83},
+	{Name: "Sinh_a", Value: 0x1000d85},
+	{Name: "Sinh_aa", Value: 0x1000d86},
+	{Name: "Sinh_ae", Value: 0x1000d87},
+	{Name: "Sinh_aee", Value: 0x1000d88},
+	{Name: "Sinh_i", Value: 0x1000d89},
+	{Name: "Sinh_ii", Value: 0x1000d8a},
+	{Name: "Sinh_u", Value: 0x1000d8b},
+	{Name: "Sinh_uu", Value: 0x1000d8c},
+	{Name: "Sinh_ri", Value: 0x1000d8d},
+	{Name: "Sinh_rii", Value: 0x1000d8e},
+	{Name: "Sinh_lu", Value: 0x1000d8f},
+	{Name: "Sinh_luu", Value: 0x1000d90},
+	{Name: "Sinh_e", Value: 0x1000d91},
+	{Name: "Sinh_ee", Value: 0x1000d92},
+	{Name: "Sinh_ai", Value: 0x1000d93},
+	{Name: "Sinh_o", Value: 0x1000d94},
+	{Name: "Sinh_oo", Value: 0x1000d95},
+	{Name: "Sinh_au", Value: 0x1000d96},
+	{Name: "Sinh_ka", Value: 0x1000d9a},
+	{Name: "Sinh_kha", Value: 0x1000d9b},
+	{Name: "Sinh_ga", Value: 0x1000d9c},
+	{Name: "Sinh_gha", Value: 0x1000d9d},
+	{Name: "Sinh_ng2", Value: 0x1000d9e},
+	{Name: "Sinh_nga", Value: 0x1000d9f},
+	{Name: "Sinh_ca", Value: 0x1000da0},
+	{Name: "Sinh_cha", Value: 0x1000da1},
+	{Name: "Sinh_ja", Value: 0x1000da2},
+	{Name: "Sinh_jha", Value: 0x1000da3},
+	{Name: "Sinh_nya", Value: 0x1000da4},
+	{Name: "Sinh_jnya", Value: 0x1000da5},
+	{Name: "Sinh_nja", Value: 0x1000da6},
+	{Name: "Sinh_tta", Value: 0x1000da7},
+	{Name: "Sinh_ttha", Value: 0x1000da8},
+	{Name: "Sinh_dda", Value: 0x1000da9},
+	{Name: "Sinh_ddha", Value: 0x1000daa},
+	{Name: "Sinh_nna", Value: 0x1000dab},
+	{Name: "Sinh_ndda", Value: 0x1000dac},
+	{Name: "Sinh_tha", Value: 0x1000dad},
+	{Name: "Sinh_thha", Value: 0x1000dae},
+	{Name: "Sinh_dha", Value: 0x1000daf},
+	{Name: "Sinh_dhha", Value: 0x1000db0},
+	{Name: "Sinh_na", Value: 0x1000db1},
+	{Name: "Sinh_ndha", Value: 0x1000db3},
+	{Name: "Sinh_pa", Value: 0x1000db4},
+	{Name: "Sinh_pha", Value: 0x1000db5},
+	{Name: "Sinh_ba", Value: 0x1000db6},
+	{Name: "Sinh_bha", Value: 0x1000db7},
+	{Name: "Sinh_ma", Value: 0x1000db8},
+	{Name: "Sinh_mba", Value: 0x1000db9},
+	{Name: "Sinh_ya", Value: 0x1000dba},
+	{Name: "Sinh_ra", Value: 0x1000dbb},
+	{Name: "Sinh_la", Value: 0x1000dbd},
+	{Name: "Sinh_va", Value: 0x1000dc0},
+	{Name: "Sinh_sha", Value: 0x1000dc1},
+	{Name: "Sinh_ssha", Value: 0x1000dc2},
+	{Name: "Sinh_sa", Value: 0x1000dc3},
+	{Name: "Sinh_ha", Value: 0x1000dc4},
+	{Name: "Sinh_lla", Value: 0x1000dc5},
+	{Name: "Sinh_fa", Value: 0x1000dc6},
+	{Name: "Sinh_al", Value: 0x1000dca},
+	{Name: "Sinh_aa2", Value: 0x1000dcf},
+	{Name: "Sinh_ae2", Value: 0x1000dd0},
+	{Name: "Sinh_aee2", Value: 0x1000dd1},
+	{Name: "Sinh_i2", Value: 0x1000dd2},
+	{Name: "Sinh_ii2", Value: 0x1000dd3},
+	{Name: "Sinh_u2", Value: 0x1000dd4},
+	{Name: "Sinh_uu2", Value: 0x1000dd6},
+	{Name: "Sinh_ru2", Value: 0x1000dd8},
+	{Name: "Sinh_e2", Value: 0x1000dd9},
+	{Name: "Sinh_ee2", Value: 0x1000dda},
+	{Name: "Sinh_ai2", Value: 0x1000ddb},
+	{Name: "Sinh_o2", Value: 0x1000ddc},
+	{Name: "Sinh_oo2", Value: 0x1000ddd},
+	{Name: "Sinh_au2", Value: 0x1000dde},
+	{Name: "Sinh_lu2", Value: 0x1000ddf},
+	{Name: "Sinh_ruu2", Value: 0x1000df2},
+	{Name: "Sinh_luu2", Value: 0x1000df3},
+	{Name: "Sinh_kunddaliya", Value: 0x1000df4},
+	{Name: "XF86ModeLock", Value: 0x1008ff01},
+	{Name: "XF86MonBrightnessUp", Value: 0x1008ff02},
+	{Name: "XF86MonBrightnessDown", Value: 0x1008ff03},
+	{Name: "XF86KbdLightOnOff", Value: 0x1008ff04},
+	{Name: "XF86KbdBrightnessUp", Value: 0x1008ff05},
+	{Name: "XF86KbdBrightnessDown", Value: 0x1008ff06},
+	{Name: "XF86MonBrightnessCycle", Value: 0x1008ff07},
+	{Name: "XF86Standby", Value: 0x1008ff10},
+	{Name: "XF86AudioLowerVolume", Value: 0x1008ff11},
+	{Name: "XF86AudioMute", Value: 0x1008ff12},
+	{Name: "XF86AudioRaiseVolume", Value: 0x1008ff13},
+	{Name: "XF86AudioPlay", Value: 0x1008ff14},
+	{Name: "XF86AudioStop", Value: 0x1008ff15},
+	{Name: "XF86AudioPrev", Value: 0x1008ff16},
+	{Name: "XF86AudioNext", Value: 0x1008ff17},
+	{Name: "XF86HomePage", Value: 0x1008ff18},
+	{Name: "XF86Mail", Value: 0x1008ff19},
+	{Name: "XF86Start", Value: 0x1008ff1a},
+	{Name: "XF86Search", Value: 0x1008ff1b},
+	{Name: "XF86AudioRecord", Value: 0x1008ff1c},
+	{Name: "XF86Calculator", Value: 0x1008ff1d},
+	{Name: "XF86Memo", Value: 0x1008ff1e},
+	{Name: "XF86ToDoList", Value: 0x1008ff1f},
+	{Name: "XF86Calendar", Value: 0x1008ff20},
+	{Name: "XF86PowerDown", Value: 0x1008ff21},
+	{Name: "XF86ContrastAdjust", Value: 0x1008ff22},
+	{Name: "XF86RockerUp", Value: 0x1008ff23},
+	{Name: "XF86RockerDown", Value: 0x1008ff24},
+	{Name: "XF86RockerEnter", Value: 0x1008ff25},
+	{Name: "XF86Back", Value: 0x1008ff26},
+	{Name: "XF86Forward", Value: 0x1008ff27},
+	{Name: "XF86Stop", Value: 0x1008ff28},
+	{Name: "XF86Refresh", Value: 0x1008ff29},
+	{Name: "XF86PowerOff", Value: 0x1008ff2a},
+	{Name: "XF86WakeUp", Value: 0x1008ff2b},
+	{Name: "XF86Eject", Value: 0x1008ff2c},
+	{Name: "XF86ScreenSaver", Value: 0x1008ff2d},
+	{Name: "XF86WWW", Value: 0x1008ff2e},
+	{Name: "XF86Sleep", Value: 0x1008ff2f},
+	{Name: "XF86Favorites", Value: 0x1008ff30},
+	{Name: "XF86AudioPause", Value: 0x1008ff31},
+	{Name: "XF86AudioMedia", Value: 0x1008ff32},
+	{Name: "XF86MyComputer", Value: 0x1008ff33},
+	{Name: "XF86VendorHome", Value: 0x1008ff34},
+	{Name: "XF86LightBulb", Value: 0x1008ff35},
+	{Name: "XF86Shop", Value: 0x1008ff36},
+	{Name: "XF86History", Value: 0x1008ff37},
+	{Name: "XF86OpenURL", Value: 0x1008ff38},
+	{Name: "XF86AddFavorite", Value: 0x1008ff39},
+	{Name: "XF86HotLinks", Value: 0x1008ff3a},
+	{Name: "XF86BrightnessAdjust", Value: 0x1008ff3b},
+	{Name: "XF86Finance", Value: 0x1008ff3c},
+	{Name: "XF86Community", Value: 0x1008ff3d},
+	{Name: "XF86AudioRewind", Value: 0x1008ff3e},
+	{Name: "XF86BackForward", Value: 0x1008ff3f},
+	{Name: "XF86Launch0", Value: 0x1008ff40},
+	{Name: "XF86Launch1", Value: 0x1008ff41},
+	{Name: "XF86Launch2", Value: 0x1008ff42},
+	{Name: "XF86Launch3", Value: 0x1008ff43},
+	{Name: "XF86Launch4", Value: 0x1008ff44},
+	{Name: "XF86Launch5", Value: 0x1008ff45},
+	{Name: "XF86Launch6", Value: 0x1008ff46},
+	{Name: "XF86Launch7", Value: 0x1008ff47},
+	{Name: "XF86Launch8", Value: 0x1008ff48},
+	{Name: "XF86Launch9", Value: 0x1008ff49},
+	{Name: "XF86LaunchA", Value: 0x1008ff4a},
+	{Name: "XF86LaunchB", Value: 0x1008ff4b},
+	{Name: "XF86LaunchC", Value: 0x1008ff4c},
+	{Name: "XF86LaunchD", Value: 0x1008ff4d},
+	{Name: "XF86LaunchE", Value: 0x1008ff4e},
+	{Name: "XF86LaunchF", Value: 0x1008ff4f},
+	{Name: "XF86ApplicationLeft", Value: 0x1008ff50},
+	{Name: "XF86ApplicationRight", Value: 0x1008ff51},
+	{Name: "XF86Book", Value: 0x1008ff52},
+	{Name: "XF86CD", Value: 0x1008ff53},
+	{Name: "XF86Calculater", Value: 0x1008ff54},
+	{Name: "XF86Clear", Value: 0x1008ff55},
+	{Name: "XF86Close", Value: 0x1008ff56},
+	{Name: "XF86Copy", Value: 0x1008ff57},
+	{Name: "XF86Cut", Value: 0x1008ff58},
+	{Name: "XF86Display", Value: 0x1008ff59},
+	{Name: "XF86DOS", Value: 0x1008ff5a},
+	{Name: "XF86Documents", Value: 0x1008ff5b},
+	{Name: "XF86Excel", Value: 0x1008ff5c},
+	{Name: "XF86Explorer", Value: 0x1008ff5d},
+	{Name: "XF86Game", Value: 0x1008ff5e},
+	{Name: "XF86Go", Value: 0x1008ff5f},
+	{Name: "XF86iTouch", Value: 0x1008ff60},
+	{Name: "XF86LogOff", Value: 0x1008ff61},
+	{Name: "XF86Market", Value: 0x1008ff62},
+	{Name: "XF86Meeting", Value: 0x1008ff63},
+	{Name: "XF86MenuKB", Value: 0x1008ff65},
+	{Name: "XF86MenuPB", Value: 0x1008ff66},
+	{Name: "XF86MySites", Value: 0x1008ff67},
+	{Name: "XF86New", Value: 0x1008ff68},
+	{Name: "XF86News", Value: 0x1008ff69},
+	{Name: "XF86OfficeHome", Value: 0x1008ff6a},
+	{Name: "XF86Open", Value: 0x1008ff6b},
+	{Name: "XF86Option", Value: 0x1008ff6c},
+	{Name: "XF86Paste", Value: 0x1008ff6d},
+	{Name: "XF86Phone", Value: 0x1008ff6e},
+	{Name: "XF86Q", Value: 0x1008ff70},
+	{Name: "XF86Reply", Value: 0x1008ff72},
+	{Name: "XF86Reload", Value: 0x1008ff73},
+	{Name: "XF86RotateWindows", Value: 0x1008ff74},
+	{Name: "XF86RotationPB", Value: 0x1008ff75},
+	{Name: "XF86RotationKB", Value: 0x1008ff76},
+	{Name: "XF86Save", Value: 0x1008ff77},
+	{Name: "XF86ScrollUp", Value: 0x1008ff78},
+	{Name: "XF86ScrollDown", Value: 0x1008ff79},
+	{Name: "XF86ScrollClick", Value: 0x1008ff7a},
+	{Name: "XF86Send", Value: 0x1008ff7b},
+	{Name: "XF86Spell", Value: 0x1008ff7c},
+	{Name: "XF86SplitScreen", Value: 0x1008ff7d},
+	{Name: "XF86Support", Value: 0x1008ff7e},
+	{Name: "XF86TaskPane", Value: 0x1008ff7f},
+	{Name: "XF86Terminal", Value: 0x1008ff80},
+	{Name: "XF86Tools", Value: 0x1008ff81},
+	{Name: "XF86Travel", Value: 0x1008ff82},
+	{Name: "XF86UserPB", Value: 0x1008ff84},
+	{Name: "XF86User1KB", Value: 0x1008ff85},
+	{Name: "XF86User2KB", Value: 0x1008ff86},
+	{Name: "XF86Video", Value: 0x1008ff87},
+	{Name: "XF86WheelButton", Value: 0x1008ff88},
+	{Name: "XF86Word", Value: 0x1008ff89},
+	{Name: "XF86Xfer", Value: 0x1008ff8a},
+	{Name: "XF86ZoomIn", Value: 0x1008ff8b},
+	{Name: "XF86ZoomOut", Value: 0x1008ff8c},
+	{Name: "XF86Away", Value: 0x1008ff8d},
+	{Name: "XF86Messenger", Value: 0x1008ff8e},
+	{Name: "XF86WebCam", Value: 0x1008ff8f},
+	{Name: "XF86MailForward", Value: 0x1008ff90},
+	{Name: "XF86Pictures", Value: 0x1008ff91},
+	{Name: "XF86Music", Value: 0x1008ff92},
+	{Name: "XF86Battery", Value: 0x1008ff93},
+	{Name: "XF86Bluetooth", Value: 0x1008ff94},
+	{Name: "XF86WLAN", Value: 0x1008ff95},
+	{Name: "XF86UWB", Value: 0x1008ff96},
+	{Name: "XF86AudioForward", Value: 0x1008ff97},
+	{Name: "XF86AudioRepeat", Value: 0x1008ff98},
+	{Name: "XF86AudioRandomPlay", Value: 0x1008ff99},
+	{Name: "XF86Subtitle", Value: 0x1008ff9a},
+	{Name: "XF86AudioCycleTrack", Value: 0x1008ff9b},
+	{Name: "XF86CycleAngle", Value: 0x1008ff9c},
+	{Name: "XF86FrameBack", Value: 0x1008ff9d},
+	{Name: "XF86FrameForward", Value: 0x1008ff9e},
+	{Name: "XF86Time", Value: 0x1008ff9f},
+	{Name: "XF86Select", Value: 0x1008ffa0},
+	{Name: "XF86View", Value: 0x1008ffa1},
+	{Name: "XF86TopMenu", Value: 0x1008ffa2},
+	{Name: "XF86Red", Value: 0x1008ffa3},
+	{Name: "XF86Green", Value: 0x1008ffa4},
+	{Name: "XF86Yellow", Value: 0x1008ffa5},
+	{Name: "XF86Blue", Value: 0x1008ffa6},
+	{Name: "XF86Suspend", Value: 0x1008ffa7},
+	{Name: "XF86Hibernate", Value: 0x1008ffa8},
+	{Name: "XF86TouchpadToggle", Value: 0x1008ffa9},
+	{Name: "XF86TouchpadOn", Value: 0x1008ffb0},
+	{Name: "XF86TouchpadOff", Value: 0x1008ffb1},
+	{Name: "XF86AudioMicMute", Value: 0x1008ffb2},
+	{Name: "XF86Keyboard", Value: 0x1008ffb3},
+	{Name: "XF86WWAN", Value: 0x1008ffb4},
+	{Name: "XF86RFKill", Value: 0x1008ffb5},
+	{Name: "XF86AudioPreset", Value: 0x1008ffb6},
+	{Name: "XF86RotationLockToggle", Value: 0x1008ffb7},
+	{Name: "XF86FullScreen", Value: 0x1008ffb8},
+	{Name: "XF86Switch_VT_1", Value: 0x1008fe01},
+	{Name: "XF86Switch_VT_2", Value: 0x1008fe02},
+	{Name: "XF86Switch_VT_3", Value: 0x1008fe03},
+	{Name: "XF86Switch_VT_4", Value: 0x1008fe04},
+	{Name: "XF86Switch_VT_5", Value: 0x1008fe05},
+	{Name: "XF86Switch_VT_6", Value: 0x1008fe06},
+	{Name: "XF86Switch_VT_7", Value: 0x1008fe07},
+	{Name: "XF86Switch_VT_8", Value: 0x1008fe08},
+	{Name: "XF86Switch_VT_9", Value: 0x1008fe09},
+	{Name: "XF86Switch_VT_10", Value: 0x1008fe0a},
+	{Name: "XF86Switch_VT_11", Value: 0x1008fe0b},
+	{Name: "XF86Switch_VT_12", Value: 0x1008fe0c},
+	{Name: "XF86Ungrab", Value: 0x1008fe20},
+	{Name: "XF86ClearGrab", Value: 0x1008fe21},
+	{Name: "XF86Next_VMode", Value: 0x1008fe22},
+	{Name: "XF86Prev_VMode", Value: 0x1008fe23},
+	{Name: "XF86LogWindowTree", Value: 0x1008fe24},
+	{Name: "XF86LogGrabInfo", Value: 0x1008fe25},
+	{Name: "XF86BrightnessAuto", Value: 0x100810f4},
+	{Name: "XF86DisplayOff", Value: 0x100810f5},
+	{Name: "XF86Info", Value: 0x10081166},
+	{Name: "XF86AspectRatio", Value: 0x10081177},
+	{Name: "XF86DVD", Value: 0x10081185},
+	{Name: "XF86Audio", Value: 0x10081188},
+	{Name: "XF86ChannelUp", Value: 0x10081192},
+	{Name: "XF86ChannelDown", Value: 0x10081193},
+	{Name: "XF86Break", Value: 0x1008119b},
+	{Name: "XF86VideoPhone", Value: 0x100811a0},
+	{Name: "XF86ZoomReset", Value: 0x100811a4},
+	{Name: "XF86Editor", Value: 0x100811a6},
+	{Name: "XF86GraphicsEditor", Value: 0x100811a8},
+	{Name: "XF86Presentation", Value: 0x100811a9},
+	{Name: "XF86Database", Value: 0x100811aa},
+	{Name: "XF86Voicemail", Value: 0x100811ac},
+	{Name: "XF86Addressbook", Value: 0x100811ad},
+	{Name: "XF86DisplayToggle", Value: 0x100811af},
+	{Name: "XF86SpellCheck", Value: 0x100811b0},
+	{Name: "XF86ContextMenu", Value: 0x100811b6},
+	{Name: "XF86MediaRepeat", Value: 0x100811b7},
+	{Name: "XF8610ChannelsUp", Value: 0x100811b8},
+	{Name: "XF8610ChannelsDown", Value: 0x100811b9},
+	{Name: "XF86Images", Value: 0x100811ba},
+	{Name: "XF86NotificationCenter", Value: 0x100811bc},
+	{Name: "XF86PickupPhone", Value: 0x100811bd},
+	{Name: "XF86HangupPhone", Value: 0x100811be},
+	{Name: "XF86Fn", Value: 0x100811d0},
+	{Name: "XF86Fn_Esc", Value: 0x100811d1},
+	{Name: "XF86FnRightShift", Value: 0x100811e5},
+	{Name: "XF86Numeric0", Value: 0x10081200},
+	{Name: "XF86Numeric1", Value: 0x10081201},
+	{Name: "XF86Numeric2", Value: 0x10081202},
+	{Name: "XF86Numeric3", Value: 0x10081203},
+	{Name: "XF86Numeric4", Value: 0x10081204},
+	{Name: "XF86Numeric5", Value: 0x10081205},
+	{Name: "XF86Numeric6", Value: 0x10081206},
+	{Name: "XF86Numeric7", Value: 0x10081207},
+	{Name: "XF86Numeric8", Value: 0x10081208},
+	{Name: "XF86Numeric9", Value: 0x10081209},
+	{Name: "XF86NumericStar", Value: 0x1008120a},
+	{Name: "XF86NumericPound", Value: 0x1008120b},
+	{Name: "XF86NumericA", Value: 0x1008120c},
+	{Name: "XF86NumericB", Value: 0x1008120d},
+	{Name: "XF86NumericC", Value: 0x1008120e},
+	{Name: "XF86NumericD", Value: 0x1008120f},
+	{Name: "XF86CameraFocus", Value: 0x10081210},
+	{Name: "XF86WPSButton", Value: 0x10081211},
+	{Name: "XF86CameraZoomIn", Value: 0x10081215},
+	{Name: "XF86CameraZoomOut", Value: 0x10081216},
+	{Name: "XF86CameraUp", Value: 0x10081217},
+	{Name: "XF86CameraDown", Value: 0x10081218},
+	{Name: "XF86CameraLeft", Value: 0x10081219},
+	{Name: "XF86CameraRight", Value: 0x1008121a},
+	{Name: "XF86AttendantOn", Value: 0x1008121b},
+	{Name: "XF86AttendantOff", Value: 0x1008121c},
+	{Name: "XF86AttendantToggle", Value: 0x1008121d},
+	{Name: "XF86LightsToggle", Value: 0x1008121e},
+	{Name: "XF86ALSToggle", Value: 0x10081230},
+	{Name: "XF86Buttonconfig", Value: 0x10081240},
+	{Name: "XF86Taskmanager", Value: 0x10081241},
+	{Name: "XF86Journal", Value: 0x10081242},
+	{Name: "XF86ControlPanel", Value: 0x10081243},
+	{Name: "XF86AppSelect", Value: 0x10081244},
+	{Name: "XF86Screensaver", Value: 0x10081245},
+	{Name: "XF86VoiceCommand", Value: 0x10081246},
+	{Name: "XF86Assistant", Value: 0x10081247},
+	{Name: "XF86EmojiPicker", Value: 0x10081249},
+	{Name: "XF86Dictate", Value: 0x1008124a},
+	{Name: "XF86CameraAccessEnable", Value: 0x1008124b},
+	{Name: "XF86CameraAccessDisable", Value: 0x1008124c},
+	{Name: "XF86CameraAccessToggle", Value: 0x1008124d},
+	{Name: "XF86BrightnessMin", Value: 0x10081250},
+	{Name: "XF86BrightnessMax", Value: 0x10081251},
+	{Name: "XF86KbdInputAssistPrev", Value: 0x10081260},
+	{Name: "XF86KbdInputAssistNext", Value: 0x10081261},
+	{Name: "XF86KbdInputAssistPrevgroup", Value: 0x10081262},
+	{Name: "XF86KbdInputAssistNextgroup", Value: 0x10081263},
+	{Name: "XF86KbdInputAssistAccept", Value: 0x10081264},
+	{Name: "XF86KbdInputAssistCancel", Value: 0x10081265},
+	{Name: "XF86RightUp", Value: 0x10081266},
+	{Name: "XF86RightDown", Value: 0x10081267},
+	{Name: "XF86LeftUp", Value: 0x10081268},
+	{Name: "XF86LeftDown", Value: 0x10081269},
+	{Name: "XF86RootMenu", Value: 0x1008126a},
+	{Name: "XF86MediaTopMenu", Value: 0x1008126b},
+	{Name: "XF86Numeric11", Value: 0x1008126c},
+	{Name: "XF86Numeric12", Value: 0x1008126d},
+	{Name: "XF86AudioDesc", Value: 0x1008126e},
+	{Name: "XF863DMode", Value: 0x1008126f},
+	{Name: "XF86NextFavorite", Value: 0x10081270},
+	{Name: "XF86StopRecord", Value: 0x10081271},
+	{Name: "XF86PauseRecord", Value: 0x10081272},
+	{Name: "XF86VOD", Value: 0x10081273},
+	{Name: "XF86Unmute", Value: 0x10081274},
+	{Name: "XF86FastReverse", Value: 0x10081275},
+	{Name: "XF86SlowReverse", Value: 0x10081276},
+	{Name: "XF86Data", Value: 0x10081277},
+	{Name: "XF86OnScreenKeyboard", Value: 0x10081278},
+	{Name: "XF86PrivacyScreenToggle", Value: 0x10081279},
+	{Name: "XF86SelectiveScreenshot", Value: 0x1008127a},
+	{Name: "XF86NextElement", Value: 0x1008127b},
+	{Name: "XF86PreviousElement", Value: 0x1008127c},
+	{Name: "XF86AutopilotEngageToggle", Value: 0x1008127d},
+	{Name: "XF86MarkWaypoint", Value: 0x1008127e},
+	{Name: "XF86Sos", Value: 0x1008127f},
+	{Name: "XF86NavChart", Value: 0x10081280},
+	{Name: "XF86FishingChart", Value: 0x10081281},
+	{Name: "XF86SingleRangeRadar", Value: 0x10081282},
+	{Name: "XF86DualRangeRadar", Value: 0x10081283},
+	{Name: "XF86RadarOverlay", Value: 0x10081284},
+	{Name: "XF86TraditionalSonar", Value: 0x10081285},
+	{Name: "XF86ClearvuSonar", Value: 0x10081286},
+	{Name: "XF86SidevuSonar", Value: 0x10081287},
+	{Name: "XF86NavInfo", Value: 0x10081288},
+	{Name: "XF86Macro1", Value: 0x10081290},
+	{Name: "XF86Macro2", Value: 0x10081291},
+	{Name: "XF86Macro3", Value: 0x10081292},
+	{Name: "XF86Macro4", Value: 0x10081293},
+	{Name: "XF86Macro5", Value: 0x10081294},
+	{Name: "XF86Macro6", Value: 0x10081295},
+	{Name: "XF86Macro7", Value: 0x10081296},
+	{Name: "XF86Macro8", Value: 0x10081297},
+	{Name: "XF86Macro9", Value: 0x10081298},
+	{Name: "XF86Macro10", Value: 0x10081299},
+	{Name: "XF86Macro11", Value: 0x1008129a},
+	{Name: "XF86Macro12", Value: 0x1008129b},
+	{Name: "XF86Macro13", Value: 0x1008129c},
+	{Name: "XF86Macro14", Value: 0x1008129d},
+	{Name: "XF86Macro15", Value: 0x1008129e},
+	{Name: "XF86Macro16", Value: 0x1008129f},
+	{Name: "XF86Macro17", Value: 0x100812a0},
+	{Name: "XF86Macro18", Value: 0x100812a1},
+	{Name: "XF86Macro19", Value: 0x100812a2},
+	{Name: "XF86Macro20", Value: 0x100812a3},
+	{Name: "XF86Macro21", Value: 0x100812a4},
+	{Name: "XF86Macro22", Value: 0x100812a5},
+	{Name: "XF86Macro23", Value: 0x100812a6},
+	{Name: "XF86Macro24", Value: 0x100812a7},
+	{Name: "XF86Macro25", Value: 0x100812a8},
+	{Name: "XF86Macro26", Value: 0x100812a9},
+	{Name: "XF86Macro27", Value: 0x100812aa},
+	{Name: "XF86Macro28", Value: 0x100812ab},
+	{Name: "XF86Macro29", Value: 0x100812ac},
+	{Name: "XF86Macro30", Value: 0x100812ad},
+	{Name: "XF86MacroRecordStart", Value: 0x100812b0},
+	{Name: "XF86MacroRecordStop", Value: 0x100812b1},
+	{Name: "XF86MacroPresetCycle", Value: 0x100812b2},
+	{Name: "XF86MacroPreset1", Value: 0x100812b3},
+	{Name: "XF86MacroPreset2", Value: 0x100812b4},
+	{Name: "XF86MacroPreset3", Value: 0x100812b5},
+	{Name: "XF86KbdLcdMenu1", Value: 0x100812b8},
+	{Name: "XF86KbdLcdMenu2", Value: 0x100812b9},
+	{Name: "XF86KbdLcdMenu3", Value: 0x100812ba},
+	{Name: "XF86KbdLcdMenu4", Value: 0x100812bb},
+	{Name: "XF86KbdLcdMenu5", Value: 0x100812bc},
+}
@@ -17,6 +17,16 @@ func (k KeywordDefinition) DocumentationLink() string {
 	return fmt.Sprintf("https://wiki.hyprland.org/Configuring/%s/#%s", k.documentationFile, k.documentationHeadingSlug)
 }
 
+// DocumentationFile is the name of the wiki page that documents the keyword.
+func (k KeywordDefinition) DocumentationFile() string {
+	return k.documentationFile
+}
+
+// DocumentationHeadingSlug is the anchor of the section of DocumentationFile that documents the keyword.
+func (k KeywordDefinition) DocumentationHeadingSlug() string {
+	return k.documentationHeadingSlug
+}
+
 var Keywords = []KeywordDefinition{
 	{
 		Name:                     "submap",
@@ -94,7 +104,7 @@ var Keywords = []KeywordDefinition{
 		Name:                     "bind",
 		documentationHeadingSlug: "basic",
 		documentationFile:        "Binds",
-		Flags:                    []string{"r", "l", "e", "n", "m", "t", "i"},
+		Flags:                    []string{"l", "r", "c", "g", "o", "e", "n", "m", "t", "i", "s", "d", "p", "u"},
 	},
 	{
 		Name:                     "unbind",
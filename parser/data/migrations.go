@@ -0,0 +1,148 @@
+package parser_data
+
+import "slices"
+
+//go:generate go run ./generate migrations migrations_generated.go
+
+type MigrationKind int
+
+const (
+	// OptionRenamed options moved to another path, possibly with different values
+	OptionRenamed MigrationKind = iota
+	// OptionRemoved options have no equivalent anymore
+	OptionRemoved
+	// KeywordRenamed keywords can be renamed without changing their arguments
+	KeywordRenamed
+	// OptionAdded options didn't exist before the migration. They are only used to derive the schemas of older versions
+	OptionAdded
+	// WindowRuleV1Syntax is the windowrule = RULE, REGEX syntax, which became windowrule = RULE, class:REGEX
+	WindowRuleV1Syntax
+	// WindowRuleMatchSyntax replaced the PROP:REGEX matchers of window rules by match:PROP REGEX, and gave values to rules, such as float on
+	WindowRuleMatchSyntax
+)
+
+// Migration is a breaking change of the configuration introduced by a Hyprland release.
+type Migration struct {
+	Kind MigrationKind
+	// Version is the first Hyprland version with the change
+	Version string
+	// Option is the full path of the option, such as decoration:drop_shadow, or the keyword
+	Option string
+	// RenamedTo is the new path of the option or the new keyword
+	RenamedTo string
+	// Values maps values of the old option to values of the new one, when they differ
+	Values map[string]string
+	// Note explains what to do instead of a removed option
+	Note string
+	// Type and Default describe a removed option, for the schemas of the versions that still have it
+	Type    string
+	Default string
+}
+
+// Migrations lists breaking changes of the configuration, in release order: MigrationOverlay merged with the options
+// parser/data/generate found removed and added between the wiki snapshots of successive versions, see migrations_generated.go.
+var Migrations []Migration
+
+// MigrationOverlay lists the migrations that can't be derived from wiki snapshots: where renamed options and keywords went,
+// changes of syntax, and what to use instead of removed options.
+// Options removed or added in versions that have no snapshot yet are listed too, see just pull-wiki-versions.
+var MigrationOverlay = []Migration{
+	{
+		Kind:      OptionRenamed,
+		Version:   "0.41.0",
+		Option:    "master:new_is_master",
+		RenamedTo: "master:new_status",
+		Values: map[string]string{
+			"true": "master", "yes": "master", "on": "master", "1": "master",
+			"false": "slave", "no": "slave", "off": "slave", "0": "slave",
+		},
+	},
+	{Kind: OptionRenamed, Version: "0.45.0", Option: "decoration:drop_shadow", RenamedTo: "decoration:shadow:enabled"},
+	{Kind: OptionRenamed, Version: "0.45.0", Option: "decoration:shadow_range", RenamedTo: "decoration:shadow:range"},
+	{Kind: OptionRenamed, Version: "0.45.0", Option: "decoration:shadow_render_power", RenamedTo: "decoration:shadow:render_power"},
+	{Kind: OptionRenamed, Version: "0.45.0", Option: "decoration:shadow_ignore_window", RenamedTo: "decoration:shadow:ignore_window"},
+	{Kind: OptionRenamed, Version: "0.45.0", Option: "decoration:col.shadow", RenamedTo: "decoration:shadow:color"},
+	{Kind: OptionRenamed, Version: "0.45.0", Option: "decoration:col.shadow_inactive", RenamedTo: "decoration:shadow:color_inactive"},
+	{Kind: OptionRenamed, Version: "0.45.0", Option: "decoration:shadow_offset", RenamedTo: "decoration:shadow:offset"},
+	{Kind: OptionRenamed, Version: "0.45.0", Option: "decoration:shadow_scale", RenamedTo: "decoration:shadow:scale"},
+	{Kind: OptionAdded, Version: "0.45.0", Option: "decoration:shadow:sharp"},
+	{
+		Kind:    OptionRemoved,
+		Version: "0.45.0",
+		Option:  "dwindle:no_gaps_when_only",
+		Type:    "int",
+		Default: "0",
+		Note:    "use workspace rules instead, see https://wiki.hyprland.org/Configuring/Workspace-Rules/#smart-gaps",
+	},
+	{
+		Kind:    OptionRemoved,
+		Version: "0.45.0",
+		Option:  "master:no_gaps_when_only",
+		Type:    "int",
+		Default: "0",
+		Note:    "use workspace rules instead, see https://wiki.hyprland.org/Configuring/Workspace-Rules/#smart-gaps",
+	},
+	{Kind: WindowRuleV1Syntax, Version: "0.48.0", Option: "windowrule"},
+	{Kind: KeywordRenamed, Version: "0.48.0", Option: "windowrulev2", RenamedTo: "windowrule"},
+	{
+		Kind:    OptionRemoved,
+		Version: "0.51.0",
+		Option:  "gestures:workspace_swipe",
+		Type:    "bool",
+		Default: "false",
+		Note:    "use gesture = 3, horizontal, workspace instead, see https://wiki.hyprland.org/Configuring/Gestures/",
+	},
+	{
+		Kind:    OptionRemoved,
+		Version: "0.51.0",
+		Option:  "gestures:workspace_swipe_fingers",
+		Type:    "int",
+		Default: "3",
+		Note:    "set the number of fingers in gesture = FINGERS, horizontal, workspace instead",
+	},
+	{
+		Kind:    OptionRemoved,
+		Version: "0.51.0",
+		Option:  "gestures:workspace_swipe_min_fingers",
+		Type:    "bool",
+		Default: "false",
+		Note:    "set the number of fingers in gesture = FINGERS, horizontal, workspace instead",
+	},
+	{Kind: WindowRuleMatchSyntax, Version: "0.53.0", Option: "windowrule", Note: "see https://wiki.hyprland.org/Configuring/Window-Rules/"},
+}
+
+// MergeMigrations adds the overlay to the migrations derived from wiki snapshots, in release order.
+// Overlay notes are attached to the derived removals they describe, and renames replace the removal and addition they were derived as.
+func MergeMigrations(overlay, derived []Migration) []Migration {
+	merged := slices.Clone(derived)
+	for _, migration := range overlay {
+		switch migration.Kind {
+		case OptionRemoved, OptionAdded:
+			if i := slices.IndexFunc(merged, func(m Migration) bool { return m.Kind == migration.Kind && m.Option == migration.Option }); i >= 0 {
+				if migration.Note != "" {
+					merged[i].Note = migration.Note
+				}
+				continue
+			}
+		case OptionRenamed:
+			merged = slices.DeleteFunc(merged, func(m Migration) bool {
+				return (m.Kind == OptionRemoved && m.Option == migration.Option) || (m.Kind == OptionAdded && m.Option == migration.RenamedTo)
+			})
+		}
+		merged = append(merged, migration)
+	}
+	slices.SortStableFunc(merged, func(a, b Migration) int { return CompareVersions(a.Version, b.Version) })
+	return merged
+}
+
+// MigrationsBetween returns the migrations needed to go from a configuration written for Hyprland from to one written for Hyprland to.
+// from is excluded and to is included.
+func MigrationsBetween(from, to string) []Migration {
+	migrations := make([]Migration, 0)
+	for _, migration := range Migrations {
+		if CompareVersions(migration.Version, from) > 0 && CompareVersions(migration.Version, to) <= 0 {
+			migrations = append(migrations, migration)
+		}
+	}
+	return migrations
+}
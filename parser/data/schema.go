@@ -0,0 +1,74 @@
+package parser_data
+
+import (
+	"slices"
+	"strings"
+)
+
+//go:generate go run ./generate schemas schemas_generated.go
+
+// Schema is the documentation of the configuration of a Hyprland version.
+type Schema struct {
+	// Version is the first Hyprland version the schema documents
+	Version string
+	// Until is the first Hyprland version the schema doesn't document anymore, empty for the schema of HyprlandVersion
+	Until       string
+	Sections    []SectionDefinition
+	Keywords    []KeywordDefinition
+	Animations  []AnimationDefinition
+	Dispatchers []DispatcherDefinition
+}
+
+// documentedSchema is the schema of HyprlandVersion, that Sections, Keywords, Animations and Dispatchers document.
+var documentedSchema Schema
+
+// Documented returns the schema of HyprlandVersion.
+func Documented() Schema {
+	return documentedSchema
+}
+
+// SchemaFor returns the schema of a Hyprland version.
+// Versions older than HyprlandVersion get the schemas that parser/data/generate embedded in schemas_generated.go,
+// newer and invalid versions get the schema of HyprlandVersion.
+// Without a wiki snapshot of the version, its schema only differs from the documented one by the options Migrations list.
+func SchemaFor(version string) Schema {
+	if !ValidVersion(version) || len(olderSchemas) == 0 {
+		return documentedSchema
+	}
+	if CompareVersions(version, olderSchemas[0].Version) < 0 {
+		return olderSchemas[0]
+	}
+	for _, schema := range olderSchemas {
+		if CompareVersions(schema.Version, version) <= 0 && CompareVersions(version, schema.Until) < 0 {
+			return schema
+		}
+	}
+	return documentedSchema
+}
+
+func (s Schema) FindSectionDefinitionByName(name string) *SectionDefinition {
+	for _, sec := range s.Sections {
+		if sec.Name() == name || sec.JSONName() == name {
+			return &sec
+		}
+	}
+	return nil
+}
+
+// FindSectionDefinitionByPath finds the section at path, such as ["decoration", "blur"], ignoring case.
+func (s Schema) FindSectionDefinitionByPath(path []string) *SectionDefinition {
+	for _, sec := range s.Sections {
+		if slices.EqualFunc(sec.Path, path, strings.EqualFold) {
+			return &sec
+		}
+	}
+	return nil
+}
+
+func (s Schema) FindVariableDefinitionInSection(sectionName, variableName string) *VariableDefinition {
+	sec := s.FindSectionDefinitionByName(sectionName)
+	if sec == nil {
+		return nil
+	}
+	return sec.VariableDefinition(variableName)
+}
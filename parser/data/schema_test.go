@@ -0,0 +1,31 @@
+package parser_data
+
+import "testing"
+
+func TestSchemaFor(t *testing.T) {
+	if SchemaFor("0.55").Version != HyprlandVersion || SchemaFor("0.51.2").Version != HyprlandVersion || SchemaFor("not a version").Version != HyprlandVersion {
+		t.Error("expected the documented schema for versions since the last change and invalid versions")
+	}
+
+	schema := SchemaFor("v0.44")
+	if schema.Version != "0.41.0" || schema.Until != "0.45.0" {
+		t.Errorf("expected the schema of 0.41 to 0.45, got %s to %s", schema.Version, schema.Until)
+	}
+	if schema.FindVariableDefinitionInSection("decoration", "drop_shadow") == nil || schema.FindVariableDefinitionInSection("gestures", "workspace_swipe") == nil || schema.FindVariableDefinitionInSection("master", "no_gaps_when_only") == nil {
+		t.Error("expected options renamed or removed since 0.44 to be documented")
+	}
+	if schema.FindSectionDefinitionByPath([]string{"decoration", "shadow"}) != nil {
+		t.Error("decoration:shadow was added in 0.45")
+	}
+
+	schema = SchemaFor("0.30")
+	if newIsMaster := schema.FindVariableDefinitionInSection("master", "new_is_master"); newIsMaster == nil || newIsMaster.Type != "bool" {
+		t.Errorf("expected master:new_is_master to be a bool, got %v", newIsMaster)
+	}
+	if schema.FindVariableDefinitionInSection("master", "new_status") != nil {
+		t.Error("master:new_status was added in 0.41")
+	}
+	if len(schema.Keywords) == 0 || len(schema.Dispatchers) == 0 || FindVariableDefinitionInSection("decoration", "drop_shadow") != nil {
+		t.Error("expected older schemas to share keywords and dispatchers, and to leave the documented schema alone")
+	}
+}
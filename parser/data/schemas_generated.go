@@ -0,0 +1,1918 @@
+// Code generated by parser/data/generate; DO NOT EDIT.
+
+package parser_data
+
+var olderSchemas = []Schema{
+	{
+		Version: "0.40.0",
+		Until:   "0.41.0",
+		Sections: []SectionDefinition{
+			{
+				Path:                     []string{"General"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "general",
+				Variables: []VariableDefinition{
+					{Name: "border_size", Description: "size of the border around windows", Type: "int", Default: "1"},
+					{Name: "gaps_in", Description: "gaps between windows, also supports css style gaps (top, right, bottom, left -> 5,10,15,20)", Type: "int", Default: "5"},
+					{Name: "gaps_out", Description: "gaps between windows and monitor edges, also supports css style gaps (top, right, bottom, left -> 5,10,15,20)", Type: "int", Default: "20"},
+					{Name: "float_gaps", Description: "gaps between windows and monitor edges for floating windows, also supports css style gaps (top, right, bottom, left -> 5 10 15 20). -1 means default", Type: "int", Default: "0"},
+					{Name: "gaps_workspaces", Description: "gaps between workspaces. Stacks with gaps_out.", Type: "int", Default: "0"},
+					{Name: "col.inactive_border", Description: "border color for inactive windows", Type: "gradient", Default: "0xff444444"},
+					{Name: "col.active_border", Description: "border color for the active window", Type: "gradient", Default: "0xffffffff"},
+					{Name: "col.nogroup_border", Description: "inactive border color for window that cannot be added to a group (see denywindowfromgroup dispatcher)", Type: "gradient", Default: "0xffffaaff"},
+					{Name: "col.nogroup_border_active", Description: "active border color for window that cannot be added to a group", Type: "gradient", Default: "0xffff00ff"},
+					{Name: "layout", Description: "which layout to use. [dwindle/master/scrolling/monocle]", Type: "str", Default: "dwindle", AllowedValues: []AllowedValue{
+						{Value: "dwindle", Description: ""},
+						{Value: "master", Description: ""},
+						{Value: "scrolling", Description: ""},
+						{Value: "monocle", Description: ""},
+					}},
+					{Name: "no_focus_fallback", Description: "if true, will not fall back to the next available window when moving focus in a direction where no window was found", Type: "bool", Default: "false"},
+					{Name: "resize_on_border", Description: "enables resizing windows by clicking and dragging on borders and gaps", Type: "bool", Default: "false"},
+					{Name: "extend_border_grab_area", Description: "extends the area around the border where you can click and drag on, only used when general:resize_on_border is on.", Type: "int", Default: "15"},
+					{Name: "hover_icon_on_border", Description: "show a cursor icon when hovering over borders, only used when general:resize_on_border is on.", Type: "bool", Default: "true"},
+					{Name: "allow_tearing", Description: "master switch for allowing tearing to occur. See the Tearing page.", Type: "bool", Default: "false"},
+					{Name: "resize_corner", Description: "force floating windows to use a specific corner when being resized (1-4 going clockwise from top left, 0 to disable)", Type: "int", Default: "0"},
+					{Name: "modal_parent_blocking", Description: "whether parent windows of modals will be interactive", Type: "bool", Default: "true"},
+					{Name: "locale", Description: "overrides the system locale (e.g. en_US, es)", Type: "str", Default: "[[Empty]]"},
+					{Name: "autogenerated", Description: "Whether this configuration was autogenerated", Type: "bool", Default: "1"},
+				},
+			},
+			{
+				Path:                     []string{"General", "Snap"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "snap",
+				Variables: []VariableDefinition{
+					{Name: "enabled", Description: "enable snapping for floating windows", Type: "bool", Default: "false"},
+					{Name: "window_gap", Description: "minimum gap in pixels between windows before snapping", Type: "int", Default: "10"},
+					{Name: "monitor_gap", Description: "minimum gap in pixels between window and monitor edges before snapping", Type: "int", Default: "10"},
+					{Name: "border_overlap", Description: "if true, windows snap such that only one border's worth of space is between them", Type: "bool", Default: "false"},
+					{Name: "respect_gaps", Description: "if true, snapping will respect gaps between windows(set in general:gaps_in)", Type: "bool", Default: "false"},
+				},
+			},
+			{
+				Path:                     []string{"Decoration"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "decoration",
+				Variables: []VariableDefinition{
+					{Name: "rounding", Description: "rounded corners' radius (in layout px)", Type: "int", Default: "0"},
+					{Name: "rounding_power", Description: "adjusts the curve used for rounding corners, larger is smoother, 2.0 is a circle, 4.0 is a squircle, 1.0 is a triangular corner. [1.0 - 10.0]", Type: "float", Default: "2.0", Bounds: &ValueBounds{Min: 1, Max: 10}},
+					{Name: "active_opacity", Description: "opacity of active windows. [0.0 - 1.0]", Type: "float", Default: "1.0", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "inactive_opacity", Description: "opacity of inactive windows. [0.0 - 1.0]", Type: "float", Default: "1.0", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "fullscreen_opacity", Description: "opacity of fullscreen windows. [0.0 - 1.0]", Type: "float", Default: "1.0", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "dim_modal", Description: "enables dimming of parents of modal windows", Type: "bool", Default: "true"},
+					{Name: "dim_inactive", Description: "enables dimming of inactive windows", Type: "bool", Default: "false"},
+					{Name: "dim_strength", Description: "how much inactive windows should be dimmed [0.0 - 1.0]", Type: "float", Default: "0.5", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "dim_special", Description: "how much to dim the rest of the screen by when a special workspace is open. [0.0 - 1.0]", Type: "float", Default: "0.2", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "dim_around", Description: "how much the dim_around window rule should dim by. [0.0 - 1.0]", Type: "float", Default: "0.4", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "screen_shader", Description: "a path to a custom shader to be applied at the end of rendering. See examples/screenShader.frag for an example.", Type: "str", Default: "[[Empty]]"},
+					{Name: "border_part_of_window", Description: "whether the window border should be a part of the window", Type: "bool", Default: "true"},
+					{Name: "shadow_scale", Description: "shadow's scale. [0.0 - 1.0]", Type: "float", Default: "1.0", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "shadow_offset", Description: "shadow's rendering offset.", Type: "vec2", Default: "[0, 0]"},
+					{Name: "col.shadow_inactive", Description: "inactive shadow color. (if not set, will fall back to color)", Type: "color", Default: "unset"},
+					{Name: "col.shadow", Description: "shadow's color. Alpha dictates shadow's opacity.", Type: "color", Default: "0xee1a1a1a"},
+					{Name: "shadow_ignore_window", Description: "if true, the shadow will not be rendered behind the window itself, only around it.", Type: "bool", Default: "true"},
+					{Name: "shadow_render_power", Description: "in what power to render the falloff (more power, the faster the falloff) [1 - 4]", Type: "int", Default: "3", Bounds: &ValueBounds{Min: 1, Max: 4}},
+					{Name: "shadow_range", Description: "Shadow range (\"size\") in layout px", Type: "int", Default: "4"},
+					{Name: "drop_shadow", Description: "enable drop shadows on windows", Type: "bool", Default: "true"},
+				},
+			},
+			{
+				Path:                     []string{"Decoration", "Blur"},
+				Description:              "`blur:size` and `blur:passes` have to be at least 1.",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "blur",
+				Variables: []VariableDefinition{
+					{Name: "enabled", Description: "enable kawase window background blur", Type: "bool", Default: "true"},
+					{Name: "size", Description: "blur size (distance)", Type: "int", Default: "8"},
+					{Name: "passes", Description: "the amount of passes to perform", Type: "int", Default: "1"},
+					{Name: "ignore_opacity", Description: "make the blur layer ignore the opacity of the window", Type: "bool", Default: "true"},
+					{Name: "new_optimizations", Description: "whether to enable further optimizations to the blur. Recommended to leave on, as it will massively improve performance.", Type: "bool", Default: "true"},
+					{Name: "xray", Description: "if enabled, floating windows will ignore tiled windows in their blur. Only available if new_optimizations is true. Will reduce overhead on floating blur significantly.", Type: "bool", Default: "false"},
+					{Name: "noise", Description: "how much noise to apply. [0.0 - 1.0]", Type: "float", Default: "0.0117", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "contrast", Description: "contrast modulation for blur. [0.0 - 2.0]", Type: "float", Default: "0.8916", Bounds: &ValueBounds{Min: 0, Max: 2}},
+					{Name: "brightness", Description: "brightness modulation for blur. [0.0 - 2.0]", Type: "float", Default: "0.8172", Bounds: &ValueBounds{Min: 0, Max: 2}},
+					{Name: "vibrancy", Description: "Increase saturation of blurred colors. [0.0 - 1.0]", Type: "float", Default: "0.1696", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "vibrancy_darkness", Description: "How strong the effect of vibrancy is on dark areas . [0.0 - 1.0]", Type: "float", Default: "0.0", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "special", Description: "whether to blur behind the special workspace (note: expensive)", Type: "bool", Default: "false"},
+					{Name: "popups", Description: "whether to blur popups (e.g. right-click menus)", Type: "bool", Default: "false"},
+					{Name: "popups_ignorealpha", Description: "works like ignore_alpha in layer rules. If pixel opacity is below set value, will not blur. [0.0 - 1.0]", Type: "float", Default: "0.2", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "input_methods", Description: "whether to blur input methods (e.g. fcitx5)", Type: "bool", Default: "false"},
+					{Name: "input_methods_ignorealpha", Description: "works like ignore_alpha in layer rules. If pixel opacity is below set value, will not blur. [0.0 - 1.0]", Type: "float", Default: "0.2", Bounds: &ValueBounds{Min: 0, Max: 1}},
+				},
+			},
+			{
+				Path:                     []string{"Animations"},
+				Description:              "_[More about Animations](https://wiki.hyprland.org/Configuring/Animations)._",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "animations",
+				Variables: []VariableDefinition{
+					{Name: "enabled", Description: "enable animations", Type: "bool", Default: "true"},
+					{Name: "workspace_wraparound", Description: "enable workspace wraparound, causing directional workspace animations to animate as if the first and last workspaces were adjacent", Type: "bool", Default: "false"},
+				},
+			},
+			{
+				Path:                     []string{"Input"},
+				Description:              "You can find a list of models, layouts, variants and options in [`/usr/share/X11/xkb/rules/evdev.lst`](). Alternatively, you can use the `localectl` command to discover what is available on your system.",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "input",
+				Variables: []VariableDefinition{
+					{Name: "kb_model", Description: "Appropriate XKB keymap parameter. See the note below.", Type: "str", Default: "[[Empty]]"},
+					{Name: "kb_layout", Description: "Appropriate XKB keymap parameter", Type: "str", Default: "us"},
+					{Name: "kb_variant", Description: "Appropriate XKB keymap parameter", Type: "str", Default: "[[Empty]]"},
+					{Name: "kb_options", Description: "Appropriate XKB keymap parameter", Type: "str", Default: "[[Empty]]"},
+					{Name: "kb_rules", Description: "Appropriate XKB keymap parameter", Type: "str", Default: "[[Empty]]"},
+					{Name: "kb_file", Description: "If you prefer, you can use a path to your custom .xkb file.", Type: "str", Default: "[[Empty]]"},
+					{Name: "numlock_by_default", Description: "Engage numlock by default.", Type: "bool", Default: "false"},
+					{Name: "resolve_binds_by_sym", Description: "Determines how keybinds act when multiple layouts are used. If false, keybinds will always act as if the first specified layout is active. If true, keybinds specified by symbols are activated when you type the respective symbol with the current layout.", Type: "bool", Default: "false"},
+					{Name: "repeat_rate", Description: "The repeat rate for held-down keys, in repeats per second.", Type: "int", Default: "25"},
+					{Name: "repeat_delay", Description: "Delay before a held-down key is repeated, in milliseconds.", Type: "int", Default: "600"},
+					{Name: "sensitivity", Description: "Sets the mouse input sensitivity. Value is clamped to the range -1.0 to 1.0. libinput#pointer-acceleration", Type: "float", Default: "0.0"},
+					{Name: "accel_profile", Description: "Sets the cursor acceleration profile. Can be one of adaptive, flat. Can also be custom, see below. Leave empty to use libinput's default mode for your input device. libinput#pointer-acceleration [adaptive/flat/custom]", Type: "str", Default: "[[Empty]]", AllowedValues: []AllowedValue{
+						{Value: "adaptive", Description: ""},
+						{Value: "flat", Description: ""},
+						{Value: "custom", Description: "", Parameters: "<step> <points...>"},
+					}},
+					{Name: "force_no_accel", Description: "Force no cursor acceleration. This bypasses most of your pointer settings to get as raw of a signal as possible. Enabling this is not recommended due to potential cursor desynchronization.", Type: "bool", Default: "false"},
+					{Name: "rotation", Description: "Sets the rotation of a device in degrees clockwise off the logical neutral position. Value is clamped to the range 0 to 359.", Type: "int", Default: "0"},
+					{Name: "left_handed", Description: "Switches RMB and LMB", Type: "bool", Default: "false"},
+					{Name: "scroll_points", Description: "Sets the scroll acceleration profile, when accel_profile is set to custom. Has to be in the form <step> <points>. Leave empty to have a flat scroll curve.", Type: "str", Default: "[[Empty]]"},
+					{Name: "scroll_method", Description: "Sets the scroll method. Can be one of 2fg (2 fingers), edge, on_button_down, no_scroll. libinput#scrolling [2fg/edge/on_button_down/no_scroll]", Type: "str", Default: "[[Empty]]", AllowedValues: []AllowedValue{
+						{Value: "2fg", Description: ""},
+						{Value: "edge", Description: ""},
+						{Value: "on_button_down", Description: ""},
+						{Value: "no_scroll", Description: ""},
+					}},
+					{Name: "scroll_button", Description: "Sets the scroll button. Has to be an int, cannot be a string. Check wev if you have any doubts regarding the ID. 0 means default.", Type: "int", Default: "0"},
+					{Name: "scroll_button_lock", Description: "If the scroll button lock is enabled, the button does not need to be held down. Pressing and releasing the button toggles the button lock, which logically holds the button down or releases it. While the button is logically held down, motion events are converted to scroll events.", Type: "bool", Default: "false"},
+					{Name: "scroll_factor", Description: "Multiplier added to scroll movement for external mice. Note that there is a separate setting for touchpad scroll_factor.", Type: "float", Default: "1.0"},
+					{Name: "natural_scroll", Description: "Inverts scrolling direction. When enabled, scrolling moves content directly, rather than manipulating a scrollbar.", Type: "bool", Default: "false"},
+					{Name: "follow_mouse", Description: "Specify if and how cursor movement should affect window focus. See the note below. [0/1/2/3]", Type: "int", Default: "1", AllowedValues: []AllowedValue{
+						{Value: "0", Description: ""},
+						{Value: "1", Description: ""},
+						{Value: "2", Description: ""},
+						{Value: "3", Description: ""},
+					}},
+					{Name: "follow_mouse_threshold", Description: "The smallest distance in logical pixels the mouse needs to travel for the window under it to get focused. Works only with follow_mouse = 1.", Type: "float", Default: "0.0"},
+					{Name: "focus_on_close", Description: "Controls the window focus behavior when a window is closed. When set to 0, focus will shift to the next window candidate. When set to 1, focus will shift to the window under the cursor. [0/1]", Type: "int", Default: "0", AllowedValues: []AllowedValue{
+						{Value: "0", Description: ""},
+						{Value: "1", Description: ""},
+					}},
+					{Name: "mouse_refocus", Description: "If disabled, mouse focus won't switch to the hovered window unless the mouse crosses a window boundary when follow_mouse=1.", Type: "bool", Default: "true"},
+					{Name: "float_switch_override_focus", Description: "If enabled (1 or 2), focus will change to the window under the cursor when changing from tiled-to-floating and vice versa. If 2, focus will also follow mouse on float-to-float switches.", Type: "int", Default: "1"},
+					{Name: "special_fallthrough", Description: "if enabled, having only floating windows in the special workspace will not block focusing windows in the regular workspace.", Type: "bool", Default: "false"},
+					{Name: "off_window_axis_events", Description: "Handles axis events around (gaps/border for tiled, dragarea/border for floated) a focused window. 0 ignores axis events 1 sends out-of-bound coordinates 2 fakes pointer coordinates to the closest point inside the window 3 warps the cursor to the closest point inside the window", Type: "int", Default: "1"},
+					{Name: "emulate_discrete_scroll", Description: "Emulates discrete scrolling from high resolution scrolling events. 0 disables it, 1 enables handling of non-standard events only, and 2 force enables all scroll wheel events to be handled", Type: "int", Default: "1"},
+				},
+			},
+			{
+				Path:                     []string{"Input", "Touchpad"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "touchpad",
+				Variables: []VariableDefinition{
+					{Name: "disable_while_typing", Description: "Disable the touchpad while typing.", Type: "bool", Default: "true"},
+					{Name: "natural_scroll", Description: "Inverts scrolling direction. When enabled, scrolling moves content directly, rather than manipulating a scrollbar.", Type: "bool", Default: "false"},
+					{Name: "scroll_factor", Description: "Multiplier applied to the amount of scroll movement.", Type: "float", Default: "1.0"},
+					{Name: "middle_button_emulation", Description: "Sending LMB and RMB simultaneously will be interpreted as a middle click. This disables any touchpad area that would normally send a middle click based on location. libinput#middle-button-emulation", Type: "bool", Default: "false"},
+					{Name: "tap_button_map", Description: "Sets the tap button mapping for touchpad button emulation. Can be one of lrm (default) or lmr (Left, Middle, Right Buttons). [lrm/lmr]", Type: "str", Default: "[[Empty]]", AllowedValues: []AllowedValue{
+						{Value: "lrm", Description: ""},
+						{Value: "lmr", Description: ""},
+					}},
+					{Name: "clickfinger_behavior", Description: "Button presses with 1, 2, or 3 fingers will be mapped to LMB, RMB, and MMB respectively. This disables interpretation of clicks based on location on the touchpad. libinput#clickfinger-behavior", Type: "bool", Default: "false"},
+					{Name: "tap-to-click", Description: "Tapping on the touchpad with 1, 2, or 3 fingers will send LMB, RMB, and MMB respectively.", Type: "bool", Default: "true"},
+					{Name: "drag_lock", Description: "When enabled, lifting the finger off while dragging will not drop the dragged item. 0 -> disabled, 1 -> enabled with timeout, 2 -> enabled sticky. libinput#tap-and-drag", Type: "int", Default: "0", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "disabled"},
+						{Value: "1", Description: "enabled with timeout"},
+						{Value: "2", Description: "enabled sticky"},
+					}},
+					{Name: "tap-and-drag", Description: "Sets the tap and drag mode for the touchpad", Type: "bool", Default: "true"},
+					{Name: "flip_x", Description: "inverts the horizontal movement of the touchpad", Type: "bool", Default: "false"},
+					{Name: "flip_y", Description: "inverts the vertical movement of the touchpad", Type: "bool", Default: "false"},
+					{Name: "drag_3fg", Description: "enables three finger drag, 0 -> disabled, 1 -> 3 fingers, 2 -> 4 fingers libinput#drag-3fg", Type: "int", Default: "0", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "disabled"},
+						{Value: "1", Description: "3 fingers"},
+						{Value: "2", Description: "4 fingers"},
+					}},
+				},
+			},
+			{
+				Path:                     []string{"Input", "Touchdevice"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "touchdevice",
+				Variables: []VariableDefinition{
+					{Name: "transform", Description: "Transform the input from touchdevices. The possible transformations are the same as those of the monitors. -1 means it's unset.", Type: "int", Default: "-1"},
+					{Name: "output", Description: "The monitor to bind touch devices. The default is auto-detection. To stop auto-detection, use an empty string or the \"[[Empty]]\" value.", Type: "string", Default: "[[Auto]]"},
+					{Name: "enabled", Description: "Whether input is enabled for touch devices.", Type: "bool", Default: "true"},
+				},
+			},
+			{
+				Path:                     []string{"Input", "Virtualkeyboard"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "virtualkeyboard",
+				Variables: []VariableDefinition{
+					{Name: "share_states", Description: "Unify key down states and modifier states with other keyboards. 0 -> no, 1 -> yes, 2 -> yes unless IME client", Type: "int", Default: "2", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "no"},
+						{Value: "1", Description: "yes"},
+						{Value: "2", Description: "yes unless IME client"},
+					}},
+					{Name: "release_pressed_on_close", Description: "Release all pressed keys by virtual keyboard on close.", Type: "bool", Default: "false"},
+				},
+			},
+			{
+				Path:                     []string{"Input", "Tablet"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "tablet",
+				Variables: []VariableDefinition{
+					{Name: "transform", Description: "transform the input from tablets. The possible transformations are the same as those of the monitors. -1 means it's unset.", Type: "int", Default: "-1"},
+					{Name: "output", Description: "the monitor to bind tablets. Can be current or a monitor name. Leave empty to map across all monitors.", Type: "string", Default: "[[Empty]]"},
+					{Name: "region_position", Description: "position of the mapped region in monitor layout relative to the top left corner of the bound monitor or all monitors.", Type: "vec2", Default: "[0, 0]"},
+					{Name: "absolute_region_position", Description: "whether to treat the region_position as an absolute position in monitor layout. Only applies when output is empty.", Type: "bool", Default: "false"},
+					{Name: "region_size", Description: "size of the mapped region. When this variable is set, tablet input will be mapped to the region. [0, 0] or invalid size means unset.", Type: "vec2", Default: "[0, 0]"},
+					{Name: "relative_input", Description: "whether the input should be relative", Type: "bool", Default: "false"},
+					{Name: "left_handed", Description: "if enabled, the tablet will be rotated 180 degrees", Type: "bool", Default: "false"},
+					{Name: "active_area_size", Description: "size of tablet's active area in mm", Type: "vec2", Default: "[0, 0]"},
+					{Name: "active_area_position", Description: "position of the active area in mm", Type: "vec2", Default: "[0, 0]"},
+				},
+			},
+			{
+				Path:                     []string{"Gestures"},
+				Description:              "`workspace_swipe`, `workspace_swipe_fingers` and `workspace_swipe_min_fingers` were removed in favor of the new gestures system.",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "gestures",
+				Variables: []VariableDefinition{
+					{Name: "workspace_swipe_distance", Description: "in px, the distance of the touchpad gesture", Type: "int", Default: "300"},
+					{Name: "workspace_swipe_touch", Description: "enable workspace swiping from the edge of a touchscreen", Type: "bool", Default: "false"},
+					{Name: "workspace_swipe_invert", Description: "invert the direction (touchpad only)", Type: "bool", Default: "true"},
+					{Name: "workspace_swipe_touch_invert", Description: "invert the direction (touchscreen only)", Type: "bool", Default: "false"},
+					{Name: "workspace_swipe_min_speed_to_force", Description: "minimum speed in px per timepoint to force the change ignoring cancel_ratio. Setting to 0 will disable this mechanic.", Type: "int", Default: "30"},
+					{Name: "workspace_swipe_cancel_ratio", Description: "how much the swipe has to proceed in order to commence it. (0.7 -> if > 0.7 * distance, switch, if less, revert) [0.0 - 1.0]", Type: "float", Default: "0.5", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "workspace_swipe_create_new", Description: "whether a swipe right on the last workspace should create a new one.", Type: "bool", Default: "true"},
+					{Name: "workspace_swipe_direction_lock", Description: "if enabled, switching direction will be locked when you swipe past the direction_lock_threshold (touchpad only).", Type: "bool", Default: "true"},
+					{Name: "workspace_swipe_direction_lock_threshold", Description: "in px, the distance to swipe before direction lock activates (touchpad only).", Type: "int", Default: "10"},
+					{Name: "workspace_swipe_forever", Description: "if enabled, swiping will not clamp at the neighboring workspaces but continue to the further ones.", Type: "bool", Default: "false"},
+					{Name: "workspace_swipe_use_r", Description: "if enabled, swiping will use the r prefix instead of the m prefix for finding workspaces.", Type: "bool", Default: "false"},
+					{Name: "close_max_timeout", Description: "the timeout for a window to close when using a 1:1 gesture, in ms", Type: "int", Default: "1000"},
+					{Name: "workspace_swipe_min_fingers", Description: "Removed in Hyprland 0.51.0, set the number of fingers in gesture = FINGERS, horizontal, workspace instead", Type: "bool", Default: "false"},
+					{Name: "workspace_swipe_fingers", Description: "Removed in Hyprland 0.51.0, set the number of fingers in gesture = FINGERS, horizontal, workspace instead", Type: "int", Default: "3"},
+					{Name: "workspace_swipe", Description: "Removed in Hyprland 0.51.0, use gesture = 3, horizontal, workspace instead, see https://wiki.hyprland.org/Configuring/Gestures/", Type: "bool", Default: "false"},
+				},
+			},
+			{
+				Path:                     []string{"Group"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "group",
+				Variables: []VariableDefinition{
+					{Name: "auto_group", Description: "whether new windows will be automatically grouped into the focused unlocked group. Note: if you want to disable auto_group only for specific windows, use the \"group barred\" window rule instead.", Type: "bool", Default: "true"},
+					{Name: "insert_after_current", Description: "whether new windows in a group spawn after current or at group tail", Type: "bool", Default: "true"},
+					{Name: "focus_removed_window", Description: "whether Hyprland should focus on the window that has just been moved out of the group", Type: "bool", Default: "true"},
+					{Name: "drag_into_group", Description: "whether dragging a window into a unlocked group will merge them. Options: 0 (disabled), 1 (enabled), 2 (only when dragging into the groupbar)", Type: "int", Default: "1", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "disabled"},
+						{Value: "1", Description: "enabled"},
+						{Value: "2", Description: "only when dragging into the groupbar"},
+					}},
+					{Name: "merge_groups_on_drag", Description: "whether window groups can be dragged into other groups", Type: "bool", Default: "true"},
+					{Name: "merge_groups_on_groupbar", Description: "whether one group will be merged with another when dragged into its groupbar", Type: "bool", Default: "true"},
+					{Name: "merge_floated_into_tiled_on_groupbar", Description: "whether dragging a floating window into a tiled window groupbar will merge them", Type: "bool", Default: "false"},
+					{Name: "group_on_movetoworkspace", Description: "whether using movetoworkspace[silent] will merge the window into the workspace's solitary unlocked group", Type: "bool", Default: "false"},
+					{Name: "col.border_active", Description: "active group border color", Type: "gradient", Default: "0x66ffff00"},
+					{Name: "col.border_inactive", Description: "inactive (out of focus) group border color", Type: "gradient", Default: "0x66777700"},
+					{Name: "col.border_locked_active", Description: "active locked group border color", Type: "gradient", Default: "0x66ff5500"},
+					{Name: "col.border_locked_inactive", Description: "inactive locked group border color", Type: "gradient", Default: "0x66775500"},
+				},
+			},
+			{
+				Path:                     []string{"Group", "Groupbar"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "groupbar",
+				Variables: []VariableDefinition{
+					{Name: "enabled", Description: "enables groupbars", Type: "bool", Default: "true"},
+					{Name: "font_family", Description: "font used to display groupbar titles, use misc:font_family if not specified", Type: "string", Default: "[[Empty]]"},
+					{Name: "font_size", Description: "font size of groupbar title", Type: "int", Default: "8"},
+					{Name: "font_weight_active", Description: "font weight of active groupbar title", Type: "font_weight", Default: "normal"},
+					{Name: "font_weight_inactive", Description: "font weight of inactive groupbar title", Type: "font_weight", Default: "normal"},
+					{Name: "gradients", Description: "enables gradients", Type: "bool", Default: "false"},
+					{Name: "height", Description: "height of the groupbar", Type: "int", Default: "14"},
+					{Name: "indicator_gap", Description: "height of gap between groupbar indicator and title", Type: "int", Default: "0"},
+					{Name: "indicator_height", Description: "height of the groupbar indicator", Type: "int", Default: "3"},
+					{Name: "stacked", Description: "render the groupbar as a vertical stack", Type: "bool", Default: "false"},
+					{Name: "priority", Description: "sets the decoration priority for groupbars", Type: "int", Default: "3"},
+					{Name: "render_titles", Description: "whether to render titles in the group bar decoration", Type: "bool", Default: "true"},
+					{Name: "text_offset", Description: "adjust vertical position for titles", Type: "int", Default: "0"},
+					{Name: "text_padding", Description: "set horizontal padding for titles", Type: "int", Default: "0"},
+					{Name: "scrolling", Description: "whether scrolling in the groupbar changes group active window", Type: "bool", Default: "true"},
+					{Name: "rounding", Description: "how much to round the indicator", Type: "int", Default: "1"},
+					{Name: "rounding_power", Description: "adjusts the curve used for rounding groupbar corners, larger is smoother, 2.0 is a circle, 4.0 is a squircle, 1.0 is a triangular corner. [1.0 - 10.0]", Type: "float", Default: "2.0", Bounds: &ValueBounds{Min: 1, Max: 10}},
+					{Name: "gradient_rounding", Description: "how much to round the gradients", Type: "int", Default: "2"},
+					{Name: "gradient_rounding_power", Description: "adjusts the curve used for rounding gradient corners, larger is smoother, 2.0 is a circle, 4.0 is a squircle, 1.0 is a triangular corner. [1.0 - 10.0]", Type: "float", Default: "2.0", Bounds: &ValueBounds{Min: 1, Max: 10}},
+					{Name: "round_only_edges", Description: "round only the indicator edges of the entire groupbar", Type: "bool", Default: "true"},
+					{Name: "gradient_round_only_edges", Description: "round only the gradient edges of the entire groupbar", Type: "bool", Default: "true"},
+					{Name: "text_color", Description: "color for window titles in the groupbar", Type: "color", Default: "0xffffffff"},
+					{Name: "text_color_inactive", Description: "color for inactive windows' titles in the groupbar (if unset, defaults to text_color)", Type: "color", Default: "unset"},
+					{Name: "text_color_locked_active", Description: "color for the active window's title in a locked group (if unset, defaults to text_color)", Type: "color", Default: "unset"},
+					{Name: "text_color_locked_inactive", Description: "color for inactive windows' titles in locked groups (if unset, defaults to text_color_inactive)", Type: "color", Default: "unset"},
+					{Name: "col.active", Description: "active group bar background color", Type: "gradient", Default: "0x66ffff00"},
+					{Name: "col.inactive", Description: "inactive (out of focus) group bar background color", Type: "gradient", Default: "0x66777700"},
+					{Name: "col.locked_active", Description: "active locked group bar background color", Type: "gradient", Default: "0x66ff5500"},
+					{Name: "col.locked_inactive", Description: "inactive locked group bar background color", Type: "gradient", Default: "0x66775500"},
+					{Name: "gaps_in", Description: "gap size between gradients", Type: "int", Default: "2"},
+					{Name: "gaps_out", Description: "gap size between gradients and window", Type: "int", Default: "2"},
+					{Name: "keep_upper_gap", Description: "add or remove upper gap", Type: "bool", Default: "true"},
+					{Name: "blur", Description: "applies blur to the groupbar indicators and gradients", Type: "bool", Default: "false"},
+				},
+			},
+			{
+				Path:                     []string{"Misc"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "misc",
+				Variables: []VariableDefinition{
+					{Name: "disable_hyprland_logo", Description: "disables the random Hyprland logo / anime girl background. :(", Type: "bool", Default: "false"},
+					{Name: "disable_splash_rendering", Description: "disables the Hyprland splash rendering. (requires a monitor reload to take effect)", Type: "bool", Default: "false"},
+					{Name: "disable_scale_notification", Description: "disables notification popup when a monitor fails to set a suitable scale", Type: "bool", Default: "false"},
+					{Name: "col.splash", Description: "Changes the color of the splash text (requires a monitor reload to take effect).", Type: "color", Default: "0xffffffff"},
+					{Name: "font_family", Description: "Set the global default font to render the text including debug fps/notification, config error messages and etc., selected from system fonts.", Type: "string", Default: "Sans"},
+					{Name: "splash_font_family", Description: "Changes the font used to render the splash text, selected from system fonts (requires a monitor reload to take effect).", Type: "string", Default: "[[Empty]]"},
+					{Name: "force_default_wallpaper", Description: "Enforce any of the 3 default wallpapers. Setting this to 0 or 1 disables the anime background. -1 means \"random\". [-1/0/1/2]", Type: "int", Default: "-1", AllowedValues: []AllowedValue{
+						{Value: "-1", Description: ""},
+						{Value: "0", Description: ""},
+						{Value: "1", Description: ""},
+						{Value: "2", Description: ""},
+					}},
+					{Name: "vfr", Description: "controls the VFR status of Hyprland. Heavily recommended to leave enabled to conserve resources.", Type: "bool", Default: "true"},
+					{Name: "vrr", Description: "controls the VRR (Adaptive Sync) of your monitors. 0 - off, 1 - on, 2 - fullscreen only, 3 - fullscreen with video or game content type [0/1/2/3]", Type: "int", Default: "0", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "off"},
+						{Value: "1", Description: "on"},
+						{Value: "2", Description: "fullscreen only"},
+						{Value: "3", Description: "fullscreen with video or game content type"},
+					}},
+					{Name: "mouse_move_enables_dpms", Description: "If DPMS is set to off, wake up the monitors if the mouse moves.", Type: "bool", Default: "false"},
+					{Name: "key_press_enables_dpms", Description: "If DPMS is set to off, wake up the monitors if a key is pressed.", Type: "bool", Default: "false"},
+					{Name: "name_vk_after_proc", Description: "Name virtual keyboards after the processes that create them. E.g. /usr/bin/fcitx5 will have hl-virtual-keyboard-fcitx5.", Type: "bool", Default: "true"},
+					{Name: "always_follow_on_dnd", Description: "Will make mouse focus follow the mouse when drag and dropping. Recommended to leave it enabled, especially for people using focus follows mouse at 0.", Type: "bool", Default: "true"},
+					{Name: "layers_hog_keyboard_focus", Description: "If true, will make keyboard-interactive layers keep their focus on mouse move (e.g. wofi, bemenu)", Type: "bool", Default: "true"},
+					{Name: "animate_manual_resizes", Description: "If true, will animate manual window resizes/moves", Type: "bool", Default: "false"},
+					{Name: "animate_mouse_windowdragging", Description: "If true, will animate windows being dragged by mouse, note that this can cause weird behavior on some curves", Type: "bool", Default: "false"},
+					{Name: "disable_autoreload", Description: "If true, the config will not reload automatically on save, and instead needs to be reloaded with hyprctl reload. Might save on battery.", Type: "bool", Default: "false"},
+					{Name: "enable_swallow", Description: "Enable window swallowing", Type: "bool", Default: "false"},
+					{Name: "swallow_regex", Description: "The class regex to be used for windows that should be swallowed (usually, a terminal). To know more about the list of regex which can be used use this cheatsheet.", Type: "str", Default: "[[Empty]]"},
+					{Name: "swallow_exception_regex", Description: "The title regex to be used for windows that should not be swallowed by the windows specified in swallow_regex  (e.g. wev). The regex is matched against the parent (e.g. Kitty) window's title on the assumption that it changes to whatever process it's running.", Type: "str", Default: "[[Empty]]"},
+					{Name: "focus_on_activate", Description: "Whether Hyprland should focus an app that requests to be focused (an activate request)", Type: "bool", Default: "false"},
+					{Name: "mouse_move_focuses_monitor", Description: "Whether mouse moving into a different monitor should focus it", Type: "bool", Default: "true"},
+					{Name: "allow_session_lock_restore", Description: "if true, will allow you to restart a lockscreen app in case it crashes", Type: "bool", Default: "false"},
+					{Name: "session_lock_xray", Description: "if true, keep rendering workspaces below your lockscreen", Type: "bool", Default: "false"},
+					{Name: "background_color", Description: "change the background color. (requires enabled disable_hyprland_logo)", Type: "color", Default: "0x111111"},
+					{Name: "close_special_on_empty", Description: "close the special workspace if the last window is removed", Type: "bool", Default: "true"},
+					{Name: "on_focus_under_fullscreen", Description: "if there is a fullscreen or maximized window, decide whether a tiled window requested to focus should replace it, stay behind or disable the fullscreen/maximized state. 0 - ignore focus request (keep focus on fullscreen window), 1 - takes over, 2 - unfullscreen/unmaximize [0/1/2]", Type: "int", Default: "2", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "ignore focus request (keep focus on fullscreen window)"},
+						{Value: "1", Description: "takes over"},
+						{Value: "2", Description: "unfullscreen/unmaximize"},
+					}},
+					{Name: "exit_window_retains_fullscreen", Description: "if true, closing a fullscreen window makes the next focused window fullscreen", Type: "bool", Default: "false"},
+					{Name: "initial_workspace_tracking", Description: "if enabled, windows will open on the workspace they were invoked on. 0 - disabled, 1 - single-shot, 2 - persistent (all children too)", Type: "int", Default: "1", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "disabled"},
+						{Value: "1", Description: "single-shot"},
+						{Value: "2", Description: "persistent (all children too)"},
+					}},
+					{Name: "middle_click_paste", Description: "whether to enable middle-click-paste (aka primary selection)", Type: "bool", Default: "true"},
+					{Name: "render_unfocused_fps", Description: "the maximum limit for render_unfocused windows' fps in the background (see also Window-Rules - render_unfocused)", Type: "int", Default: "15"},
+					{Name: "disable_xdg_env_checks", Description: "disable the warning if XDG environment is externally managed", Type: "bool", Default: "false"},
+					{Name: "disable_hyprland_qtutils_check", Description: "disable the warning if hyprland-qtutils is not installed", Type: "bool", Default: "false"},
+					{Name: "lockdead_screen_delay", Description: "delay after which the \"lockdead\" screen will appear in case a lockscreen app fails to cover all the outputs (5 seconds max)", Type: "int", Default: "1000"},
+					{Name: "enable_anr_dialog", Description: "whether to enable the ANR (app not responding) dialog when your apps hang", Type: "bool", Default: "true"},
+					{Name: "anr_missed_pings", Description: "number of missed pings before showing the ANR dialog", Type: "int", Default: "5"},
+					{Name: "size_limits_tiled", Description: "whether to apply min_size and max_size rules to tiled windows", Type: "bool", Default: "false"},
+					{Name: "disable_watchdog_warning", Description: "whether to disable the warning about not using start-hyprland", Type: "bool", Default: "false"},
+				},
+			},
+			{
+				Path:                     []string{"Layout"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "layout",
+				Variables: []VariableDefinition{
+					{Name: "single_window_aspect_ratio", Description: "whenever only a single window is shown on a screen, add padding so that it conforms to the specified aspect ratio. A value like 4 3 on a 16:9 screen will make it a 4:3 window in the middle with padding to the sides.", Type: "Vec2D", Default: "0 0"},
+					{Name: "single_window_aspect_ratio_tolerance", Description: "sets a tolerance for single_window_aspect_ratio, so that if the padding that would have been added is smaller than the specified fraction of the height or width of the screen, it will not attempt to adjust the window size [0 - 1]", Type: "int", Default: "0.1", Bounds: &ValueBounds{Min: 0, Max: 1}},
+				},
+			},
+			{
+				Path:                     []string{"Binds"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "binds",
+				Variables: []VariableDefinition{
+					{Name: "pass_mouse_when_bound", Description: "if disabled, will not pass the mouse events to apps / dragging windows around if a keybind has been triggered.", Type: "bool", Default: "false"},
+					{Name: "scroll_event_delay", Description: "in ms, how many ms to wait after a scroll event to allow passing another one for the binds.", Type: "int", Default: "300"},
+					{Name: "workspace_back_and_forth", Description: "If enabled, an attempt to switch to the currently focused workspace will instead switch to the previous workspace. Akin to i3's auto_back_and_forth.", Type: "bool", Default: "false"},
+					{Name: "hide_special_on_workspace_change", Description: "If enabled, changing the active workspace (including to itself) will hide the special workspace on the monitor where the newly active workspace resides.", Type: "bool", Default: "false"},
+					{Name: "allow_workspace_cycles", Description: "If enabled, workspaces don't forget their previous workspace, so cycles can be created by switching to the first workspace in a sequence, then endlessly going to the previous workspace.", Type: "bool", Default: "false"},
+					{Name: "workspace_center_on", Description: "Whether switching workspaces should center the cursor on the workspace (0) or on the last active window for that workspace (1)", Type: "int", Default: "0"},
+					{Name: "focus_preferred_method", Description: "sets the preferred focus finding method when using focuswindow/movewindow/etc with a direction. 0 - history (recent have priority), 1 - length (longer shared edges have priority)", Type: "int", Default: "0", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "history (recent have priority)"},
+						{Value: "1", Description: "length (longer shared edges have priority)"},
+					}},
+					{Name: "ignore_group_lock", Description: "If enabled, dispatchers like moveintogroup, moveoutofgroup and movewindoworgroup will ignore lock per group.", Type: "bool", Default: "false"},
+					{Name: "movefocus_cycles_fullscreen", Description: "If enabled, when on a fullscreen window, movefocus will cycle fullscreen, if not, it will move the focus in a direction.", Type: "bool", Default: "false"},
+					{Name: "movefocus_cycles_groupfirst", Description: "If enabled, when in a grouped window, movefocus will cycle windows in the groups first, then at each ends of tabs, it'll move on to other windows/groups", Type: "bool", Default: "false"},
+					{Name: "disable_keybind_grabbing", Description: "If enabled, apps that request keybinds to be disabled (e.g. VMs) will not be able to do so.", Type: "bool", Default: "false"},
+					{Name: "allow_pin_fullscreen", Description: "If enabled, Allow fullscreen to pinned windows, and restore their pinned status afterwards", Type: "bool", Default: "false"},
+					{Name: "drag_threshold", Description: "Movement threshold in pixels for window dragging and c/g bind flags. 0 to disable and grab on mousedown.", Type: "int", Default: "0"},
+				},
+			},
+			{
+				Path:                     []string{"XWayland"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "xwayland",
+				Variables: []VariableDefinition{
+					{Name: "enabled", Description: "allow running applications using X11", Type: "bool", Default: "true"},
+					{Name: "use_nearest_neighbor", Description: "uses the nearest neighbor filtering for xwayland apps, making them pixelated rather than blurry", Type: "bool", Default: "true"},
+					{Name: "force_zero_scaling", Description: "forces a scale of 1 on xwayland windows on scaled displays.", Type: "bool", Default: "false"},
+					{Name: "create_abstract_socket", Description: "Create the abstract Unix domain socket for XWayland connections. (XWayland restart is required for changes to take effect; Linux only)", Type: "bool", Default: "false"},
+				},
+			},
+			{
+				Path:                     []string{"OpenGL"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "opengl",
+				Variables: []VariableDefinition{
+					{Name: "nvidia_anti_flicker", Description: "reduces flickering on nvidia at the cost of possible frame drops on lower-end GPUs. On non-nvidia, this is ignored.", Type: "bool", Default: "true"},
+				},
+			},
+			{
+				Path:                     []string{"Render"},
+				Description:              "`cm_auto_hdr` requires `--target-colorspace-hint-mode=source` mpv option to work with mpv versions greater than v0.40.0",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "render",
+				Variables: []VariableDefinition{
+					{Name: "direct_scanout", Description: "Enables direct scanout. Direct scanout attempts to reduce lag when there is only one fullscreen application on a screen (e.g. game). It is also recommended to set this to false if the fullscreen application shows graphical glitches. 0 - off, 1 - on, 2 - auto (on with content type 'game')", Type: "int", Default: "0", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "off"},
+						{Value: "1", Description: "on"},
+						{Value: "2", Description: "auto (on with content type 'game')"},
+					}},
+					{Name: "expand_undersized_textures", Description: "Whether to expand undersized textures along the edge, or rather stretch the entire texture.", Type: "bool", Default: "true"},
+					{Name: "xp_mode", Description: "Disables back buffer and bottom layer rendering.", Type: "bool", Default: "false"},
+					{Name: "ctm_animation", Description: "Whether to enable a fade animation for CTM changes (hyprsunset). 2 means \"auto\" which disables them on Nvidia.", Type: "int", Default: "2"},
+					{Name: "cm_fs_passthrough", Description: "Passthrough color settings for fullscreen apps when possible. 0 - off, 1 - always, 2 - hdr only", Type: "int", Default: "2", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "off"},
+						{Value: "1", Description: "always"},
+						{Value: "2", Description: "hdr only"},
+					}},
+					{Name: "cm_enabled", Description: "Whether the color management pipeline should be enabled or not (requires a restart of Hyprland to fully take effect)", Type: "bool", Default: "true"},
+					{Name: "send_content_type", Description: "Report content type to allow monitor profile autoswitch (may result in a black screen during the switch)", Type: "bool", Default: "true"},
+					{Name: "cm_auto_hdr", Description: "Auto-switch to HDR in fullscreen when needed. 0 - off, 1 - switch to cm, hdr, 2 - switch to cm, hdredid", Type: "int", Default: "1", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "off"},
+						{Value: "1", Description: "switch to cm, hdr"},
+						{Value: "2", Description: "switch to cm, hdredid"},
+					}},
+					{Name: "new_render_scheduling", Description: "Automatically uses triple buffering when needed, improves FPS on underpowered devices.", Type: "bool", Default: "false"},
+					{Name: "non_shader_cm", Description: "Enable CM without shader. 0 - disable, 1 - whenever possible, 2 - DS and passthrough only, 3 - disable and ignore CM issues", Type: "int", Default: "3", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "disable"},
+						{Value: "1", Description: "whenever possible"},
+						{Value: "2", Description: "DS and passthrough only"},
+						{Value: "3", Description: "disable and ignore CM issues"},
+					}},
+					{Name: "cm_sdr_eotf", Description: "Default transfer function for displaying SDR apps. default - Use default value (Gamma 2.2), gamma22 - Treat unspecified as Gamma 2.2, gamma22force - Treat unspecified and sRGB as Gamma 2.2, srgb - Treat unspecified as sRGB", Type: "str", Default: "default", AllowedValues: []AllowedValue{
+						{Value: "default", Description: "Use default value (Gamma 2.2)"},
+						{Value: "gamma22", Description: "Treat unspecified as Gamma 2.2"},
+						{Value: "gamma22force", Description: "Treat unspecified and sRGB as Gamma 2.2"},
+						{Value: "srgb", Description: "Treat unspecified as sRGB"},
+					}},
+				},
+			},
+			{
+				Path:                     []string{"Cursor"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "cursor",
+				Variables: []VariableDefinition{
+					{Name: "invisible", Description: "don't render cursors", Type: "bool", Default: "false"},
+					{Name: "sync_gsettings_theme", Description: "sync xcursor theme with gsettings, it applies cursor-theme and cursor-size on theme load to gsettings making most CSD gtk based clients use same xcursor theme and size.", Type: "bool", Default: "true"},
+					{Name: "no_hardware_cursors", Description: "disables hardware cursors. 0 - use hw cursors if possible, 1 - don't use hw cursors, 2 - auto (disable when tearing)", Type: "int", Default: "2", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "use hw cursors if possible"},
+						{Value: "1", Description: "don't use hw cursors"},
+						{Value: "2", Description: "auto (disable when tearing)"},
+					}},
+					{Name: "no_break_fs_vrr", Description: "disables scheduling new frames on cursor movement for fullscreen apps with VRR enabled to avoid framerate spikes (may require no_hardware_cursors = true) 0 - off, 1 - on, 2 - auto (on with content type 'game')", Type: "int", Default: "2", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "off"},
+						{Value: "1", Description: "on"},
+						{Value: "2", Description: "auto (on with content type 'game')"},
+					}},
+					{Name: "min_refresh_rate", Description: "minimum refresh rate for cursor movement when no_break_fs_vrr is active. Set to minimum supported refresh rate or higher", Type: "int", Default: "24"},
+					{Name: "hotspot_padding", Description: "the padding, in logical px, between screen edges and the cursor", Type: "int", Default: "1"},
+					{Name: "inactive_timeout", Description: "in seconds, after how many seconds of cursor's inactivity to hide it. Set to 0 for never.", Type: "float", Default: "0"},
+					{Name: "no_warps", Description: "if true, will not warp the cursor in many cases (focusing, keybinds, etc)", Type: "bool", Default: "false"},
+					{Name: "persistent_warps", Description: "When a window is refocused, the cursor returns to its last position relative to that window, rather than to the centre.", Type: "bool", Default: "false"},
+					{Name: "warp_on_change_workspace", Description: "Move the cursor to the last focused window after changing the workspace. Options: 0 (Disabled), 1 (Enabled), 2 (Force - ignores cursor:no_warps option)", Type: "int", Default: "0", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "Disabled"},
+						{Value: "1", Description: "Enabled"},
+						{Value: "2", Description: "Force - ignores cursor:no_warps option"},
+					}},
+					{Name: "warp_on_toggle_special", Description: "Move the cursor to the last focused window when toggling a special workspace. Options: 0 (Disabled), 1 (Enabled), 2 (Force - ignores cursor:no_warps option)", Type: "int", Default: "0", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "Disabled"},
+						{Value: "1", Description: "Enabled"},
+						{Value: "2", Description: "Force - ignores cursor:no_warps option"},
+					}},
+					{Name: "default_monitor", Description: "the name of a default monitor for the cursor to be set to on startup (see hyprctl monitors for names)", Type: "str", Default: "[[EMPTY]]"},
+					{Name: "zoom_factor", Description: "the factor to zoom by around the cursor. Like a magnifying glass. Minimum 1.0 (meaning no zoom)", Type: "float", Default: "1.0"},
+					{Name: "zoom_rigid", Description: "whether the zoom should follow the cursor rigidly (cursor is always centered if it can be) or loosely", Type: "bool", Default: "false"},
+					{Name: "zoom_detached_camera", Description: "detach the camera from the mouse when zoomed in, only ever moving the camera to keep the mouse in view when it goes past the screen edges", Type: "bool", Default: "true"},
+					{Name: "enable_hyprcursor", Description: "whether to enable hyprcursor support", Type: "bool", Default: "true"},
+					{Name: "hide_on_key_press", Description: "Hides the cursor when you press any key until the mouse is moved.", Type: "bool", Default: "false"},
+					{Name: "hide_on_touch", Description: "Hides the cursor when the last input was a touch input until a mouse input is done.", Type: "bool", Default: "true"},
+					{Name: "hide_on_tablet", Description: "Hides the cursor when the last input was a tablet input until a mouse input is done.", Type: "bool", Default: "true"},
+					{Name: "use_cpu_buffer", Description: "Makes HW cursors use a CPU buffer. Required on Nvidia to have HW cursors. 0 - off, 1 - on, 2 - auto (nvidia only)", Type: "int", Default: "2", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "off"},
+						{Value: "1", Description: "on"},
+						{Value: "2", Description: "auto (nvidia only)"},
+					}},
+					{Name: "warp_back_after_non_mouse_input", Description: "Warp the cursor back to where it was after using a non-mouse input to move it, and then returning back to mouse.", Type: "bool", Default: "false"},
+					{Name: "zoom_disable_aa", Description: "disable antialiasing when zooming, which means things will be pixelated instead of blurry", Type: "bool", Default: "false"},
+				},
+			},
+			{
+				Path:                     []string{"Ecosystem"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "ecosystem",
+				Variables: []VariableDefinition{
+					{Name: "no_update_news", Description: "disable the popup that shows up when you update hyprland to a new version.", Type: "bool", Default: "false"},
+					{Name: "no_donation_nag", Description: "disable the popup that shows up twice a year encouraging to donate.", Type: "bool", Default: "false"},
+					{Name: "enforce_permissions", Description: "whether to enable permission control.", Type: "bool", Default: "false"},
+				},
+			},
+			{
+				Path:                     []string{"Quirks"},
+				Description:              "Some clients expect monitor to be in HDR mode prior to the client start. This breaks auto HDR activation and can cause whitescreen and flickering. Use `prefer_hdr` to fix it,",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "quirks",
+				Variables: []VariableDefinition{
+					{Name: "prefer_hdr", Description: "Report HDR mode as preferred. 0 - off, 1 - always, 2 - gamescope only", Type: "int", Default: "0", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "off"},
+						{Value: "1", Description: "always"},
+						{Value: "2", Description: "gamescope only"},
+					}},
+				},
+			},
+			{
+				Path:                     []string{"Debug"},
+				Description:              "Only for developers.",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "debug",
+				Variables: []VariableDefinition{
+					{Name: "overlay", Description: "print the debug performance overlay. Disable VFR for accurate results.", Type: "bool", Default: "false"},
+					{Name: "damage_blink", Description: "(epilepsy warning!) flash areas updated with damage tracking", Type: "bool", Default: "false"},
+					{Name: "gl_debugging", Description: "enables OpenGL debugging with glGetError and EGL_KHR_debug, requires a restart after changing.", Type: "bool", Default: "false"},
+					{Name: "disable_logs", Description: "disable logging to a file", Type: "bool", Default: "true"},
+					{Name: "disable_time", Description: "disables time logging", Type: "bool", Default: "true"},
+					{Name: "damage_tracking", Description: "redraw only the needed bits of the display. Do not change. (default: full - 2) monitor - 1, none - 0", Type: "int", Default: "2"},
+					{Name: "enable_stdout_logs", Description: "enables logging to stdout", Type: "bool", Default: "false"},
+					{Name: "manual_crash", Description: "set to 1 and then back to 0 to crash Hyprland.", Type: "int", Default: "0"},
+					{Name: "suppress_errors", Description: "if true, do not display config file parsing errors.", Type: "bool", Default: "false"},
+					{Name: "watchdog_timeout", Description: "sets the timeout in seconds for watchdog to abort processing of a signal of the main thread. Set to 0 to disable.", Type: "int", Default: "5"},
+					{Name: "disable_scale_checks", Description: "disables verification of the scale factors. Will result in pixel alignment and rounding errors.", Type: "bool", Default: "false"},
+					{Name: "error_limit", Description: "limits the number of displayed config file parsing errors.", Type: "int", Default: "5"},
+					{Name: "error_position", Description: "sets the position of the error bar. top - 0, bottom - 1", Type: "int", Default: "0"},
+					{Name: "colored_stdout_logs", Description: "enables colors in the stdout logs.", Type: "bool", Default: "true"},
+					{Name: "pass", Description: "enables render pass debugging.", Type: "bool", Default: "false"},
+					{Name: "full_cm_proto", Description: "claims support for all cm proto features (requires restart)", Type: "bool", Default: "false"},
+				},
+			},
+			{
+				Path:                     []string{"Master"},
+				Description:              "The master layout makes one (or more) window(s) be the \"master\", taking (by default) the left part of the screen, and tiles the rest on the right. You can change the orientation on a per-workspace basis if you want to use anything other than the default left/right split.",
+				DocumentationFile:        "Master-Layout",
+				DocumentationHeadingSlug: "config",
+				Variables: []VariableDefinition{
+					{Name: "allow_small_split", Description: "enable adding additional master windows in a horizontal split style", Type: "bool", Default: "false"},
+					{Name: "special_scale_factor", Description: "the scale of the special workspace windows. [0.0 - 1.0]", Type: "float", Default: "1", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "mfact", Description: "the size as a percentage of the master window, for example mfact = 0.70 would mean 70% of the screen will be the master window, and 30% the slave [0.0 - 1.0]", Type: "floatvalue", Default: "0.55", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "new_on_top", Description: "whether a newly open window should be on the top of the stack", Type: "bool", Default: "false"},
+					{Name: "new_on_active", Description: "before, after: place new window relative to the focused window; none: place new window according to the value of new_on_top.", Type: "string", Default: "none"},
+					{Name: "orientation", Description: "default placement of the master area, can be left, right, top, bottom or center", Type: "string", Default: "left"},
+					{Name: "slave_count_for_center_master", Description: "when using orientation=center, make the master window centered only when at least this many slave windows are open. (Set 0 to always_center_master)", Type: "int", Default: "2"},
+					{Name: "center_master_fallback", Description: "Set fallback for center master when slaves are less than slave_count_for_center_master, can be left ,right ,top ,bottom", Type: "string", Default: "left"},
+					{Name: "smart_resizing", Description: "if enabled, resizing direction will be determined by the mouse's position on the window (nearest to which corner). Else, it is based on the window's tiling position.", Type: "bool", Default: "true"},
+					{Name: "drop_at_cursor", Description: "when enabled, dragging and dropping windows will put them at the cursor position. Otherwise, when dropped at the stack side, they will go to the top/bottom of the stack depending on new_on_top.", Type: "bool", Default: "true"},
+					{Name: "always_keep_position", Description: "whether to keep the master window in its configured position when there are no slave windows", Type: "bool", Default: "false"},
+					{Name: "no_gaps_when_only", Description: "Removed in Hyprland 0.45.0, use workspace rules instead, see https://wiki.hyprland.org/Configuring/Workspace-Rules/#smart-gaps", Type: "int", Default: "0"},
+					{Name: "new_is_master", Description: "Became master:new_status in Hyprland 0.41.0, with other values. master: new window becomes master; slave: new windows are added to slave stack; inherit: inherit from focused window", Type: "bool", Default: "0"},
+				},
+			},
+			{
+				Path:                     []string{"Dwindle"},
+				Description:              "Dwindle is a BSPWM-like layout, where every window on a workspace is a member of a binary tree.",
+				DocumentationFile:        "Dwindle-Layout",
+				DocumentationHeadingSlug: "config",
+				Variables: []VariableDefinition{
+					{Name: "pseudotile", Description: "enable pseudotiling. Pseudotiled windows retain their floating size when tiled.", Type: "bool", Default: "false"},
+					{Name: "force_split", Description: "0 -> split follows mouse, 1 -> always split to the left (new = left or top) 2 -> always split to the right (new = right or bottom)", Type: "int", Default: "0", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "split follows mouse"},
+						{Value: "1", Description: "always split to the left (new = left or top)"},
+						{Value: "2", Description: "always split to the right (new = right or bottom)"},
+					}},
+					{Name: "preserve_split", Description: "if enabled, the split (side/top) will not change regardless of what happens to the container.", Type: "bool", Default: "false"},
+					{Name: "smart_split", Description: "if enabled, allows a more precise control over the window split direction based on the cursor's position. The window is conceptually divided into four triangles, and cursor's triangle determines the split direction. This feature also turns on preserve_split.", Type: "bool", Default: "false"},
+					{Name: "smart_resizing", Description: "if enabled, resizing direction will be determined by the mouse's position on the window (nearest to which corner). Else, it is based on the window's tiling position.", Type: "bool", Default: "true"},
+					{Name: "permanent_direction_override", Description: "if enabled, makes the preselect direction persist until either this mode is turned off, another direction is specified, or a non-direction is specified (anything other than l,r,u/t,d/b)", Type: "bool", Default: "false"},
+					{Name: "special_scale_factor", Description: "specifies the scale factor of windows on the special workspace [0 - 1]", Type: "float", Default: "1", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "split_width_multiplier", Description: "specifies the auto-split width multiplier. Multiplying window size is useful on widescreen monitors where window W > H even after several splits.", Type: "float", Default: "1.0"},
+					{Name: "use_active_for_splits", Description: "whether to prefer the active window or the mouse position for splits", Type: "bool", Default: "true"},
+					{Name: "default_split_ratio", Description: "the default split ratio on window open. 1 means even 50/50 split. [0.1 - 1.9]", Type: "float", Default: "1.0", Bounds: &ValueBounds{Min: 0.1, Max: 1.9}},
+					{Name: "split_bias", Description: "specifies which window will receive the split ratio. 0 -> directional (the top or left window), 1 -> the current window", Type: "int", Default: "0", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "directional (the top or left window)"},
+						{Value: "1", Description: "the current window"},
+					}},
+					{Name: "precise_mouse_move", Description: "bindm movewindow will drop the window more precisely depending on where your mouse is.", Type: "bool", Default: "false"},
+					{Name: "no_gaps_when_only", Description: "Removed in Hyprland 0.45.0, use workspace rules instead, see https://wiki.hyprland.org/Configuring/Workspace-Rules/#smart-gaps", Type: "int", Default: "0"},
+				},
+			},
+		},
+	},
+	{
+		Version: "0.41.0",
+		Until:   "0.45.0",
+		Sections: []SectionDefinition{
+			{
+				Path:                     []string{"General"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "general",
+				Variables: []VariableDefinition{
+					{Name: "border_size", Description: "size of the border around windows", Type: "int", Default: "1"},
+					{Name: "gaps_in", Description: "gaps between windows, also supports css style gaps (top, right, bottom, left -> 5,10,15,20)", Type: "int", Default: "5"},
+					{Name: "gaps_out", Description: "gaps between windows and monitor edges, also supports css style gaps (top, right, bottom, left -> 5,10,15,20)", Type: "int", Default: "20"},
+					{Name: "float_gaps", Description: "gaps between windows and monitor edges for floating windows, also supports css style gaps (top, right, bottom, left -> 5 10 15 20). -1 means default", Type: "int", Default: "0"},
+					{Name: "gaps_workspaces", Description: "gaps between workspaces. Stacks with gaps_out.", Type: "int", Default: "0"},
+					{Name: "col.inactive_border", Description: "border color for inactive windows", Type: "gradient", Default: "0xff444444"},
+					{Name: "col.active_border", Description: "border color for the active window", Type: "gradient", Default: "0xffffffff"},
+					{Name: "col.nogroup_border", Description: "inactive border color for window that cannot be added to a group (see denywindowfromgroup dispatcher)", Type: "gradient", Default: "0xffffaaff"},
+					{Name: "col.nogroup_border_active", Description: "active border color for window that cannot be added to a group", Type: "gradient", Default: "0xffff00ff"},
+					{Name: "layout", Description: "which layout to use. [dwindle/master/scrolling/monocle]", Type: "str", Default: "dwindle", AllowedValues: []AllowedValue{
+						{Value: "dwindle", Description: ""},
+						{Value: "master", Description: ""},
+						{Value: "scrolling", Description: ""},
+						{Value: "monocle", Description: ""},
+					}},
+					{Name: "no_focus_fallback", Description: "if true, will not fall back to the next available window when moving focus in a direction where no window was found", Type: "bool", Default: "false"},
+					{Name: "resize_on_border", Description: "enables resizing windows by clicking and dragging on borders and gaps", Type: "bool", Default: "false"},
+					{Name: "extend_border_grab_area", Description: "extends the area around the border where you can click and drag on, only used when general:resize_on_border is on.", Type: "int", Default: "15"},
+					{Name: "hover_icon_on_border", Description: "show a cursor icon when hovering over borders, only used when general:resize_on_border is on.", Type: "bool", Default: "true"},
+					{Name: "allow_tearing", Description: "master switch for allowing tearing to occur. See the Tearing page.", Type: "bool", Default: "false"},
+					{Name: "resize_corner", Description: "force floating windows to use a specific corner when being resized (1-4 going clockwise from top left, 0 to disable)", Type: "int", Default: "0"},
+					{Name: "modal_parent_blocking", Description: "whether parent windows of modals will be interactive", Type: "bool", Default: "true"},
+					{Name: "locale", Description: "overrides the system locale (e.g. en_US, es)", Type: "str", Default: "[[Empty]]"},
+					{Name: "autogenerated", Description: "Whether this configuration was autogenerated", Type: "bool", Default: "1"},
+				},
+			},
+			{
+				Path:                     []string{"General", "Snap"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "snap",
+				Variables: []VariableDefinition{
+					{Name: "enabled", Description: "enable snapping for floating windows", Type: "bool", Default: "false"},
+					{Name: "window_gap", Description: "minimum gap in pixels between windows before snapping", Type: "int", Default: "10"},
+					{Name: "monitor_gap", Description: "minimum gap in pixels between window and monitor edges before snapping", Type: "int", Default: "10"},
+					{Name: "border_overlap", Description: "if true, windows snap such that only one border's worth of space is between them", Type: "bool", Default: "false"},
+					{Name: "respect_gaps", Description: "if true, snapping will respect gaps between windows(set in general:gaps_in)", Type: "bool", Default: "false"},
+				},
+			},
+			{
+				Path:                     []string{"Decoration"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "decoration",
+				Variables: []VariableDefinition{
+					{Name: "rounding", Description: "rounded corners' radius (in layout px)", Type: "int", Default: "0"},
+					{Name: "rounding_power", Description: "adjusts the curve used for rounding corners, larger is smoother, 2.0 is a circle, 4.0 is a squircle, 1.0 is a triangular corner. [1.0 - 10.0]", Type: "float", Default: "2.0", Bounds: &ValueBounds{Min: 1, Max: 10}},
+					{Name: "active_opacity", Description: "opacity of active windows. [0.0 - 1.0]", Type: "float", Default: "1.0", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "inactive_opacity", Description: "opacity of inactive windows. [0.0 - 1.0]", Type: "float", Default: "1.0", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "fullscreen_opacity", Description: "opacity of fullscreen windows. [0.0 - 1.0]", Type: "float", Default: "1.0", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "dim_modal", Description: "enables dimming of parents of modal windows", Type: "bool", Default: "true"},
+					{Name: "dim_inactive", Description: "enables dimming of inactive windows", Type: "bool", Default: "false"},
+					{Name: "dim_strength", Description: "how much inactive windows should be dimmed [0.0 - 1.0]", Type: "float", Default: "0.5", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "dim_special", Description: "how much to dim the rest of the screen by when a special workspace is open. [0.0 - 1.0]", Type: "float", Default: "0.2", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "dim_around", Description: "how much the dim_around window rule should dim by. [0.0 - 1.0]", Type: "float", Default: "0.4", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "screen_shader", Description: "a path to a custom shader to be applied at the end of rendering. See examples/screenShader.frag for an example.", Type: "str", Default: "[[Empty]]"},
+					{Name: "border_part_of_window", Description: "whether the window border should be a part of the window", Type: "bool", Default: "true"},
+					{Name: "shadow_scale", Description: "shadow's scale. [0.0 - 1.0]", Type: "float", Default: "1.0", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "shadow_offset", Description: "shadow's rendering offset.", Type: "vec2", Default: "[0, 0]"},
+					{Name: "col.shadow_inactive", Description: "inactive shadow color. (if not set, will fall back to color)", Type: "color", Default: "unset"},
+					{Name: "col.shadow", Description: "shadow's color. Alpha dictates shadow's opacity.", Type: "color", Default: "0xee1a1a1a"},
+					{Name: "shadow_ignore_window", Description: "if true, the shadow will not be rendered behind the window itself, only around it.", Type: "bool", Default: "true"},
+					{Name: "shadow_render_power", Description: "in what power to render the falloff (more power, the faster the falloff) [1 - 4]", Type: "int", Default: "3", Bounds: &ValueBounds{Min: 1, Max: 4}},
+					{Name: "shadow_range", Description: "Shadow range (\"size\") in layout px", Type: "int", Default: "4"},
+					{Name: "drop_shadow", Description: "enable drop shadows on windows", Type: "bool", Default: "true"},
+				},
+			},
+			{
+				Path:                     []string{"Decoration", "Blur"},
+				Description:              "`blur:size` and `blur:passes` have to be at least 1.",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "blur",
+				Variables: []VariableDefinition{
+					{Name: "enabled", Description: "enable kawase window background blur", Type: "bool", Default: "true"},
+					{Name: "size", Description: "blur size (distance)", Type: "int", Default: "8"},
+					{Name: "passes", Description: "the amount of passes to perform", Type: "int", Default: "1"},
+					{Name: "ignore_opacity", Description: "make the blur layer ignore the opacity of the window", Type: "bool", Default: "true"},
+					{Name: "new_optimizations", Description: "whether to enable further optimizations to the blur. Recommended to leave on, as it will massively improve performance.", Type: "bool", Default: "true"},
+					{Name: "xray", Description: "if enabled, floating windows will ignore tiled windows in their blur. Only available if new_optimizations is true. Will reduce overhead on floating blur significantly.", Type: "bool", Default: "false"},
+					{Name: "noise", Description: "how much noise to apply. [0.0 - 1.0]", Type: "float", Default: "0.0117", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "contrast", Description: "contrast modulation for blur. [0.0 - 2.0]", Type: "float", Default: "0.8916", Bounds: &ValueBounds{Min: 0, Max: 2}},
+					{Name: "brightness", Description: "brightness modulation for blur. [0.0 - 2.0]", Type: "float", Default: "0.8172", Bounds: &ValueBounds{Min: 0, Max: 2}},
+					{Name: "vibrancy", Description: "Increase saturation of blurred colors. [0.0 - 1.0]", Type: "float", Default: "0.1696", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "vibrancy_darkness", Description: "How strong the effect of vibrancy is on dark areas . [0.0 - 1.0]", Type: "float", Default: "0.0", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "special", Description: "whether to blur behind the special workspace (note: expensive)", Type: "bool", Default: "false"},
+					{Name: "popups", Description: "whether to blur popups (e.g. right-click menus)", Type: "bool", Default: "false"},
+					{Name: "popups_ignorealpha", Description: "works like ignore_alpha in layer rules. If pixel opacity is below set value, will not blur. [0.0 - 1.0]", Type: "float", Default: "0.2", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "input_methods", Description: "whether to blur input methods (e.g. fcitx5)", Type: "bool", Default: "false"},
+					{Name: "input_methods_ignorealpha", Description: "works like ignore_alpha in layer rules. If pixel opacity is below set value, will not blur. [0.0 - 1.0]", Type: "float", Default: "0.2", Bounds: &ValueBounds{Min: 0, Max: 1}},
+				},
+			},
+			{
+				Path:                     []string{"Animations"},
+				Description:              "_[More about Animations](https://wiki.hyprland.org/Configuring/Animations)._",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "animations",
+				Variables: []VariableDefinition{
+					{Name: "enabled", Description: "enable animations", Type: "bool", Default: "true"},
+					{Name: "workspace_wraparound", Description: "enable workspace wraparound, causing directional workspace animations to animate as if the first and last workspaces were adjacent", Type: "bool", Default: "false"},
+				},
+			},
+			{
+				Path:                     []string{"Input"},
+				Description:              "You can find a list of models, layouts, variants and options in [`/usr/share/X11/xkb/rules/evdev.lst`](). Alternatively, you can use the `localectl` command to discover what is available on your system.",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "input",
+				Variables: []VariableDefinition{
+					{Name: "kb_model", Description: "Appropriate XKB keymap parameter. See the note below.", Type: "str", Default: "[[Empty]]"},
+					{Name: "kb_layout", Description: "Appropriate XKB keymap parameter", Type: "str", Default: "us"},
+					{Name: "kb_variant", Description: "Appropriate XKB keymap parameter", Type: "str", Default: "[[Empty]]"},
+					{Name: "kb_options", Description: "Appropriate XKB keymap parameter", Type: "str", Default: "[[Empty]]"},
+					{Name: "kb_rules", Description: "Appropriate XKB keymap parameter", Type: "str", Default: "[[Empty]]"},
+					{Name: "kb_file", Description: "If you prefer, you can use a path to your custom .xkb file.", Type: "str", Default: "[[Empty]]"},
+					{Name: "numlock_by_default", Description: "Engage numlock by default.", Type: "bool", Default: "false"},
+					{Name: "resolve_binds_by_sym", Description: "Determines how keybinds act when multiple layouts are used. If false, keybinds will always act as if the first specified layout is active. If true, keybinds specified by symbols are activated when you type the respective symbol with the current layout.", Type: "bool", Default: "false"},
+					{Name: "repeat_rate", Description: "The repeat rate for held-down keys, in repeats per second.", Type: "int", Default: "25"},
+					{Name: "repeat_delay", Description: "Delay before a held-down key is repeated, in milliseconds.", Type: "int", Default: "600"},
+					{Name: "sensitivity", Description: "Sets the mouse input sensitivity. Value is clamped to the range -1.0 to 1.0. libinput#pointer-acceleration", Type: "float", Default: "0.0"},
+					{Name: "accel_profile", Description: "Sets the cursor acceleration profile. Can be one of adaptive, flat. Can also be custom, see below. Leave empty to use libinput's default mode for your input device. libinput#pointer-acceleration [adaptive/flat/custom]", Type: "str", Default: "[[Empty]]", AllowedValues: []AllowedValue{
+						{Value: "adaptive", Description: ""},
+						{Value: "flat", Description: ""},
+						{Value: "custom", Description: "", Parameters: "<step> <points...>"},
+					}},
+					{Name: "force_no_accel", Description: "Force no cursor acceleration. This bypasses most of your pointer settings to get as raw of a signal as possible. Enabling this is not recommended due to potential cursor desynchronization.", Type: "bool", Default: "false"},
+					{Name: "rotation", Description: "Sets the rotation of a device in degrees clockwise off the logical neutral position. Value is clamped to the range 0 to 359.", Type: "int", Default: "0"},
+					{Name: "left_handed", Description: "Switches RMB and LMB", Type: "bool", Default: "false"},
+					{Name: "scroll_points", Description: "Sets the scroll acceleration profile, when accel_profile is set to custom. Has to be in the form <step> <points>. Leave empty to have a flat scroll curve.", Type: "str", Default: "[[Empty]]"},
+					{Name: "scroll_method", Description: "Sets the scroll method. Can be one of 2fg (2 fingers), edge, on_button_down, no_scroll. libinput#scrolling [2fg/edge/on_button_down/no_scroll]", Type: "str", Default: "[[Empty]]", AllowedValues: []AllowedValue{
+						{Value: "2fg", Description: ""},
+						{Value: "edge", Description: ""},
+						{Value: "on_button_down", Description: ""},
+						{Value: "no_scroll", Description: ""},
+					}},
+					{Name: "scroll_button", Description: "Sets the scroll button. Has to be an int, cannot be a string. Check wev if you have any doubts regarding the ID. 0 means default.", Type: "int", Default: "0"},
+					{Name: "scroll_button_lock", Description: "If the scroll button lock is enabled, the button does not need to be held down. Pressing and releasing the button toggles the button lock, which logically holds the button down or releases it. While the button is logically held down, motion events are converted to scroll events.", Type: "bool", Default: "false"},
+					{Name: "scroll_factor", Description: "Multiplier added to scroll movement for external mice. Note that there is a separate setting for touchpad scroll_factor.", Type: "float", Default: "1.0"},
+					{Name: "natural_scroll", Description: "Inverts scrolling direction. When enabled, scrolling moves content directly, rather than manipulating a scrollbar.", Type: "bool", Default: "false"},
+					{Name: "follow_mouse", Description: "Specify if and how cursor movement should affect window focus. See the note below. [0/1/2/3]", Type: "int", Default: "1", AllowedValues: []AllowedValue{
+						{Value: "0", Description: ""},
+						{Value: "1", Description: ""},
+						{Value: "2", Description: ""},
+						{Value: "3", Description: ""},
+					}},
+					{Name: "follow_mouse_threshold", Description: "The smallest distance in logical pixels the mouse needs to travel for the window under it to get focused. Works only with follow_mouse = 1.", Type: "float", Default: "0.0"},
+					{Name: "focus_on_close", Description: "Controls the window focus behavior when a window is closed. When set to 0, focus will shift to the next window candidate. When set to 1, focus will shift to the window under the cursor. [0/1]", Type: "int", Default: "0", AllowedValues: []AllowedValue{
+						{Value: "0", Description: ""},
+						{Value: "1", Description: ""},
+					}},
+					{Name: "mouse_refocus", Description: "If disabled, mouse focus won't switch to the hovered window unless the mouse crosses a window boundary when follow_mouse=1.", Type: "bool", Default: "true"},
+					{Name: "float_switch_override_focus", Description: "If enabled (1 or 2), focus will change to the window under the cursor when changing from tiled-to-floating and vice versa. If 2, focus will also follow mouse on float-to-float switches.", Type: "int", Default: "1"},
+					{Name: "special_fallthrough", Description: "if enabled, having only floating windows in the special workspace will not block focusing windows in the regular workspace.", Type: "bool", Default: "false"},
+					{Name: "off_window_axis_events", Description: "Handles axis events around (gaps/border for tiled, dragarea/border for floated) a focused window. 0 ignores axis events 1 sends out-of-bound coordinates 2 fakes pointer coordinates to the closest point inside the window 3 warps the cursor to the closest point inside the window", Type: "int", Default: "1"},
+					{Name: "emulate_discrete_scroll", Description: "Emulates discrete scrolling from high resolution scrolling events. 0 disables it, 1 enables handling of non-standard events only, and 2 force enables all scroll wheel events to be handled", Type: "int", Default: "1"},
+				},
+			},
+			{
+				Path:                     []string{"Input", "Touchpad"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "touchpad",
+				Variables: []VariableDefinition{
+					{Name: "disable_while_typing", Description: "Disable the touchpad while typing.", Type: "bool", Default: "true"},
+					{Name: "natural_scroll", Description: "Inverts scrolling direction. When enabled, scrolling moves content directly, rather than manipulating a scrollbar.", Type: "bool", Default: "false"},
+					{Name: "scroll_factor", Description: "Multiplier applied to the amount of scroll movement.", Type: "float", Default: "1.0"},
+					{Name: "middle_button_emulation", Description: "Sending LMB and RMB simultaneously will be interpreted as a middle click. This disables any touchpad area that would normally send a middle click based on location. libinput#middle-button-emulation", Type: "bool", Default: "false"},
+					{Name: "tap_button_map", Description: "Sets the tap button mapping for touchpad button emulation. Can be one of lrm (default) or lmr (Left, Middle, Right Buttons). [lrm/lmr]", Type: "str", Default: "[[Empty]]", AllowedValues: []AllowedValue{
+						{Value: "lrm", Description: ""},
+						{Value: "lmr", Description: ""},
+					}},
+					{Name: "clickfinger_behavior", Description: "Button presses with 1, 2, or 3 fingers will be mapped to LMB, RMB, and MMB respectively. This disables interpretation of clicks based on location on the touchpad. libinput#clickfinger-behavior", Type: "bool", Default: "false"},
+					{Name: "tap-to-click", Description: "Tapping on the touchpad with 1, 2, or 3 fingers will send LMB, RMB, and MMB respectively.", Type: "bool", Default: "true"},
+					{Name: "drag_lock", Description: "When enabled, lifting the finger off while dragging will not drop the dragged item. 0 -> disabled, 1 -> enabled with timeout, 2 -> enabled sticky. libinput#tap-and-drag", Type: "int", Default: "0", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "disabled"},
+						{Value: "1", Description: "enabled with timeout"},
+						{Value: "2", Description: "enabled sticky"},
+					}},
+					{Name: "tap-and-drag", Description: "Sets the tap and drag mode for the touchpad", Type: "bool", Default: "true"},
+					{Name: "flip_x", Description: "inverts the horizontal movement of the touchpad", Type: "bool", Default: "false"},
+					{Name: "flip_y", Description: "inverts the vertical movement of the touchpad", Type: "bool", Default: "false"},
+					{Name: "drag_3fg", Description: "enables three finger drag, 0 -> disabled, 1 -> 3 fingers, 2 -> 4 fingers libinput#drag-3fg", Type: "int", Default: "0", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "disabled"},
+						{Value: "1", Description: "3 fingers"},
+						{Value: "2", Description: "4 fingers"},
+					}},
+				},
+			},
+			{
+				Path:                     []string{"Input", "Touchdevice"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "touchdevice",
+				Variables: []VariableDefinition{
+					{Name: "transform", Description: "Transform the input from touchdevices. The possible transformations are the same as those of the monitors. -1 means it's unset.", Type: "int", Default: "-1"},
+					{Name: "output", Description: "The monitor to bind touch devices. The default is auto-detection. To stop auto-detection, use an empty string or the \"[[Empty]]\" value.", Type: "string", Default: "[[Auto]]"},
+					{Name: "enabled", Description: "Whether input is enabled for touch devices.", Type: "bool", Default: "true"},
+				},
+			},
+			{
+				Path:                     []string{"Input", "Virtualkeyboard"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "virtualkeyboard",
+				Variables: []VariableDefinition{
+					{Name: "share_states", Description: "Unify key down states and modifier states with other keyboards. 0 -> no, 1 -> yes, 2 -> yes unless IME client", Type: "int", Default: "2", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "no"},
+						{Value: "1", Description: "yes"},
+						{Value: "2", Description: "yes unless IME client"},
+					}},
+					{Name: "release_pressed_on_close", Description: "Release all pressed keys by virtual keyboard on close.", Type: "bool", Default: "false"},
+				},
+			},
+			{
+				Path:                     []string{"Input", "Tablet"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "tablet",
+				Variables: []VariableDefinition{
+					{Name: "transform", Description: "transform the input from tablets. The possible transformations are the same as those of the monitors. -1 means it's unset.", Type: "int", Default: "-1"},
+					{Name: "output", Description: "the monitor to bind tablets. Can be current or a monitor name. Leave empty to map across all monitors.", Type: "string", Default: "[[Empty]]"},
+					{Name: "region_position", Description: "position of the mapped region in monitor layout relative to the top left corner of the bound monitor or all monitors.", Type: "vec2", Default: "[0, 0]"},
+					{Name: "absolute_region_position", Description: "whether to treat the region_position as an absolute position in monitor layout. Only applies when output is empty.", Type: "bool", Default: "false"},
+					{Name: "region_size", Description: "size of the mapped region. When this variable is set, tablet input will be mapped to the region. [0, 0] or invalid size means unset.", Type: "vec2", Default: "[0, 0]"},
+					{Name: "relative_input", Description: "whether the input should be relative", Type: "bool", Default: "false"},
+					{Name: "left_handed", Description: "if enabled, the tablet will be rotated 180 degrees", Type: "bool", Default: "false"},
+					{Name: "active_area_size", Description: "size of tablet's active area in mm", Type: "vec2", Default: "[0, 0]"},
+					{Name: "active_area_position", Description: "position of the active area in mm", Type: "vec2", Default: "[0, 0]"},
+				},
+			},
+			{
+				Path:                     []string{"Gestures"},
+				Description:              "`workspace_swipe`, `workspace_swipe_fingers` and `workspace_swipe_min_fingers` were removed in favor of the new gestures system.",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "gestures",
+				Variables: []VariableDefinition{
+					{Name: "workspace_swipe_distance", Description: "in px, the distance of the touchpad gesture", Type: "int", Default: "300"},
+					{Name: "workspace_swipe_touch", Description: "enable workspace swiping from the edge of a touchscreen", Type: "bool", Default: "false"},
+					{Name: "workspace_swipe_invert", Description: "invert the direction (touchpad only)", Type: "bool", Default: "true"},
+					{Name: "workspace_swipe_touch_invert", Description: "invert the direction (touchscreen only)", Type: "bool", Default: "false"},
+					{Name: "workspace_swipe_min_speed_to_force", Description: "minimum speed in px per timepoint to force the change ignoring cancel_ratio. Setting to 0 will disable this mechanic.", Type: "int", Default: "30"},
+					{Name: "workspace_swipe_cancel_ratio", Description: "how much the swipe has to proceed in order to commence it. (0.7 -> if > 0.7 * distance, switch, if less, revert) [0.0 - 1.0]", Type: "float", Default: "0.5", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "workspace_swipe_create_new", Description: "whether a swipe right on the last workspace should create a new one.", Type: "bool", Default: "true"},
+					{Name: "workspace_swipe_direction_lock", Description: "if enabled, switching direction will be locked when you swipe past the direction_lock_threshold (touchpad only).", Type: "bool", Default: "true"},
+					{Name: "workspace_swipe_direction_lock_threshold", Description: "in px, the distance to swipe before direction lock activates (touchpad only).", Type: "int", Default: "10"},
+					{Name: "workspace_swipe_forever", Description: "if enabled, swiping will not clamp at the neighboring workspaces but continue to the further ones.", Type: "bool", Default: "false"},
+					{Name: "workspace_swipe_use_r", Description: "if enabled, swiping will use the r prefix instead of the m prefix for finding workspaces.", Type: "bool", Default: "false"},
+					{Name: "close_max_timeout", Description: "the timeout for a window to close when using a 1:1 gesture, in ms", Type: "int", Default: "1000"},
+					{Name: "workspace_swipe_min_fingers", Description: "Removed in Hyprland 0.51.0, set the number of fingers in gesture = FINGERS, horizontal, workspace instead", Type: "bool", Default: "false"},
+					{Name: "workspace_swipe_fingers", Description: "Removed in Hyprland 0.51.0, set the number of fingers in gesture = FINGERS, horizontal, workspace instead", Type: "int", Default: "3"},
+					{Name: "workspace_swipe", Description: "Removed in Hyprland 0.51.0, use gesture = 3, horizontal, workspace instead, see https://wiki.hyprland.org/Configuring/Gestures/", Type: "bool", Default: "false"},
+				},
+			},
+			{
+				Path:                     []string{"Group"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "group",
+				Variables: []VariableDefinition{
+					{Name: "auto_group", Description: "whether new windows will be automatically grouped into the focused unlocked group. Note: if you want to disable auto_group only for specific windows, use the \"group barred\" window rule instead.", Type: "bool", Default: "true"},
+					{Name: "insert_after_current", Description: "whether new windows in a group spawn after current or at group tail", Type: "bool", Default: "true"},
+					{Name: "focus_removed_window", Description: "whether Hyprland should focus on the window that has just been moved out of the group", Type: "bool", Default: "true"},
+					{Name: "drag_into_group", Description: "whether dragging a window into a unlocked group will merge them. Options: 0 (disabled), 1 (enabled), 2 (only when dragging into the groupbar)", Type: "int", Default: "1", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "disabled"},
+						{Value: "1", Description: "enabled"},
+						{Value: "2", Description: "only when dragging into the groupbar"},
+					}},
+					{Name: "merge_groups_on_drag", Description: "whether window groups can be dragged into other groups", Type: "bool", Default: "true"},
+					{Name: "merge_groups_on_groupbar", Description: "whether one group will be merged with another when dragged into its groupbar", Type: "bool", Default: "true"},
+					{Name: "merge_floated_into_tiled_on_groupbar", Description: "whether dragging a floating window into a tiled window groupbar will merge them", Type: "bool", Default: "false"},
+					{Name: "group_on_movetoworkspace", Description: "whether using movetoworkspace[silent] will merge the window into the workspace's solitary unlocked group", Type: "bool", Default: "false"},
+					{Name: "col.border_active", Description: "active group border color", Type: "gradient", Default: "0x66ffff00"},
+					{Name: "col.border_inactive", Description: "inactive (out of focus) group border color", Type: "gradient", Default: "0x66777700"},
+					{Name: "col.border_locked_active", Description: "active locked group border color", Type: "gradient", Default: "0x66ff5500"},
+					{Name: "col.border_locked_inactive", Description: "inactive locked group border color", Type: "gradient", Default: "0x66775500"},
+				},
+			},
+			{
+				Path:                     []string{"Group", "Groupbar"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "groupbar",
+				Variables: []VariableDefinition{
+					{Name: "enabled", Description: "enables groupbars", Type: "bool", Default: "true"},
+					{Name: "font_family", Description: "font used to display groupbar titles, use misc:font_family if not specified", Type: "string", Default: "[[Empty]]"},
+					{Name: "font_size", Description: "font size of groupbar title", Type: "int", Default: "8"},
+					{Name: "font_weight_active", Description: "font weight of active groupbar title", Type: "font_weight", Default: "normal"},
+					{Name: "font_weight_inactive", Description: "font weight of inactive groupbar title", Type: "font_weight", Default: "normal"},
+					{Name: "gradients", Description: "enables gradients", Type: "bool", Default: "false"},
+					{Name: "height", Description: "height of the groupbar", Type: "int", Default: "14"},
+					{Name: "indicator_gap", Description: "height of gap between groupbar indicator and title", Type: "int", Default: "0"},
+					{Name: "indicator_height", Description: "height of the groupbar indicator", Type: "int", Default: "3"},
+					{Name: "stacked", Description: "render the groupbar as a vertical stack", Type: "bool", Default: "false"},
+					{Name: "priority", Description: "sets the decoration priority for groupbars", Type: "int", Default: "3"},
+					{Name: "render_titles", Description: "whether to render titles in the group bar decoration", Type: "bool", Default: "true"},
+					{Name: "text_offset", Description: "adjust vertical position for titles", Type: "int", Default: "0"},
+					{Name: "text_padding", Description: "set horizontal padding for titles", Type: "int", Default: "0"},
+					{Name: "scrolling", Description: "whether scrolling in the groupbar changes group active window", Type: "bool", Default: "true"},
+					{Name: "rounding", Description: "how much to round the indicator", Type: "int", Default: "1"},
+					{Name: "rounding_power", Description: "adjusts the curve used for rounding groupbar corners, larger is smoother, 2.0 is a circle, 4.0 is a squircle, 1.0 is a triangular corner. [1.0 - 10.0]", Type: "float", Default: "2.0", Bounds: &ValueBounds{Min: 1, Max: 10}},
+					{Name: "gradient_rounding", Description: "how much to round the gradients", Type: "int", Default: "2"},
+					{Name: "gradient_rounding_power", Description: "adjusts the curve used for rounding gradient corners, larger is smoother, 2.0 is a circle, 4.0 is a squircle, 1.0 is a triangular corner. [1.0 - 10.0]", Type: "float", Default: "2.0", Bounds: &ValueBounds{Min: 1, Max: 10}},
+					{Name: "round_only_edges", Description: "round only the indicator edges of the entire groupbar", Type: "bool", Default: "true"},
+					{Name: "gradient_round_only_edges", Description: "round only the gradient edges of the entire groupbar", Type: "bool", Default: "true"},
+					{Name: "text_color", Description: "color for window titles in the groupbar", Type: "color", Default: "0xffffffff"},
+					{Name: "text_color_inactive", Description: "color for inactive windows' titles in the groupbar (if unset, defaults to text_color)", Type: "color", Default: "unset"},
+					{Name: "text_color_locked_active", Description: "color for the active window's title in a locked group (if unset, defaults to text_color)", Type: "color", Default: "unset"},
+					{Name: "text_color_locked_inactive", Description: "color for inactive windows' titles in locked groups (if unset, defaults to text_color_inactive)", Type: "color", Default: "unset"},
+					{Name: "col.active", Description: "active group bar background color", Type: "gradient", Default: "0x66ffff00"},
+					{Name: "col.inactive", Description: "inactive (out of focus) group bar background color", Type: "gradient", Default: "0x66777700"},
+					{Name: "col.locked_active", Description: "active locked group bar background color", Type: "gradient", Default: "0x66ff5500"},
+					{Name: "col.locked_inactive", Description: "inactive locked group bar background color", Type: "gradient", Default: "0x66775500"},
+					{Name: "gaps_in", Description: "gap size between gradients", Type: "int", Default: "2"},
+					{Name: "gaps_out", Description: "gap size between gradients and window", Type: "int", Default: "2"},
+					{Name: "keep_upper_gap", Description: "add or remove upper gap", Type: "bool", Default: "true"},
+					{Name: "blur", Description: "applies blur to the groupbar indicators and gradients", Type: "bool", Default: "false"},
+				},
+			},
+			{
+				Path:                     []string{"Misc"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "misc",
+				Variables: []VariableDefinition{
+					{Name: "disable_hyprland_logo", Description: "disables the random Hyprland logo / anime girl background. :(", Type: "bool", Default: "false"},
+					{Name: "disable_splash_rendering", Description: "disables the Hyprland splash rendering. (requires a monitor reload to take effect)", Type: "bool", Default: "false"},
+					{Name: "disable_scale_notification", Description: "disables notification popup when a monitor fails to set a suitable scale", Type: "bool", Default: "false"},
+					{Name: "col.splash", Description: "Changes the color of the splash text (requires a monitor reload to take effect).", Type: "color", Default: "0xffffffff"},
+					{Name: "font_family", Description: "Set the global default font to render the text including debug fps/notification, config error messages and etc., selected from system fonts.", Type: "string", Default: "Sans"},
+					{Name: "splash_font_family", Description: "Changes the font used to render the splash text, selected from system fonts (requires a monitor reload to take effect).", Type: "string", Default: "[[Empty]]"},
+					{Name: "force_default_wallpaper", Description: "Enforce any of the 3 default wallpapers. Setting this to 0 or 1 disables the anime background. -1 means \"random\". [-1/0/1/2]", Type: "int", Default: "-1", AllowedValues: []AllowedValue{
+						{Value: "-1", Description: ""},
+						{Value: "0", Description: ""},
+						{Value: "1", Description: ""},
+						{Value: "2", Description: ""},
+					}},
+					{Name: "vfr", Description: "controls the VFR status of Hyprland. Heavily recommended to leave enabled to conserve resources.", Type: "bool", Default: "true"},
+					{Name: "vrr", Description: "controls the VRR (Adaptive Sync) of your monitors. 0 - off, 1 - on, 2 - fullscreen only, 3 - fullscreen with video or game content type [0/1/2/3]", Type: "int", Default: "0", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "off"},
+						{Value: "1", Description: "on"},
+						{Value: "2", Description: "fullscreen only"},
+						{Value: "3", Description: "fullscreen with video or game content type"},
+					}},
+					{Name: "mouse_move_enables_dpms", Description: "If DPMS is set to off, wake up the monitors if the mouse moves.", Type: "bool", Default: "false"},
+					{Name: "key_press_enables_dpms", Description: "If DPMS is set to off, wake up the monitors if a key is pressed.", Type: "bool", Default: "false"},
+					{Name: "name_vk_after_proc", Description: "Name virtual keyboards after the processes that create them. E.g. /usr/bin/fcitx5 will have hl-virtual-keyboard-fcitx5.", Type: "bool", Default: "true"},
+					{Name: "always_follow_on_dnd", Description: "Will make mouse focus follow the mouse when drag and dropping. Recommended to leave it enabled, especially for people using focus follows mouse at 0.", Type: "bool", Default: "true"},
+					{Name: "layers_hog_keyboard_focus", Description: "If true, will make keyboard-interactive layers keep their focus on mouse move (e.g. wofi, bemenu)", Type: "bool", Default: "true"},
+					{Name: "animate_manual_resizes", Description: "If true, will animate manual window resizes/moves", Type: "bool", Default: "false"},
+					{Name: "animate_mouse_windowdragging", Description: "If true, will animate windows being dragged by mouse, note that this can cause weird behavior on some curves", Type: "bool", Default: "false"},
+					{Name: "disable_autoreload", Description: "If true, the config will not reload automatically on save, and instead needs to be reloaded with hyprctl reload. Might save on battery.", Type: "bool", Default: "false"},
+					{Name: "enable_swallow", Description: "Enable window swallowing", Type: "bool", Default: "false"},
+					{Name: "swallow_regex", Description: "The class regex to be used for windows that should be swallowed (usually, a terminal). To know more about the list of regex which can be used use this cheatsheet.", Type: "str", Default: "[[Empty]]"},
+					{Name: "swallow_exception_regex", Description: "The title regex to be used for windows that should not be swallowed by the windows specified in swallow_regex  (e.g. wev). The regex is matched against the parent (e.g. Kitty) window's title on the assumption that it changes to whatever process it's running.", Type: "str", Default: "[[Empty]]"},
+					{Name: "focus_on_activate", Description: "Whether Hyprland should focus an app that requests to be focused (an activate request)", Type: "bool", Default: "false"},
+					{Name: "mouse_move_focuses_monitor", Description: "Whether mouse moving into a different monitor should focus it", Type: "bool", Default: "true"},
+					{Name: "allow_session_lock_restore", Description: "if true, will allow you to restart a lockscreen app in case it crashes", Type: "bool", Default: "false"},
+					{Name: "session_lock_xray", Description: "if true, keep rendering workspaces below your lockscreen", Type: "bool", Default: "false"},
+					{Name: "background_color", Description: "change the background color. (requires enabled disable_hyprland_logo)", Type: "color", Default: "0x111111"},
+					{Name: "close_special_on_empty", Description: "close the special workspace if the last window is removed", Type: "bool", Default: "true"},
+					{Name: "on_focus_under_fullscreen", Description: "if there is a fullscreen or maximized window, decide whether a tiled window requested to focus should replace it, stay behind or disable the fullscreen/maximized state. 0 - ignore focus request (keep focus on fullscreen window), 1 - takes over, 2 - unfullscreen/unmaximize [0/1/2]", Type: "int", Default: "2", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "ignore focus request (keep focus on fullscreen window)"},
+						{Value: "1", Description: "takes over"},
+						{Value: "2", Description: "unfullscreen/unmaximize"},
+					}},
+					{Name: "exit_window_retains_fullscreen", Description: "if true, closing a fullscreen window makes the next focused window fullscreen", Type: "bool", Default: "false"},
+					{Name: "initial_workspace_tracking", Description: "if enabled, windows will open on the workspace they were invoked on. 0 - disabled, 1 - single-shot, 2 - persistent (all children too)", Type: "int", Default: "1", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "disabled"},
+						{Value: "1", Description: "single-shot"},
+						{Value: "2", Description: "persistent (all children too)"},
+					}},
+					{Name: "middle_click_paste", Description: "whether to enable middle-click-paste (aka primary selection)", Type: "bool", Default: "true"},
+					{Name: "render_unfocused_fps", Description: "the maximum limit for render_unfocused windows' fps in the background (see also Window-Rules - render_unfocused)", Type: "int", Default: "15"},
+					{Name: "disable_xdg_env_checks", Description: "disable the warning if XDG environment is externally managed", Type: "bool", Default: "false"},
+					{Name: "disable_hyprland_qtutils_check", Description: "disable the warning if hyprland-qtutils is not installed", Type: "bool", Default: "false"},
+					{Name: "lockdead_screen_delay", Description: "delay after which the \"lockdead\" screen will appear in case a lockscreen app fails to cover all the outputs (5 seconds max)", Type: "int", Default: "1000"},
+					{Name: "enable_anr_dialog", Description: "whether to enable the ANR (app not responding) dialog when your apps hang", Type: "bool", Default: "true"},
+					{Name: "anr_missed_pings", Description: "number of missed pings before showing the ANR dialog", Type: "int", Default: "5"},
+					{Name: "size_limits_tiled", Description: "whether to apply min_size and max_size rules to tiled windows", Type: "bool", Default: "false"},
+					{Name: "disable_watchdog_warning", Description: "whether to disable the warning about not using start-hyprland", Type: "bool", Default: "false"},
+				},
+			},
+			{
+				Path:                     []string{"Layout"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "layout",
+				Variables: []VariableDefinition{
+					{Name: "single_window_aspect_ratio", Description: "whenever only a single window is shown on a screen, add padding so that it conforms to the specified aspect ratio. A value like 4 3 on a 16:9 screen will make it a 4:3 window in the middle with padding to the sides.", Type: "Vec2D", Default: "0 0"},
+					{Name: "single_window_aspect_ratio_tolerance", Description: "sets a tolerance for single_window_aspect_ratio, so that if the padding that would have been added is smaller than the specified fraction of the height or width of the screen, it will not attempt to adjust the window size [0 - 1]", Type: "int", Default: "0.1", Bounds: &ValueBounds{Min: 0, Max: 1}},
+				},
+			},
+			{
+				Path:                     []string{"Binds"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "binds",
+				Variables: []VariableDefinition{
+					{Name: "pass_mouse_when_bound", Description: "if disabled, will not pass the mouse events to apps / dragging windows around if a keybind has been triggered.", Type: "bool", Default: "false"},
+					{Name: "scroll_event_delay", Description: "in ms, how many ms to wait after a scroll event to allow passing another one for the binds.", Type: "int", Default: "300"},
+					{Name: "workspace_back_and_forth", Description: "If enabled, an attempt to switch to the currently focused workspace will instead switch to the previous workspace. Akin to i3's auto_back_and_forth.", Type: "bool", Default: "false"},
+					{Name: "hide_special_on_workspace_change", Description: "If enabled, changing the active workspace (including to itself) will hide the special workspace on the monitor where the newly active workspace resides.", Type: "bool", Default: "false"},
+					{Name: "allow_workspace_cycles", Description: "If enabled, workspaces don't forget their previous workspace, so cycles can be created by switching to the first workspace in a sequence, then endlessly going to the previous workspace.", Type: "bool", Default: "false"},
+					{Name: "workspace_center_on", Description: "Whether switching workspaces should center the cursor on the workspace (0) or on the last active window for that workspace (1)", Type: "int", Default: "0"},
+					{Name: "focus_preferred_method", Description: "sets the preferred focus finding method when using focuswindow/movewindow/etc with a direction. 0 - history (recent have priority), 1 - length (longer shared edges have priority)", Type: "int", Default: "0", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "history (recent have priority)"},
+						{Value: "1", Description: "length (longer shared edges have priority)"},
+					}},
+					{Name: "ignore_group_lock", Description: "If enabled, dispatchers like moveintogroup, moveoutofgroup and movewindoworgroup will ignore lock per group.", Type: "bool", Default: "false"},
+					{Name: "movefocus_cycles_fullscreen", Description: "If enabled, when on a fullscreen window, movefocus will cycle fullscreen, if not, it will move the focus in a direction.", Type: "bool", Default: "false"},
+					{Name: "movefocus_cycles_groupfirst", Description: "If enabled, when in a grouped window, movefocus will cycle windows in the groups first, then at each ends of tabs, it'll move on to other windows/groups", Type: "bool", Default: "false"},
+					{Name: "disable_keybind_grabbing", Description: "If enabled, apps that request keybinds to be disabled (e.g. VMs) will not be able to do so.", Type: "bool", Default: "false"},
+					{Name: "allow_pin_fullscreen", Description: "If enabled, Allow fullscreen to pinned windows, and restore their pinned status afterwards", Type: "bool", Default: "false"},
+					{Name: "drag_threshold", Description: "Movement threshold in pixels for window dragging and c/g bind flags. 0 to disable and grab on mousedown.", Type: "int", Default: "0"},
+				},
+			},
+			{
+				Path:                     []string{"XWayland"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "xwayland",
+				Variables: []VariableDefinition{
+					{Name: "enabled", Description: "allow running applications using X11", Type: "bool", Default: "true"},
+					{Name: "use_nearest_neighbor", Description: "uses the nearest neighbor filtering for xwayland apps, making them pixelated rather than blurry", Type: "bool", Default: "true"},
+					{Name: "force_zero_scaling", Description: "forces a scale of 1 on xwayland windows on scaled displays.", Type: "bool", Default: "false"},
+					{Name: "create_abstract_socket", Description: "Create the abstract Unix domain socket for XWayland connections. (XWayland restart is required for changes to take effect; Linux only)", Type: "bool", Default: "false"},
+				},
+			},
+			{
+				Path:                     []string{"OpenGL"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "opengl",
+				Variables: []VariableDefinition{
+					{Name: "nvidia_anti_flicker", Description: "reduces flickering on nvidia at the cost of possible frame drops on lower-end GPUs. On non-nvidia, this is ignored.", Type: "bool", Default: "true"},
+				},
+			},
+			{
+				Path:                     []string{"Render"},
+				Description:              "`cm_auto_hdr` requires `--target-colorspace-hint-mode=source` mpv option to work with mpv versions greater than v0.40.0",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "render",
+				Variables: []VariableDefinition{
+					{Name: "direct_scanout", Description: "Enables direct scanout. Direct scanout attempts to reduce lag when there is only one fullscreen application on a screen (e.g. game). It is also recommended to set this to false if the fullscreen application shows graphical glitches. 0 - off, 1 - on, 2 - auto (on with content type 'game')", Type: "int", Default: "0", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "off"},
+						{Value: "1", Description: "on"},
+						{Value: "2", Description: "auto (on with content type 'game')"},
+					}},
+					{Name: "expand_undersized_textures", Description: "Whether to expand undersized textures along the edge, or rather stretch the entire texture.", Type: "bool", Default: "true"},
+					{Name: "xp_mode", Description: "Disables back buffer and bottom layer rendering.", Type: "bool", Default: "false"},
+					{Name: "ctm_animation", Description: "Whether to enable a fade animation for CTM changes (hyprsunset). 2 means \"auto\" which disables them on Nvidia.", Type: "int", Default: "2"},
+					{Name: "cm_fs_passthrough", Description: "Passthrough color settings for fullscreen apps when possible. 0 - off, 1 - always, 2 - hdr only", Type: "int", Default: "2", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "off"},
+						{Value: "1", Description: "always"},
+						{Value: "2", Description: "hdr only"},
+					}},
+					{Name: "cm_enabled", Description: "Whether the color management pipeline should be enabled or not (requires a restart of Hyprland to fully take effect)", Type: "bool", Default: "true"},
+					{Name: "send_content_type", Description: "Report content type to allow monitor profile autoswitch (may result in a black screen during the switch)", Type: "bool", Default: "true"},
+					{Name: "cm_auto_hdr", Description: "Auto-switch to HDR in fullscreen when needed. 0 - off, 1 - switch to cm, hdr, 2 - switch to cm, hdredid", Type: "int", Default: "1", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "off"},
+						{Value: "1", Description: "switch to cm, hdr"},
+						{Value: "2", Description: "switch to cm, hdredid"},
+					}},
+					{Name: "new_render_scheduling", Description: "Automatically uses triple buffering when needed, improves FPS on underpowered devices.", Type: "bool", Default: "false"},
+					{Name: "non_shader_cm", Description: "Enable CM without shader. 0 - disable, 1 - whenever possible, 2 - DS and passthrough only, 3 - disable and ignore CM issues", Type: "int", Default: "3", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "disable"},
+						{Value: "1", Description: "whenever possible"},
+						{Value: "2", Description: "DS and passthrough only"},
+						{Value: "3", Description: "disable and ignore CM issues"},
+					}},
+					{Name: "cm_sdr_eotf", Description: "Default transfer function for displaying SDR apps. default - Use default value (Gamma 2.2), gamma22 - Treat unspecified as Gamma 2.2, gamma22force - Treat unspecified and sRGB as Gamma 2.2, srgb - Treat unspecified as sRGB", Type: "str", Default: "default", AllowedValues: []AllowedValue{
+						{Value: "default", Description: "Use default value (Gamma 2.2)"},
+						{Value: "gamma22", Description: "Treat unspecified as Gamma 2.2"},
+						{Value: "gamma22force", Description: "Treat unspecified and sRGB as Gamma 2.2"},
+						{Value: "srgb", Description: "Treat unspecified as sRGB"},
+					}},
+				},
+			},
+			{
+				Path:                     []string{"Cursor"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "cursor",
+				Variables: []VariableDefinition{
+					{Name: "invisible", Description: "don't render cursors", Type: "bool", Default: "false"},
+					{Name: "sync_gsettings_theme", Description: "sync xcursor theme with gsettings, it applies cursor-theme and cursor-size on theme load to gsettings making most CSD gtk based clients use same xcursor theme and size.", Type: "bool", Default: "true"},
+					{Name: "no_hardware_cursors", Description: "disables hardware cursors. 0 - use hw cursors if possible, 1 - don't use hw cursors, 2 - auto (disable when tearing)", Type: "int", Default: "2", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "use hw cursors if possible"},
+						{Value: "1", Description: "don't use hw cursors"},
+						{Value: "2", Description: "auto (disable when tearing)"},
+					}},
+					{Name: "no_break_fs_vrr", Description: "disables scheduling new frames on cursor movement for fullscreen apps with VRR enabled to avoid framerate spikes (may require no_hardware_cursors = true) 0 - off, 1 - on, 2 - auto (on with content type 'game')", Type: "int", Default: "2", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "off"},
+						{Value: "1", Description: "on"},
+						{Value: "2", Description: "auto (on with content type 'game')"},
+					}},
+					{Name: "min_refresh_rate", Description: "minimum refresh rate for cursor movement when no_break_fs_vrr is active. Set to minimum supported refresh rate or higher", Type: "int", Default: "24"},
+					{Name: "hotspot_padding", Description: "the padding, in logical px, between screen edges and the cursor", Type: "int", Default: "1"},
+					{Name: "inactive_timeout", Description: "in seconds, after how many seconds of cursor's inactivity to hide it. Set to 0 for never.", Type: "float", Default: "0"},
+					{Name: "no_warps", Description: "if true, will not warp the cursor in many cases (focusing, keybinds, etc)", Type: "bool", Default: "false"},
+					{Name: "persistent_warps", Description: "When a window is refocused, the cursor returns to its last position relative to that window, rather than to the centre.", Type: "bool", Default: "false"},
+					{Name: "warp_on_change_workspace", Description: "Move the cursor to the last focused window after changing the workspace. Options: 0 (Disabled), 1 (Enabled), 2 (Force - ignores cursor:no_warps option)", Type: "int", Default: "0", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "Disabled"},
+						{Value: "1", Description: "Enabled"},
+						{Value: "2", Description: "Force - ignores cursor:no_warps option"},
+					}},
+					{Name: "warp_on_toggle_special", Description: "Move the cursor to the last focused window when toggling a special workspace. Options: 0 (Disabled), 1 (Enabled), 2 (Force - ignores cursor:no_warps option)", Type: "int", Default: "0", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "Disabled"},
+						{Value: "1", Description: "Enabled"},
+						{Value: "2", Description: "Force - ignores cursor:no_warps option"},
+					}},
+					{Name: "default_monitor", Description: "the name of a default monitor for the cursor to be set to on startup (see hyprctl monitors for names)", Type: "str", Default: "[[EMPTY]]"},
+					{Name: "zoom_factor", Description: "the factor to zoom by around the cursor. Like a magnifying glass. Minimum 1.0 (meaning no zoom)", Type: "float", Default: "1.0"},
+					{Name: "zoom_rigid", Description: "whether the zoom should follow the cursor rigidly (cursor is always centered if it can be) or loosely", Type: "bool", Default: "false"},
+					{Name: "zoom_detached_camera", Description: "detach the camera from the mouse when zoomed in, only ever moving the camera to keep the mouse in view when it goes past the screen edges", Type: "bool", Default: "true"},
+					{Name: "enable_hyprcursor", Description: "whether to enable hyprcursor support", Type: "bool", Default: "true"},
+					{Name: "hide_on_key_press", Description: "Hides the cursor when you press any key until the mouse is moved.", Type: "bool", Default: "false"},
+					{Name: "hide_on_touch", Description: "Hides the cursor when the last input was a touch input until a mouse input is done.", Type: "bool", Default: "true"},
+					{Name: "hide_on_tablet", Description: "Hides the cursor when the last input was a tablet input until a mouse input is done.", Type: "bool", Default: "true"},
+					{Name: "use_cpu_buffer", Description: "Makes HW cursors use a CPU buffer. Required on Nvidia to have HW cursors. 0 - off, 1 - on, 2 - auto (nvidia only)", Type: "int", Default: "2", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "off"},
+						{Value: "1", Description: "on"},
+						{Value: "2", Description: "auto (nvidia only)"},
+					}},
+					{Name: "warp_back_after_non_mouse_input", Description: "Warp the cursor back to where it was after using a non-mouse input to move it, and then returning back to mouse.", Type: "bool", Default: "false"},
+					{Name: "zoom_disable_aa", Description: "disable antialiasing when zooming, which means things will be pixelated instead of blurry", Type: "bool", Default: "false"},
+				},
+			},
+			{
+				Path:                     []string{"Ecosystem"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "ecosystem",
+				Variables: []VariableDefinition{
+					{Name: "no_update_news", Description: "disable the popup that shows up when you update hyprland to a new version.", Type: "bool", Default: "false"},
+					{Name: "no_donation_nag", Description: "disable the popup that shows up twice a year encouraging to donate.", Type: "bool", Default: "false"},
+					{Name: "enforce_permissions", Description: "whether to enable permission control.", Type: "bool", Default: "false"},
+				},
+			},
+			{
+				Path:                     []string{"Quirks"},
+				Description:              "Some clients expect monitor to be in HDR mode prior to the client start. This breaks auto HDR activation and can cause whitescreen and flickering. Use `prefer_hdr` to fix it,",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "quirks",
+				Variables: []VariableDefinition{
+					{Name: "prefer_hdr", Description: "Report HDR mode as preferred. 0 - off, 1 - always, 2 - gamescope only", Type: "int", Default: "0", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "off"},
+						{Value: "1", Description: "always"},
+						{Value: "2", Description: "gamescope only"},
+					}},
+				},
+			},
+			{
+				Path:                     []string{"Debug"},
+				Description:              "Only for developers.",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "debug",
+				Variables: []VariableDefinition{
+					{Name: "overlay", Description: "print the debug performance overlay. Disable VFR for accurate results.", Type: "bool", Default: "false"},
+					{Name: "damage_blink", Description: "(epilepsy warning!) flash areas updated with damage tracking", Type: "bool", Default: "false"},
+					{Name: "gl_debugging", Description: "enables OpenGL debugging with glGetError and EGL_KHR_debug, requires a restart after changing.", Type: "bool", Default: "false"},
+					{Name: "disable_logs", Description: "disable logging to a file", Type: "bool", Default: "true"},
+					{Name: "disable_time", Description: "disables time logging", Type: "bool", Default: "true"},
+					{Name: "damage_tracking", Description: "redraw only the needed bits of the display. Do not change. (default: full - 2) monitor - 1, none - 0", Type: "int", Default: "2"},
+					{Name: "enable_stdout_logs", Description: "enables logging to stdout", Type: "bool", Default: "false"},
+					{Name: "manual_crash", Description: "set to 1 and then back to 0 to crash Hyprland.", Type: "int", Default: "0"},
+					{Name: "suppress_errors", Description: "if true, do not display config file parsing errors.", Type: "bool", Default: "false"},
+					{Name: "watchdog_timeout", Description: "sets the timeout in seconds for watchdog to abort processing of a signal of the main thread. Set to 0 to disable.", Type: "int", Default: "5"},
+					{Name: "disable_scale_checks", Description: "disables verification of the scale factors. Will result in pixel alignment and rounding errors.", Type: "bool", Default: "false"},
+					{Name: "error_limit", Description: "limits the number of displayed config file parsing errors.", Type: "int", Default: "5"},
+					{Name: "error_position", Description: "sets the position of the error bar. top - 0, bottom - 1", Type: "int", Default: "0"},
+					{Name: "colored_stdout_logs", Description: "enables colors in the stdout logs.", Type: "bool", Default: "true"},
+					{Name: "pass", Description: "enables render pass debugging.", Type: "bool", Default: "false"},
+					{Name: "full_cm_proto", Description: "claims support for all cm proto features (requires restart)", Type: "bool", Default: "false"},
+				},
+			},
+			{
+				Path:                     []string{"Master"},
+				Description:              "The master layout makes one (or more) window(s) be the \"master\", taking (by default) the left part of the screen, and tiles the rest on the right. You can change the orientation on a per-workspace basis if you want to use anything other than the default left/right split.",
+				DocumentationFile:        "Master-Layout",
+				DocumentationHeadingSlug: "config",
+				Variables: []VariableDefinition{
+					{Name: "allow_small_split", Description: "enable adding additional master windows in a horizontal split style", Type: "bool", Default: "false"},
+					{Name: "special_scale_factor", Description: "the scale of the special workspace windows. [0.0 - 1.0]", Type: "float", Default: "1", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "mfact", Description: "the size as a percentage of the master window, for example mfact = 0.70 would mean 70% of the screen will be the master window, and 30% the slave [0.0 - 1.0]", Type: "floatvalue", Default: "0.55", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "new_status", Description: "master: new window becomes master; slave: new windows are added to slave stack; inherit: inherit from focused window", Type: "string", Default: "slave"},
+					{Name: "new_on_top", Description: "whether a newly open window should be on the top of the stack", Type: "bool", Default: "false"},
+					{Name: "new_on_active", Description: "before, after: place new window relative to the focused window; none: place new window according to the value of new_on_top.", Type: "string", Default: "none"},
+					{Name: "orientation", Description: "default placement of the master area, can be left, right, top, bottom or center", Type: "string", Default: "left"},
+					{Name: "slave_count_for_center_master", Description: "when using orientation=center, make the master window centered only when at least this many slave windows are open. (Set 0 to always_center_master)", Type: "int", Default: "2"},
+					{Name: "center_master_fallback", Description: "Set fallback for center master when slaves are less than slave_count_for_center_master, can be left ,right ,top ,bottom", Type: "string", Default: "left"},
+					{Name: "smart_resizing", Description: "if enabled, resizing direction will be determined by the mouse's position on the window (nearest to which corner). Else, it is based on the window's tiling position.", Type: "bool", Default: "true"},
+					{Name: "drop_at_cursor", Description: "when enabled, dragging and dropping windows will put them at the cursor position. Otherwise, when dropped at the stack side, they will go to the top/bottom of the stack depending on new_on_top.", Type: "bool", Default: "true"},
+					{Name: "always_keep_position", Description: "whether to keep the master window in its configured position when there are no slave windows", Type: "bool", Default: "false"},
+					{Name: "no_gaps_when_only", Description: "Removed in Hyprland 0.45.0, use workspace rules instead, see https://wiki.hyprland.org/Configuring/Workspace-Rules/#smart-gaps", Type: "int", Default: "0"},
+				},
+			},
+			{
+				Path:                     []string{"Dwindle"},
+				Description:              "Dwindle is a BSPWM-like layout, where every window on a workspace is a member of a binary tree.",
+				DocumentationFile:        "Dwindle-Layout",
+				DocumentationHeadingSlug: "config",
+				Variables: []VariableDefinition{
+					{Name: "pseudotile", Description: "enable pseudotiling. Pseudotiled windows retain their floating size when tiled.", Type: "bool", Default: "false"},
+					{Name: "force_split", Description: "0 -> split follows mouse, 1 -> always split to the left (new = left or top) 2 -> always split to the right (new = right or bottom)", Type: "int", Default: "0", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "split follows mouse"},
+						{Value: "1", Description: "always split to the left (new = left or top)"},
+						{Value: "2", Description: "always split to the right (new = right or bottom)"},
+					}},
+					{Name: "preserve_split", Description: "if enabled, the split (side/top) will not change regardless of what happens to the container.", Type: "bool", Default: "false"},
+					{Name: "smart_split", Description: "if enabled, allows a more precise control over the window split direction based on the cursor's position. The window is conceptually divided into four triangles, and cursor's triangle determines the split direction. This feature also turns on preserve_split.", Type: "bool", Default: "false"},
+					{Name: "smart_resizing", Description: "if enabled, resizing direction will be determined by the mouse's position on the window (nearest to which corner). Else, it is based on the window's tiling position.", Type: "bool", Default: "true"},
+					{Name: "permanent_direction_override", Description: "if enabled, makes the preselect direction persist until either this mode is turned off, another direction is specified, or a non-direction is specified (anything other than l,r,u/t,d/b)", Type: "bool", Default: "false"},
+					{Name: "special_scale_factor", Description: "specifies the scale factor of windows on the special workspace [0 - 1]", Type: "float", Default: "1", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "split_width_multiplier", Description: "specifies the auto-split width multiplier. Multiplying window size is useful on widescreen monitors where window W > H even after several splits.", Type: "float", Default: "1.0"},
+					{Name: "use_active_for_splits", Description: "whether to prefer the active window or the mouse position for splits", Type: "bool", Default: "true"},
+					{Name: "default_split_ratio", Description: "the default split ratio on window open. 1 means even 50/50 split. [0.1 - 1.9]", Type: "float", Default: "1.0", Bounds: &ValueBounds{Min: 0.1, Max: 1.9}},
+					{Name: "split_bias", Description: "specifies which window will receive the split ratio. 0 -> directional (the top or left window), 1 -> the current window", Type: "int", Default: "0", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "directional (the top or left window)"},
+						{Value: "1", Description: "the current window"},
+					}},
+					{Name: "precise_mouse_move", Description: "bindm movewindow will drop the window more precisely depending on where your mouse is.", Type: "bool", Default: "false"},
+					{Name: "no_gaps_when_only", Description: "Removed in Hyprland 0.45.0, use workspace rules instead, see https://wiki.hyprland.org/Configuring/Workspace-Rules/#smart-gaps", Type: "int", Default: "0"},
+				},
+			},
+		},
+	},
+	{
+		Version: "0.45.0",
+		Until:   "0.51.0",
+		Sections: []SectionDefinition{
+			{
+				Path:                     []string{"General"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "general",
+				Variables: []VariableDefinition{
+					{Name: "border_size", Description: "size of the border around windows", Type: "int", Default: "1"},
+					{Name: "gaps_in", Description: "gaps between windows, also supports css style gaps (top, right, bottom, left -> 5,10,15,20)", Type: "int", Default: "5"},
+					{Name: "gaps_out", Description: "gaps between windows and monitor edges, also supports css style gaps (top, right, bottom, left -> 5,10,15,20)", Type: "int", Default: "20"},
+					{Name: "float_gaps", Description: "gaps between windows and monitor edges for floating windows, also supports css style gaps (top, right, bottom, left -> 5 10 15 20). -1 means default", Type: "int", Default: "0"},
+					{Name: "gaps_workspaces", Description: "gaps between workspaces. Stacks with gaps_out.", Type: "int", Default: "0"},
+					{Name: "col.inactive_border", Description: "border color for inactive windows", Type: "gradient", Default: "0xff444444"},
+					{Name: "col.active_border", Description: "border color for the active window", Type: "gradient", Default: "0xffffffff"},
+					{Name: "col.nogroup_border", Description: "inactive border color for window that cannot be added to a group (see denywindowfromgroup dispatcher)", Type: "gradient", Default: "0xffffaaff"},
+					{Name: "col.nogroup_border_active", Description: "active border color for window that cannot be added to a group", Type: "gradient", Default: "0xffff00ff"},
+					{Name: "layout", Description: "which layout to use. [dwindle/master/scrolling/monocle]", Type: "str", Default: "dwindle", AllowedValues: []AllowedValue{
+						{Value: "dwindle", Description: ""},
+						{Value: "master", Description: ""},
+						{Value: "scrolling", Description: ""},
+						{Value: "monocle", Description: ""},
+					}},
+					{Name: "no_focus_fallback", Description: "if true, will not fall back to the next available window when moving focus in a direction where no window was found", Type: "bool", Default: "false"},
+					{Name: "resize_on_border", Description: "enables resizing windows by clicking and dragging on borders and gaps", Type: "bool", Default: "false"},
+					{Name: "extend_border_grab_area", Description: "extends the area around the border where you can click and drag on, only used when general:resize_on_border is on.", Type: "int", Default: "15"},
+					{Name: "hover_icon_on_border", Description: "show a cursor icon when hovering over borders, only used when general:resize_on_border is on.", Type: "bool", Default: "true"},
+					{Name: "allow_tearing", Description: "master switch for allowing tearing to occur. See the Tearing page.", Type: "bool", Default: "false"},
+					{Name: "resize_corner", Description: "force floating windows to use a specific corner when being resized (1-4 going clockwise from top left, 0 to disable)", Type: "int", Default: "0"},
+					{Name: "modal_parent_blocking", Description: "whether parent windows of modals will be interactive", Type: "bool", Default: "true"},
+					{Name: "locale", Description: "overrides the system locale (e.g. en_US, es)", Type: "str", Default: "[[Empty]]"},
+					{Name: "autogenerated", Description: "Whether this configuration was autogenerated", Type: "bool", Default: "1"},
+				},
+			},
+			{
+				Path:                     []string{"General", "Snap"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "snap",
+				Variables: []VariableDefinition{
+					{Name: "enabled", Description: "enable snapping for floating windows", Type: "bool", Default: "false"},
+					{Name: "window_gap", Description: "minimum gap in pixels between windows before snapping", Type: "int", Default: "10"},
+					{Name: "monitor_gap", Description: "minimum gap in pixels between window and monitor edges before snapping", Type: "int", Default: "10"},
+					{Name: "border_overlap", Description: "if true, windows snap such that only one border's worth of space is between them", Type: "bool", Default: "false"},
+					{Name: "respect_gaps", Description: "if true, snapping will respect gaps between windows(set in general:gaps_in)", Type: "bool", Default: "false"},
+				},
+			},
+			{
+				Path:                     []string{"Decoration"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "decoration",
+				Variables: []VariableDefinition{
+					{Name: "rounding", Description: "rounded corners' radius (in layout px)", Type: "int", Default: "0"},
+					{Name: "rounding_power", Description: "adjusts the curve used for rounding corners, larger is smoother, 2.0 is a circle, 4.0 is a squircle, 1.0 is a triangular corner. [1.0 - 10.0]", Type: "float", Default: "2.0", Bounds: &ValueBounds{Min: 1, Max: 10}},
+					{Name: "active_opacity", Description: "opacity of active windows. [0.0 - 1.0]", Type: "float", Default: "1.0", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "inactive_opacity", Description: "opacity of inactive windows. [0.0 - 1.0]", Type: "float", Default: "1.0", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "fullscreen_opacity", Description: "opacity of fullscreen windows. [0.0 - 1.0]", Type: "float", Default: "1.0", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "dim_modal", Description: "enables dimming of parents of modal windows", Type: "bool", Default: "true"},
+					{Name: "dim_inactive", Description: "enables dimming of inactive windows", Type: "bool", Default: "false"},
+					{Name: "dim_strength", Description: "how much inactive windows should be dimmed [0.0 - 1.0]", Type: "float", Default: "0.5", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "dim_special", Description: "how much to dim the rest of the screen by when a special workspace is open. [0.0 - 1.0]", Type: "float", Default: "0.2", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "dim_around", Description: "how much the dim_around window rule should dim by. [0.0 - 1.0]", Type: "float", Default: "0.4", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "screen_shader", Description: "a path to a custom shader to be applied at the end of rendering. See examples/screenShader.frag for an example.", Type: "str", Default: "[[Empty]]"},
+					{Name: "border_part_of_window", Description: "whether the window border should be a part of the window", Type: "bool", Default: "true"},
+				},
+			},
+			{
+				Path:                     []string{"Decoration", "Blur"},
+				Description:              "`blur:size` and `blur:passes` have to be at least 1.",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "blur",
+				Variables: []VariableDefinition{
+					{Name: "enabled", Description: "enable kawase window background blur", Type: "bool", Default: "true"},
+					{Name: "size", Description: "blur size (distance)", Type: "int", Default: "8"},
+					{Name: "passes", Description: "the amount of passes to perform", Type: "int", Default: "1"},
+					{Name: "ignore_opacity", Description: "make the blur layer ignore the opacity of the window", Type: "bool", Default: "true"},
+					{Name: "new_optimizations", Description: "whether to enable further optimizations to the blur. Recommended to leave on, as it will massively improve performance.", Type: "bool", Default: "true"},
+					{Name: "xray", Description: "if enabled, floating windows will ignore tiled windows in their blur. Only available if new_optimizations is true. Will reduce overhead on floating blur significantly.", Type: "bool", Default: "false"},
+					{Name: "noise", Description: "how much noise to apply. [0.0 - 1.0]", Type: "float", Default: "0.0117", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "contrast", Description: "contrast modulation for blur. [0.0 - 2.0]", Type: "float", Default: "0.8916", Bounds: &ValueBounds{Min: 0, Max: 2}},
+					{Name: "brightness", Description: "brightness modulation for blur. [0.0 - 2.0]", Type: "float", Default: "0.8172", Bounds: &ValueBounds{Min: 0, Max: 2}},
+					{Name: "vibrancy", Description: "Increase saturation of blurred colors. [0.0 - 1.0]", Type: "float", Default: "0.1696", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "vibrancy_darkness", Description: "How strong the effect of vibrancy is on dark areas . [0.0 - 1.0]", Type: "float", Default: "0.0", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "special", Description: "whether to blur behind the special workspace (note: expensive)", Type: "bool", Default: "false"},
+					{Name: "popups", Description: "whether to blur popups (e.g. right-click menus)", Type: "bool", Default: "false"},
+					{Name: "popups_ignorealpha", Description: "works like ignore_alpha in layer rules. If pixel opacity is below set value, will not blur. [0.0 - 1.0]", Type: "float", Default: "0.2", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "input_methods", Description: "whether to blur input methods (e.g. fcitx5)", Type: "bool", Default: "false"},
+					{Name: "input_methods_ignorealpha", Description: "works like ignore_alpha in layer rules. If pixel opacity is below set value, will not blur. [0.0 - 1.0]", Type: "float", Default: "0.2", Bounds: &ValueBounds{Min: 0, Max: 1}},
+				},
+			},
+			{
+				Path:                     []string{"Decoration", "Shadow"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "shadow",
+				Variables: []VariableDefinition{
+					{Name: "enabled", Description: "enable drop shadows on windows", Type: "bool", Default: "true"},
+					{Name: "range", Description: "Shadow range (\"size\") in layout px", Type: "int", Default: "4"},
+					{Name: "render_power", Description: "in what power to render the falloff (more power, the faster the falloff) [1 - 4]", Type: "int", Default: "3", Bounds: &ValueBounds{Min: 1, Max: 4}},
+					{Name: "sharp", Description: "if enabled, will make the shadows sharp, akin to an infinite render power", Type: "bool", Default: "false"},
+					{Name: "ignore_window", Description: "if true, the shadow will not be rendered behind the window itself, only around it.", Type: "bool", Default: "true"},
+					{Name: "color", Description: "shadow's color. Alpha dictates shadow's opacity.", Type: "color", Default: "0xee1a1a1a"},
+					{Name: "color_inactive", Description: "inactive shadow color. (if not set, will fall back to color)", Type: "color", Default: "unset"},
+					{Name: "offset", Description: "shadow's rendering offset.", Type: "vec2", Default: "[0, 0]"},
+					{Name: "scale", Description: "shadow's scale. [0.0 - 1.0]", Type: "float", Default: "1.0", Bounds: &ValueBounds{Min: 0, Max: 1}},
+				},
+			},
+			{
+				Path:                     []string{"Animations"},
+				Description:              "_[More about Animations](https://wiki.hyprland.org/Configuring/Animations)._",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "animations",
+				Variables: []VariableDefinition{
+					{Name: "enabled", Description: "enable animations", Type: "bool", Default: "true"},
+					{Name: "workspace_wraparound", Description: "enable workspace wraparound, causing directional workspace animations to animate as if the first and last workspaces were adjacent", Type: "bool", Default: "false"},
+				},
+			},
+			{
+				Path:                     []string{"Input"},
+				Description:              "You can find a list of models, layouts, variants and options in [`/usr/share/X11/xkb/rules/evdev.lst`](). Alternatively, you can use the `localectl` command to discover what is available on your system.",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "input",
+				Variables: []VariableDefinition{
+					{Name: "kb_model", Description: "Appropriate XKB keymap parameter. See the note below.", Type: "str", Default: "[[Empty]]"},
+					{Name: "kb_layout", Description: "Appropriate XKB keymap parameter", Type: "str", Default: "us"},
+					{Name: "kb_variant", Description: "Appropriate XKB keymap parameter", Type: "str", Default: "[[Empty]]"},
+					{Name: "kb_options", Description: "Appropriate XKB keymap parameter", Type: "str", Default: "[[Empty]]"},
+					{Name: "kb_rules", Description: "Appropriate XKB keymap parameter", Type: "str", Default: "[[Empty]]"},
+					{Name: "kb_file", Description: "If you prefer, you can use a path to your custom .xkb file.", Type: "str", Default: "[[Empty]]"},
+					{Name: "numlock_by_default", Description: "Engage numlock by default.", Type: "bool", Default: "false"},
+					{Name: "resolve_binds_by_sym", Description: "Determines how keybinds act when multiple layouts are used. If false, keybinds will always act as if the first specified layout is active. If true, keybinds specified by symbols are activated when you type the respective symbol with the current layout.", Type: "bool", Default: "false"},
+					{Name: "repeat_rate", Description: "The repeat rate for held-down keys, in repeats per second.", Type: "int", Default: "25"},
+					{Name: "repeat_delay", Description: "Delay before a held-down key is repeated, in milliseconds.", Type: "int", Default: "600"},
+					{Name: "sensitivity", Description: "Sets the mouse input sensitivity. Value is clamped to the range -1.0 to 1.0. libinput#pointer-acceleration", Type: "float", Default: "0.0"},
+					{Name: "accel_profile", Description: "Sets the cursor acceleration profile. Can be one of adaptive, flat. Can also be custom, see below. Leave empty to use libinput's default mode for your input device. libinput#pointer-acceleration [adaptive/flat/custom]", Type: "str", Default: "[[Empty]]", AllowedValues: []AllowedValue{
+						{Value: "adaptive", Description: ""},
+						{Value: "flat", Description: ""},
+						{Value: "custom", Description: "", Parameters: "<step> <points...>"},
+					}},
+					{Name: "force_no_accel", Description: "Force no cursor acceleration. This bypasses most of your pointer settings to get as raw of a signal as possible. Enabling this is not recommended due to potential cursor desynchronization.", Type: "bool", Default: "false"},
+					{Name: "rotation", Description: "Sets the rotation of a device in degrees clockwise off the logical neutral position. Value is clamped to the range 0 to 359.", Type: "int", Default: "0"},
+					{Name: "left_handed", Description: "Switches RMB and LMB", Type: "bool", Default: "false"},
+					{Name: "scroll_points", Description: "Sets the scroll acceleration profile, when accel_profile is set to custom. Has to be in the form <step> <points>. Leave empty to have a flat scroll curve.", Type: "str", Default: "[[Empty]]"},
+					{Name: "scroll_method", Description: "Sets the scroll method. Can be one of 2fg (2 fingers), edge, on_button_down, no_scroll. libinput#scrolling [2fg/edge/on_button_down/no_scroll]", Type: "str", Default: "[[Empty]]", AllowedValues: []AllowedValue{
+						{Value: "2fg", Description: ""},
+						{Value: "edge", Description: ""},
+						{Value: "on_button_down", Description: ""},
+						{Value: "no_scroll", Description: ""},
+					}},
+					{Name: "scroll_button", Description: "Sets the scroll button. Has to be an int, cannot be a string. Check wev if you have any doubts regarding the ID. 0 means default.", Type: "int", Default: "0"},
+					{Name: "scroll_button_lock", Description: "If the scroll button lock is enabled, the button does not need to be held down. Pressing and releasing the button toggles the button lock, which logically holds the button down or releases it. While the button is logically held down, motion events are converted to scroll events.", Type: "bool", Default: "false"},
+					{Name: "scroll_factor", Description: "Multiplier added to scroll movement for external mice. Note that there is a separate setting for touchpad scroll_factor.", Type: "float", Default: "1.0"},
+					{Name: "natural_scroll", Description: "Inverts scrolling direction. When enabled, scrolling moves content directly, rather than manipulating a scrollbar.", Type: "bool", Default: "false"},
+					{Name: "follow_mouse", Description: "Specify if and how cursor movement should affect window focus. See the note below. [0/1/2/3]", Type: "int", Default: "1", AllowedValues: []AllowedValue{
+						{Value: "0", Description: ""},
+						{Value: "1", Description: ""},
+						{Value: "2", Description: ""},
+						{Value: "3", Description: ""},
+					}},
+					{Name: "follow_mouse_threshold", Description: "The smallest distance in logical pixels the mouse needs to travel for the window under it to get focused. Works only with follow_mouse = 1.", Type: "float", Default: "0.0"},
+					{Name: "focus_on_close", Description: "Controls the window focus behavior when a window is closed. When set to 0, focus will shift to the next window candidate. When set to 1, focus will shift to the window under the cursor. [0/1]", Type: "int", Default: "0", AllowedValues: []AllowedValue{
+						{Value: "0", Description: ""},
+						{Value: "1", Description: ""},
+					}},
+					{Name: "mouse_refocus", Description: "If disabled, mouse focus won't switch to the hovered window unless the mouse crosses a window boundary when follow_mouse=1.", Type: "bool", Default: "true"},
+					{Name: "float_switch_override_focus", Description: "If enabled (1 or 2), focus will change to the window under the cursor when changing from tiled-to-floating and vice versa. If 2, focus will also follow mouse on float-to-float switches.", Type: "int", Default: "1"},
+					{Name: "special_fallthrough", Description: "if enabled, having only floating windows in the special workspace will not block focusing windows in the regular workspace.", Type: "bool", Default: "false"},
+					{Name: "off_window_axis_events", Description: "Handles axis events around (gaps/border for tiled, dragarea/border for floated) a focused window. 0 ignores axis events 1 sends out-of-bound coordinates 2 fakes pointer coordinates to the closest point inside the window 3 warps the cursor to the closest point inside the window", Type: "int", Default: "1"},
+					{Name: "emulate_discrete_scroll", Description: "Emulates discrete scrolling from high resolution scrolling events. 0 disables it, 1 enables handling of non-standard events only, and 2 force enables all scroll wheel events to be handled", Type: "int", Default: "1"},
+				},
+			},
+			{
+				Path:                     []string{"Input", "Touchpad"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "touchpad",
+				Variables: []VariableDefinition{
+					{Name: "disable_while_typing", Description: "Disable the touchpad while typing.", Type: "bool", Default: "true"},
+					{Name: "natural_scroll", Description: "Inverts scrolling direction. When enabled, scrolling moves content directly, rather than manipulating a scrollbar.", Type: "bool", Default: "false"},
+					{Name: "scroll_factor", Description: "Multiplier applied to the amount of scroll movement.", Type: "float", Default: "1.0"},
+					{Name: "middle_button_emulation", Description: "Sending LMB and RMB simultaneously will be interpreted as a middle click. This disables any touchpad area that would normally send a middle click based on location. libinput#middle-button-emulation", Type: "bool", Default: "false"},
+					{Name: "tap_button_map", Description: "Sets the tap button mapping for touchpad button emulation. Can be one of lrm (default) or lmr (Left, Middle, Right Buttons). [lrm/lmr]", Type: "str", Default: "[[Empty]]", AllowedValues: []AllowedValue{
+						{Value: "lrm", Description: ""},
+						{Value: "lmr", Description: ""},
+					}},
+					{Name: "clickfinger_behavior", Description: "Button presses with 1, 2, or 3 fingers will be mapped to LMB, RMB, and MMB respectively. This disables interpretation of clicks based on location on the touchpad. libinput#clickfinger-behavior", Type: "bool", Default: "false"},
+					{Name: "tap-to-click", Description: "Tapping on the touchpad with 1, 2, or 3 fingers will send LMB, RMB, and MMB respectively.", Type: "bool", Default: "true"},
+					{Name: "drag_lock", Description: "When enabled, lifting the finger off while dragging will not drop the dragged item. 0 -> disabled, 1 -> enabled with timeout, 2 -> enabled sticky. libinput#tap-and-drag", Type: "int", Default: "0", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "disabled"},
+						{Value: "1", Description: "enabled with timeout"},
+						{Value: "2", Description: "enabled sticky"},
+					}},
+					{Name: "tap-and-drag", Description: "Sets the tap and drag mode for the touchpad", Type: "bool", Default: "true"},
+					{Name: "flip_x", Description: "inverts the horizontal movement of the touchpad", Type: "bool", Default: "false"},
+					{Name: "flip_y", Description: "inverts the vertical movement of the touchpad", Type: "bool", Default: "false"},
+					{Name: "drag_3fg", Description: "enables three finger drag, 0 -> disabled, 1 -> 3 fingers, 2 -> 4 fingers libinput#drag-3fg", Type: "int", Default: "0", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "disabled"},
+						{Value: "1", Description: "3 fingers"},
+						{Value: "2", Description: "4 fingers"},
+					}},
+				},
+			},
+			{
+				Path:                     []string{"Input", "Touchdevice"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "touchdevice",
+				Variables: []VariableDefinition{
+					{Name: "transform", Description: "Transform the input from touchdevices. The possible transformations are the same as those of the monitors. -1 means it's unset.", Type: "int", Default: "-1"},
+					{Name: "output", Description: "The monitor to bind touch devices. The default is auto-detection. To stop auto-detection, use an empty string or the \"[[Empty]]\" value.", Type: "string", Default: "[[Auto]]"},
+					{Name: "enabled", Description: "Whether input is enabled for touch devices.", Type: "bool", Default: "true"},
+				},
+			},
+			{
+				Path:                     []string{"Input", "Virtualkeyboard"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "virtualkeyboard",
+				Variables: []VariableDefinition{
+					{Name: "share_states", Description: "Unify key down states and modifier states with other keyboards. 0 -> no, 1 -> yes, 2 -> yes unless IME client", Type: "int", Default: "2", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "no"},
+						{Value: "1", Description: "yes"},
+						{Value: "2", Description: "yes unless IME client"},
+					}},
+					{Name: "release_pressed_on_close", Description: "Release all pressed keys by virtual keyboard on close.", Type: "bool", Default: "false"},
+				},
+			},
+			{
+				Path:                     []string{"Input", "Tablet"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "tablet",
+				Variables: []VariableDefinition{
+					{Name: "transform", Description: "transform the input from tablets. The possible transformations are the same as those of the monitors. -1 means it's unset.", Type: "int", Default: "-1"},
+					{Name: "output", Description: "the monitor to bind tablets. Can be current or a monitor name. Leave empty to map across all monitors.", Type: "string", Default: "[[Empty]]"},
+					{Name: "region_position", Description: "position of the mapped region in monitor layout relative to the top left corner of the bound monitor or all monitors.", Type: "vec2", Default: "[0, 0]"},
+					{Name: "absolute_region_position", Description: "whether to treat the region_position as an absolute position in monitor layout. Only applies when output is empty.", Type: "bool", Default: "false"},
+					{Name: "region_size", Description: "size of the mapped region. When this variable is set, tablet input will be mapped to the region. [0, 0] or invalid size means unset.", Type: "vec2", Default: "[0, 0]"},
+					{Name: "relative_input", Description: "whether the input should be relative", Type: "bool", Default: "false"},
+					{Name: "left_handed", Description: "if enabled, the tablet will be rotated 180 degrees", Type: "bool", Default: "false"},
+					{Name: "active_area_size", Description: "size of tablet's active area in mm", Type: "vec2", Default: "[0, 0]"},
+					{Name: "active_area_position", Description: "position of the active area in mm", Type: "vec2", Default: "[0, 0]"},
+				},
+			},
+			{
+				Path:                     []string{"Gestures"},
+				Description:              "`workspace_swipe`, `workspace_swipe_fingers` and `workspace_swipe_min_fingers` were removed in favor of the new gestures system.",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "gestures",
+				Variables: []VariableDefinition{
+					{Name: "workspace_swipe_distance", Description: "in px, the distance of the touchpad gesture", Type: "int", Default: "300"},
+					{Name: "workspace_swipe_touch", Description: "enable workspace swiping from the edge of a touchscreen", Type: "bool", Default: "false"},
+					{Name: "workspace_swipe_invert", Description: "invert the direction (touchpad only)", Type: "bool", Default: "true"},
+					{Name: "workspace_swipe_touch_invert", Description: "invert the direction (touchscreen only)", Type: "bool", Default: "false"},
+					{Name: "workspace_swipe_min_speed_to_force", Description: "minimum speed in px per timepoint to force the change ignoring cancel_ratio. Setting to 0 will disable this mechanic.", Type: "int", Default: "30"},
+					{Name: "workspace_swipe_cancel_ratio", Description: "how much the swipe has to proceed in order to commence it. (0.7 -> if > 0.7 * distance, switch, if less, revert) [0.0 - 1.0]", Type: "float", Default: "0.5", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "workspace_swipe_create_new", Description: "whether a swipe right on the last workspace should create a new one.", Type: "bool", Default: "true"},
+					{Name: "workspace_swipe_direction_lock", Description: "if enabled, switching direction will be locked when you swipe past the direction_lock_threshold (touchpad only).", Type: "bool", Default: "true"},
+					{Name: "workspace_swipe_direction_lock_threshold", Description: "in px, the distance to swipe before direction lock activates (touchpad only).", Type: "int", Default: "10"},
+					{Name: "workspace_swipe_forever", Description: "if enabled, swiping will not clamp at the neighboring workspaces but continue to the further ones.", Type: "bool", Default: "false"},
+					{Name: "workspace_swipe_use_r", Description: "if enabled, swiping will use the r prefix instead of the m prefix for finding workspaces.", Type: "bool", Default: "false"},
+					{Name: "close_max_timeout", Description: "the timeout for a window to close when using a 1:1 gesture, in ms", Type: "int", Default: "1000"},
+					{Name: "workspace_swipe_min_fingers", Description: "Removed in Hyprland 0.51.0, set the number of fingers in gesture = FINGERS, horizontal, workspace instead", Type: "bool", Default: "false"},
+					{Name: "workspace_swipe_fingers", Description: "Removed in Hyprland 0.51.0, set the number of fingers in gesture = FINGERS, horizontal, workspace instead", Type: "int", Default: "3"},
+					{Name: "workspace_swipe", Description: "Removed in Hyprland 0.51.0, use gesture = 3, horizontal, workspace instead, see https://wiki.hyprland.org/Configuring/Gestures/", Type: "bool", Default: "false"},
+				},
+			},
+			{
+				Path:                     []string{"Group"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "group",
+				Variables: []VariableDefinition{
+					{Name: "auto_group", Description: "whether new windows will be automatically grouped into the focused unlocked group. Note: if you want to disable auto_group only for specific windows, use the \"group barred\" window rule instead.", Type: "bool", Default: "true"},
+					{Name: "insert_after_current", Description: "whether new windows in a group spawn after current or at group tail", Type: "bool", Default: "true"},
+					{Name: "focus_removed_window", Description: "whether Hyprland should focus on the window that has just been moved out of the group", Type: "bool", Default: "true"},
+					{Name: "drag_into_group", Description: "whether dragging a window into a unlocked group will merge them. Options: 0 (disabled), 1 (enabled), 2 (only when dragging into the groupbar)", Type: "int", Default: "1", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "disabled"},
+						{Value: "1", Description: "enabled"},
+						{Value: "2", Description: "only when dragging into the groupbar"},
+					}},
+					{Name: "merge_groups_on_drag", Description: "whether window groups can be dragged into other groups", Type: "bool", Default: "true"},
+					{Name: "merge_groups_on_groupbar", Description: "whether one group will be merged with another when dragged into its groupbar", Type: "bool", Default: "true"},
+					{Name: "merge_floated_into_tiled_on_groupbar", Description: "whether dragging a floating window into a tiled window groupbar will merge them", Type: "bool", Default: "false"},
+					{Name: "group_on_movetoworkspace", Description: "whether using movetoworkspace[silent] will merge the window into the workspace's solitary unlocked group", Type: "bool", Default: "false"},
+					{Name: "col.border_active", Description: "active group border color", Type: "gradient", Default: "0x66ffff00"},
+					{Name: "col.border_inactive", Description: "inactive (out of focus) group border color", Type: "gradient", Default: "0x66777700"},
+					{Name: "col.border_locked_active", Description: "active locked group border color", Type: "gradient", Default: "0x66ff5500"},
+					{Name: "col.border_locked_inactive", Description: "inactive locked group border color", Type: "gradient", Default: "0x66775500"},
+				},
+			},
+			{
+				Path:                     []string{"Group", "Groupbar"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "groupbar",
+				Variables: []VariableDefinition{
+					{Name: "enabled", Description: "enables groupbars", Type: "bool", Default: "true"},
+					{Name: "font_family", Description: "font used to display groupbar titles, use misc:font_family if not specified", Type: "string", Default: "[[Empty]]"},
+					{Name: "font_size", Description: "font size of groupbar title", Type: "int", Default: "8"},
+					{Name: "font_weight_active", Description: "font weight of active groupbar title", Type: "font_weight", Default: "normal"},
+					{Name: "font_weight_inactive", Description: "font weight of inactive groupbar title", Type: "font_weight", Default: "normal"},
+					{Name: "gradients", Description: "enables gradients", Type: "bool", Default: "false"},
+					{Name: "height", Description: "height of the groupbar", Type: "int", Default: "14"},
+					{Name: "indicator_gap", Description: "height of gap between groupbar indicator and title", Type: "int", Default: "0"},
+					{Name: "indicator_height", Description: "height of the groupbar indicator", Type: "int", Default: "3"},
+					{Name: "stacked", Description: "render the groupbar as a vertical stack", Type: "bool", Default: "false"},
+					{Name: "priority", Description: "sets the decoration priority for groupbars", Type: "int", Default: "3"},
+					{Name: "render_titles", Description: "whether to render titles in the group bar decoration", Type: "bool", Default: "true"},
+					{Name: "text_offset", Description: "adjust vertical position for titles", Type: "int", Default: "0"},
+					{Name: "text_padding", Description: "set horizontal padding for titles", Type: "int", Default: "0"},
+					{Name: "scrolling", Description: "whether scrolling in the groupbar changes group active window", Type: "bool", Default: "true"},
+					{Name: "rounding", Description: "how much to round the indicator", Type: "int", Default: "1"},
+					{Name: "rounding_power", Description: "adjusts the curve used for rounding groupbar corners, larger is smoother, 2.0 is a circle, 4.0 is a squircle, 1.0 is a triangular corner. [1.0 - 10.0]", Type: "float", Default: "2.0", Bounds: &ValueBounds{Min: 1, Max: 10}},
+					{Name: "gradient_rounding", Description: "how much to round the gradients", Type: "int", Default: "2"},
+					{Name: "gradient_rounding_power", Description: "adjusts the curve used for rounding gradient corners, larger is smoother, 2.0 is a circle, 4.0 is a squircle, 1.0 is a triangular corner. [1.0 - 10.0]", Type: "float", Default: "2.0", Bounds: &ValueBounds{Min: 1, Max: 10}},
+					{Name: "round_only_edges", Description: "round only the indicator edges of the entire groupbar", Type: "bool", Default: "true"},
+					{Name: "gradient_round_only_edges", Description: "round only the gradient edges of the entire groupbar", Type: "bool", Default: "true"},
+					{Name: "text_color", Description: "color for window titles in the groupbar", Type: "color", Default: "0xffffffff"},
+					{Name: "text_color_inactive", Description: "color for inactive windows' titles in the groupbar (if unset, defaults to text_color)", Type: "color", Default: "unset"},
+					{Name: "text_color_locked_active", Description: "color for the active window's title in a locked group (if unset, defaults to text_color)", Type: "color", Default: "unset"},
+					{Name: "text_color_locked_inactive", Description: "color for inactive windows' titles in locked groups (if unset, defaults to text_color_inactive)", Type: "color", Default: "unset"},
+					{Name: "col.active", Description: "active group bar background color", Type: "gradient", Default: "0x66ffff00"},
+					{Name: "col.inactive", Description: "inactive (out of focus) group bar background color", Type: "gradient", Default: "0x66777700"},
+					{Name: "col.locked_active", Description: "active locked group bar background color", Type: "gradient", Default: "0x66ff5500"},
+					{Name: "col.locked_inactive", Description: "inactive locked group bar background color", Type: "gradient", Default: "0x66775500"},
+					{Name: "gaps_in", Description: "gap size between gradients", Type: "int", Default: "2"},
+					{Name: "gaps_out", Description: "gap size between gradients and window", Type: "int", Default: "2"},
+					{Name: "keep_upper_gap", Description: "add or remove upper gap", Type: "bool", Default: "true"},
+					{Name: "blur", Description: "applies blur to the groupbar indicators and gradients", Type: "bool", Default: "false"},
+				},
+			},
+			{
+				Path:                     []string{"Misc"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "misc",
+				Variables: []VariableDefinition{
+					{Name: "disable_hyprland_logo", Description: "disables the random Hyprland logo / anime girl background. :(", Type: "bool", Default: "false"},
+					{Name: "disable_splash_rendering", Description: "disables the Hyprland splash rendering. (requires a monitor reload to take effect)", Type: "bool", Default: "false"},
+					{Name: "disable_scale_notification", Description: "disables notification popup when a monitor fails to set a suitable scale", Type: "bool", Default: "false"},
+					{Name: "col.splash", Description: "Changes the color of the splash text (requires a monitor reload to take effect).", Type: "color", Default: "0xffffffff"},
+					{Name: "font_family", Description: "Set the global default font to render the text including debug fps/notification, config error messages and etc., selected from system fonts.", Type: "string", Default: "Sans"},
+					{Name: "splash_font_family", Description: "Changes the font used to render the splash text, selected from system fonts (requires a monitor reload to take effect).", Type: "string", Default: "[[Empty]]"},
+					{Name: "force_default_wallpaper", Description: "Enforce any of the 3 default wallpapers. Setting this to 0 or 1 disables the anime background. -1 means \"random\". [-1/0/1/2]", Type: "int", Default: "-1", AllowedValues: []AllowedValue{
+						{Value: "-1", Description: ""},
+						{Value: "0", Description: ""},
+						{Value: "1", Description: ""},
+						{Value: "2", Description: ""},
+					}},
+					{Name: "vfr", Description: "controls the VFR status of Hyprland. Heavily recommended to leave enabled to conserve resources.", Type: "bool", Default: "true"},
+					{Name: "vrr", Description: "controls the VRR (Adaptive Sync) of your monitors. 0 - off, 1 - on, 2 - fullscreen only, 3 - fullscreen with video or game content type [0/1/2/3]", Type: "int", Default: "0", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "off"},
+						{Value: "1", Description: "on"},
+						{Value: "2", Description: "fullscreen only"},
+						{Value: "3", Description: "fullscreen with video or game content type"},
+					}},
+					{Name: "mouse_move_enables_dpms", Description: "If DPMS is set to off, wake up the monitors if the mouse moves.", Type: "bool", Default: "false"},
+					{Name: "key_press_enables_dpms", Description: "If DPMS is set to off, wake up the monitors if a key is pressed.", Type: "bool", Default: "false"},
+					{Name: "name_vk_after_proc", Description: "Name virtual keyboards after the processes that create them. E.g. /usr/bin/fcitx5 will have hl-virtual-keyboard-fcitx5.", Type: "bool", Default: "true"},
+					{Name: "always_follow_on_dnd", Description: "Will make mouse focus follow the mouse when drag and dropping. Recommended to leave it enabled, especially for people using focus follows mouse at 0.", Type: "bool", Default: "true"},
+					{Name: "layers_hog_keyboard_focus", Description: "If true, will make keyboard-interactive layers keep their focus on mouse move (e.g. wofi, bemenu)", Type: "bool", Default: "true"},
+					{Name: "animate_manual_resizes", Description: "If true, will animate manual window resizes/moves", Type: "bool", Default: "false"},
+					{Name: "animate_mouse_windowdragging", Description: "If true, will animate windows being dragged by mouse, note that this can cause weird behavior on some curves", Type: "bool", Default: "false"},
+					{Name: "disable_autoreload", Description: "If true, the config will not reload automatically on save, and instead needs to be reloaded with hyprctl reload. Might save on battery.", Type: "bool", Default: "false"},
+					{Name: "enable_swallow", Description: "Enable window swallowing", Type: "bool", Default: "false"},
+					{Name: "swallow_regex", Description: "The class regex to be used for windows that should be swallowed (usually, a terminal). To know more about the list of regex which can be used use this cheatsheet.", Type: "str", Default: "[[Empty]]"},
+					{Name: "swallow_exception_regex", Description: "The title regex to be used for windows that should not be swallowed by the windows specified in swallow_regex  (e.g. wev). The regex is matched against the parent (e.g. Kitty) window's title on the assumption that it changes to whatever process it's running.", Type: "str", Default: "[[Empty]]"},
+					{Name: "focus_on_activate", Description: "Whether Hyprland should focus an app that requests to be focused (an activate request)", Type: "bool", Default: "false"},
+					{Name: "mouse_move_focuses_monitor", Description: "Whether mouse moving into a different monitor should focus it", Type: "bool", Default: "true"},
+					{Name: "allow_session_lock_restore", Description: "if true, will allow you to restart a lockscreen app in case it crashes", Type: "bool", Default: "false"},
+					{Name: "session_lock_xray", Description: "if true, keep rendering workspaces below your lockscreen", Type: "bool", Default: "false"},
+					{Name: "background_color", Description: "change the background color. (requires enabled disable_hyprland_logo)", Type: "color", Default: "0x111111"},
+					{Name: "close_special_on_empty", Description: "close the special workspace if the last window is removed", Type: "bool", Default: "true"},
+					{Name: "on_focus_under_fullscreen", Description: "if there is a fullscreen or maximized window, decide whether a tiled window requested to focus should replace it, stay behind or disable the fullscreen/maximized state. 0 - ignore focus request (keep focus on fullscreen window), 1 - takes over, 2 - unfullscreen/unmaximize [0/1/2]", Type: "int", Default: "2", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "ignore focus request (keep focus on fullscreen window)"},
+						{Value: "1", Description: "takes over"},
+						{Value: "2", Description: "unfullscreen/unmaximize"},
+					}},
+					{Name: "exit_window_retains_fullscreen", Description: "if true, closing a fullscreen window makes the next focused window fullscreen", Type: "bool", Default: "false"},
+					{Name: "initial_workspace_tracking", Description: "if enabled, windows will open on the workspace they were invoked on. 0 - disabled, 1 - single-shot, 2 - persistent (all children too)", Type: "int", Default: "1", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "disabled"},
+						{Value: "1", Description: "single-shot"},
+						{Value: "2", Description: "persistent (all children too)"},
+					}},
+					{Name: "middle_click_paste", Description: "whether to enable middle-click-paste (aka primary selection)", Type: "bool", Default: "true"},
+					{Name: "render_unfocused_fps", Description: "the maximum limit for render_unfocused windows' fps in the background (see also Window-Rules - render_unfocused)", Type: "int", Default: "15"},
+					{Name: "disable_xdg_env_checks", Description: "disable the warning if XDG environment is externally managed", Type: "bool", Default: "false"},
+					{Name: "disable_hyprland_qtutils_check", Description: "disable the warning if hyprland-qtutils is not installed", Type: "bool", Default: "false"},
+					{Name: "lockdead_screen_delay", Description: "delay after which the \"lockdead\" screen will appear in case a lockscreen app fails to cover all the outputs (5 seconds max)", Type: "int", Default: "1000"},
+					{Name: "enable_anr_dialog", Description: "whether to enable the ANR (app not responding) dialog when your apps hang", Type: "bool", Default: "true"},
+					{Name: "anr_missed_pings", Description: "number of missed pings before showing the ANR dialog", Type: "int", Default: "5"},
+					{Name: "size_limits_tiled", Description: "whether to apply min_size and max_size rules to tiled windows", Type: "bool", Default: "false"},
+					{Name: "disable_watchdog_warning", Description: "whether to disable the warning about not using start-hyprland", Type: "bool", Default: "false"},
+				},
+			},
+			{
+				Path:                     []string{"Layout"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "layout",
+				Variables: []VariableDefinition{
+					{Name: "single_window_aspect_ratio", Description: "whenever only a single window is shown on a screen, add padding so that it conforms to the specified aspect ratio. A value like 4 3 on a 16:9 screen will make it a 4:3 window in the middle with padding to the sides.", Type: "Vec2D", Default: "0 0"},
+					{Name: "single_window_aspect_ratio_tolerance", Description: "sets a tolerance for single_window_aspect_ratio, so that if the padding that would have been added is smaller than the specified fraction of the height or width of the screen, it will not attempt to adjust the window size [0 - 1]", Type: "int", Default: "0.1", Bounds: &ValueBounds{Min: 0, Max: 1}},
+				},
+			},
+			{
+				Path:                     []string{"Binds"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "binds",
+				Variables: []VariableDefinition{
+					{Name: "pass_mouse_when_bound", Description: "if disabled, will not pass the mouse events to apps / dragging windows around if a keybind has been triggered.", Type: "bool", Default: "false"},
+					{Name: "scroll_event_delay", Description: "in ms, how many ms to wait after a scroll event to allow passing another one for the binds.", Type: "int", Default: "300"},
+					{Name: "workspace_back_and_forth", Description: "If enabled, an attempt to switch to the currently focused workspace will instead switch to the previous workspace. Akin to i3's auto_back_and_forth.", Type: "bool", Default: "false"},
+					{Name: "hide_special_on_workspace_change", Description: "If enabled, changing the active workspace (including to itself) will hide the special workspace on the monitor where the newly active workspace resides.", Type: "bool", Default: "false"},
+					{Name: "allow_workspace_cycles", Description: "If enabled, workspaces don't forget their previous workspace, so cycles can be created by switching to the first workspace in a sequence, then endlessly going to the previous workspace.", Type: "bool", Default: "false"},
+					{Name: "workspace_center_on", Description: "Whether switching workspaces should center the cursor on the workspace (0) or on the last active window for that workspace (1)", Type: "int", Default: "0"},
+					{Name: "focus_preferred_method", Description: "sets the preferred focus finding method when using focuswindow/movewindow/etc with a direction. 0 - history (recent have priority), 1 - length (longer shared edges have priority)", Type: "int", Default: "0", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "history (recent have priority)"},
+						{Value: "1", Description: "length (longer shared edges have priority)"},
+					}},
+					{Name: "ignore_group_lock", Description: "If enabled, dispatchers like moveintogroup, moveoutofgroup and movewindoworgroup will ignore lock per group.", Type: "bool", Default: "false"},
+					{Name: "movefocus_cycles_fullscreen", Description: "If enabled, when on a fullscreen window, movefocus will cycle fullscreen, if not, it will move the focus in a direction.", Type: "bool", Default: "false"},
+					{Name: "movefocus_cycles_groupfirst", Description: "If enabled, when in a grouped window, movefocus will cycle windows in the groups first, then at each ends of tabs, it'll move on to other windows/groups", Type: "bool", Default: "false"},
+					{Name: "disable_keybind_grabbing", Description: "If enabled, apps that request keybinds to be disabled (e.g. VMs) will not be able to do so.", Type: "bool", Default: "false"},
+					{Name: "allow_pin_fullscreen", Description: "If enabled, Allow fullscreen to pinned windows, and restore their pinned status afterwards", Type: "bool", Default: "false"},
+					{Name: "drag_threshold", Description: "Movement threshold in pixels for window dragging and c/g bind flags. 0 to disable and grab on mousedown.", Type: "int", Default: "0"},
+				},
+			},
+			{
+				Path:                     []string{"XWayland"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "xwayland",
+				Variables: []VariableDefinition{
+					{Name: "enabled", Description: "allow running applications using X11", Type: "bool", Default: "true"},
+					{Name: "use_nearest_neighbor", Description: "uses the nearest neighbor filtering for xwayland apps, making them pixelated rather than blurry", Type: "bool", Default: "true"},
+					{Name: "force_zero_scaling", Description: "forces a scale of 1 on xwayland windows on scaled displays.", Type: "bool", Default: "false"},
+					{Name: "create_abstract_socket", Description: "Create the abstract Unix domain socket for XWayland connections. (XWayland restart is required for changes to take effect; Linux only)", Type: "bool", Default: "false"},
+				},
+			},
+			{
+				Path:                     []string{"OpenGL"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "opengl",
+				Variables: []VariableDefinition{
+					{Name: "nvidia_anti_flicker", Description: "reduces flickering on nvidia at the cost of possible frame drops on lower-end GPUs. On non-nvidia, this is ignored.", Type: "bool", Default: "true"},
+				},
+			},
+			{
+				Path:                     []string{"Render"},
+				Description:              "`cm_auto_hdr` requires `--target-colorspace-hint-mode=source` mpv option to work with mpv versions greater than v0.40.0",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "render",
+				Variables: []VariableDefinition{
+					{Name: "direct_scanout", Description: "Enables direct scanout. Direct scanout attempts to reduce lag when there is only one fullscreen application on a screen (e.g. game). It is also recommended to set this to false if the fullscreen application shows graphical glitches. 0 - off, 1 - on, 2 - auto (on with content type 'game')", Type: "int", Default: "0", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "off"},
+						{Value: "1", Description: "on"},
+						{Value: "2", Description: "auto (on with content type 'game')"},
+					}},
+					{Name: "expand_undersized_textures", Description: "Whether to expand undersized textures along the edge, or rather stretch the entire texture.", Type: "bool", Default: "true"},
+					{Name: "xp_mode", Description: "Disables back buffer and bottom layer rendering.", Type: "bool", Default: "false"},
+					{Name: "ctm_animation", Description: "Whether to enable a fade animation for CTM changes (hyprsunset). 2 means \"auto\" which disables them on Nvidia.", Type: "int", Default: "2"},
+					{Name: "cm_fs_passthrough", Description: "Passthrough color settings for fullscreen apps when possible. 0 - off, 1 - always, 2 - hdr only", Type: "int", Default: "2", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "off"},
+						{Value: "1", Description: "always"},
+						{Value: "2", Description: "hdr only"},
+					}},
+					{Name: "cm_enabled", Description: "Whether the color management pipeline should be enabled or not (requires a restart of Hyprland to fully take effect)", Type: "bool", Default: "true"},
+					{Name: "send_content_type", Description: "Report content type to allow monitor profile autoswitch (may result in a black screen during the switch)", Type: "bool", Default: "true"},
+					{Name: "cm_auto_hdr", Description: "Auto-switch to HDR in fullscreen when needed. 0 - off, 1 - switch to cm, hdr, 2 - switch to cm, hdredid", Type: "int", Default: "1", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "off"},
+						{Value: "1", Description: "switch to cm, hdr"},
+						{Value: "2", Description: "switch to cm, hdredid"},
+					}},
+					{Name: "new_render_scheduling", Description: "Automatically uses triple buffering when needed, improves FPS on underpowered devices.", Type: "bool", Default: "false"},
+					{Name: "non_shader_cm", Description: "Enable CM without shader. 0 - disable, 1 - whenever possible, 2 - DS and passthrough only, 3 - disable and ignore CM issues", Type: "int", Default: "3", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "disable"},
+						{Value: "1", Description: "whenever possible"},
+						{Value: "2", Description: "DS and passthrough only"},
+						{Value: "3", Description: "disable and ignore CM issues"},
+					}},
+					{Name: "cm_sdr_eotf", Description: "Default transfer function for displaying SDR apps. default - Use default value (Gamma 2.2), gamma22 - Treat unspecified as Gamma 2.2, gamma22force - Treat unspecified and sRGB as Gamma 2.2, srgb - Treat unspecified as sRGB", Type: "str", Default: "default", AllowedValues: []AllowedValue{
+						{Value: "default", Description: "Use default value (Gamma 2.2)"},
+						{Value: "gamma22", Description: "Treat unspecified as Gamma 2.2"},
+						{Value: "gamma22force", Description: "Treat unspecified and sRGB as Gamma 2.2"},
+						{Value: "srgb", Description: "Treat unspecified as sRGB"},
+					}},
+				},
+			},
+			{
+				Path:                     []string{"Cursor"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "cursor",
+				Variables: []VariableDefinition{
+					{Name: "invisible", Description: "don't render cursors", Type: "bool", Default: "false"},
+					{Name: "sync_gsettings_theme", Description: "sync xcursor theme with gsettings, it applies cursor-theme and cursor-size on theme load to gsettings making most CSD gtk based clients use same xcursor theme and size.", Type: "bool", Default: "true"},
+					{Name: "no_hardware_cursors", Description: "disables hardware cursors. 0 - use hw cursors if possible, 1 - don't use hw cursors, 2 - auto (disable when tearing)", Type: "int", Default: "2", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "use hw cursors if possible"},
+						{Value: "1", Description: "don't use hw cursors"},
+						{Value: "2", Description: "auto (disable when tearing)"},
+					}},
+					{Name: "no_break_fs_vrr", Description: "disables scheduling new frames on cursor movement for fullscreen apps with VRR enabled to avoid framerate spikes (may require no_hardware_cursors = true) 0 - off, 1 - on, 2 - auto (on with content type 'game')", Type: "int", Default: "2", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "off"},
+						{Value: "1", Description: "on"},
+						{Value: "2", Description: "auto (on with content type 'game')"},
+					}},
+					{Name: "min_refresh_rate", Description: "minimum refresh rate for cursor movement when no_break_fs_vrr is active. Set to minimum supported refresh rate or higher", Type: "int", Default: "24"},
+					{Name: "hotspot_padding", Description: "the padding, in logical px, between screen edges and the cursor", Type: "int", Default: "1"},
+					{Name: "inactive_timeout", Description: "in seconds, after how many seconds of cursor's inactivity to hide it. Set to 0 for never.", Type: "float", Default: "0"},
+					{Name: "no_warps", Description: "if true, will not warp the cursor in many cases (focusing, keybinds, etc)", Type: "bool", Default: "false"},
+					{Name: "persistent_warps", Description: "When a window is refocused, the cursor returns to its last position relative to that window, rather than to the centre.", Type: "bool", Default: "false"},
+					{Name: "warp_on_change_workspace", Description: "Move the cursor to the last focused window after changing the workspace. Options: 0 (Disabled), 1 (Enabled), 2 (Force - ignores cursor:no_warps option)", Type: "int", Default: "0", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "Disabled"},
+						{Value: "1", Description: "Enabled"},
+						{Value: "2", Description: "Force - ignores cursor:no_warps option"},
+					}},
+					{Name: "warp_on_toggle_special", Description: "Move the cursor to the last focused window when toggling a special workspace. Options: 0 (Disabled), 1 (Enabled), 2 (Force - ignores cursor:no_warps option)", Type: "int", Default: "0", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "Disabled"},
+						{Value: "1", Description: "Enabled"},
+						{Value: "2", Description: "Force - ignores cursor:no_warps option"},
+					}},
+					{Name: "default_monitor", Description: "the name of a default monitor for the cursor to be set to on startup (see hyprctl monitors for names)", Type: "str", Default: "[[EMPTY]]"},
+					{Name: "zoom_factor", Description: "the factor to zoom by around the cursor. Like a magnifying glass. Minimum 1.0 (meaning no zoom)", Type: "float", Default: "1.0"},
+					{Name: "zoom_rigid", Description: "whether the zoom should follow the cursor rigidly (cursor is always centered if it can be) or loosely", Type: "bool", Default: "false"},
+					{Name: "zoom_detached_camera", Description: "detach the camera from the mouse when zoomed in, only ever moving the camera to keep the mouse in view when it goes past the screen edges", Type: "bool", Default: "true"},
+					{Name: "enable_hyprcursor", Description: "whether to enable hyprcursor support", Type: "bool", Default: "true"},
+					{Name: "hide_on_key_press", Description: "Hides the cursor when you press any key until the mouse is moved.", Type: "bool", Default: "false"},
+					{Name: "hide_on_touch", Description: "Hides the cursor when the last input was a touch input until a mouse input is done.", Type: "bool", Default: "true"},
+					{Name: "hide_on_tablet", Description: "Hides the cursor when the last input was a tablet input until a mouse input is done.", Type: "bool", Default: "true"},
+					{Name: "use_cpu_buffer", Description: "Makes HW cursors use a CPU buffer. Required on Nvidia to have HW cursors. 0 - off, 1 - on, 2 - auto (nvidia only)", Type: "int", Default: "2", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "off"},
+						{Value: "1", Description: "on"},
+						{Value: "2", Description: "auto (nvidia only)"},
+					}},
+					{Name: "warp_back_after_non_mouse_input", Description: "Warp the cursor back to where it was after using a non-mouse input to move it, and then returning back to mouse.", Type: "bool", Default: "false"},
+					{Name: "zoom_disable_aa", Description: "disable antialiasing when zooming, which means things will be pixelated instead of blurry", Type: "bool", Default: "false"},
+				},
+			},
+			{
+				Path:                     []string{"Ecosystem"},
+				Description:              "",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "ecosystem",
+				Variables: []VariableDefinition{
+					{Name: "no_update_news", Description: "disable the popup that shows up when you update hyprland to a new version.", Type: "bool", Default: "false"},
+					{Name: "no_donation_nag", Description: "disable the popup that shows up twice a year encouraging to donate.", Type: "bool", Default: "false"},
+					{Name: "enforce_permissions", Description: "whether to enable permission control.", Type: "bool", Default: "false"},
+				},
+			},
+			{
+				Path:                     []string{"Quirks"},
+				Description:              "Some clients expect monitor to be in HDR mode prior to the client start. This breaks auto HDR activation and can cause whitescreen and flickering. Use `prefer_hdr` to fix it,",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "quirks",
+				Variables: []VariableDefinition{
+					{Name: "prefer_hdr", Description: "Report HDR mode as preferred. 0 - off, 1 - always, 2 - gamescope only", Type: "int", Default: "0", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "off"},
+						{Value: "1", Description: "always"},
+						{Value: "2", Description: "gamescope only"},
+					}},
+				},
+			},
+			{
+				Path:                     []string{"Debug"},
+				Description:              "Only for developers.",
+				DocumentationFile:        "Variables",
+				DocumentationHeadingSlug: "debug",
+				Variables: []VariableDefinition{
+					{Name: "overlay", Description: "print the debug performance overlay. Disable VFR for accurate results.", Type: "bool", Default: "false"},
+					{Name: "damage_blink", Description: "(epilepsy warning!) flash areas updated with damage tracking", Type: "bool", Default: "false"},
+					{Name: "gl_debugging", Description: "enables OpenGL debugging with glGetError and EGL_KHR_debug, requires a restart after changing.", Type: "bool", Default: "false"},
+					{Name: "disable_logs", Description: "disable logging to a file", Type: "bool", Default: "true"},
+					{Name: "disable_time", Description: "disables time logging", Type: "bool", Default: "true"},
+					{Name: "damage_tracking", Description: "redraw only the needed bits of the display. Do not change. (default: full - 2) monitor - 1, none - 0", Type: "int", Default: "2"},
+					{Name: "enable_stdout_logs", Description: "enables logging to stdout", Type: "bool", Default: "false"},
+					{Name: "manual_crash", Description: "set to 1 and then back to 0 to crash Hyprland.", Type: "int", Default: "0"},
+					{Name: "suppress_errors", Description: "if true, do not display config file parsing errors.", Type: "bool", Default: "false"},
+					{Name: "watchdog_timeout", Description: "sets the timeout in seconds for watchdog to abort processing of a signal of the main thread. Set to 0 to disable.", Type: "int", Default: "5"},
+					{Name: "disable_scale_checks", Description: "disables verification of the scale factors. Will result in pixel alignment and rounding errors.", Type: "bool", Default: "false"},
+					{Name: "error_limit", Description: "limits the number of displayed config file parsing errors.", Type: "int", Default: "5"},
+					{Name: "error_position", Description: "sets the position of the error bar. top - 0, bottom - 1", Type: "int", Default: "0"},
+					{Name: "colored_stdout_logs", Description: "enables colors in the stdout logs.", Type: "bool", Default: "true"},
+					{Name: "pass", Description: "enables render pass debugging.", Type: "bool", Default: "false"},
+					{Name: "full_cm_proto", Description: "claims support for all cm proto features (requires restart)", Type: "bool", Default: "false"},
+				},
+			},
+			{
+				Path:                     []string{"Master"},
+				Description:              "The master layout makes one (or more) window(s) be the \"master\", taking (by default) the left part of the screen, and tiles the rest on the right. You can change the orientation on a per-workspace basis if you want to use anything other than the default left/right split.",
+				DocumentationFile:        "Master-Layout",
+				DocumentationHeadingSlug: "config",
+				Variables: []VariableDefinition{
+					{Name: "allow_small_split", Description: "enable adding additional master windows in a horizontal split style", Type: "bool", Default: "false"},
+					{Name: "special_scale_factor", Description: "the scale of the special workspace windows. [0.0 - 1.0]", Type: "float", Default: "1", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "mfact", Description: "the size as a percentage of the master window, for example mfact = 0.70 would mean 70% of the screen will be the master window, and 30% the slave [0.0 - 1.0]", Type: "floatvalue", Default: "0.55", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "new_status", Description: "master: new window becomes master; slave: new windows are added to slave stack; inherit: inherit from focused window", Type: "string", Default: "slave"},
+					{Name: "new_on_top", Description: "whether a newly open window should be on the top of the stack", Type: "bool", Default: "false"},
+					{Name: "new_on_active", Description: "before, after: place new window relative to the focused window; none: place new window according to the value of new_on_top.", Type: "string", Default: "none"},
+					{Name: "orientation", Description: "default placement of the master area, can be left, right, top, bottom or center", Type: "string", Default: "left"},
+					{Name: "slave_count_for_center_master", Description: "when using orientation=center, make the master window centered only when at least this many slave windows are open. (Set 0 to always_center_master)", Type: "int", Default: "2"},
+					{Name: "center_master_fallback", Description: "Set fallback for center master when slaves are less than slave_count_for_center_master, can be left ,right ,top ,bottom", Type: "string", Default: "left"},
+					{Name: "smart_resizing", Description: "if enabled, resizing direction will be determined by the mouse's position on the window (nearest to which corner). Else, it is based on the window's tiling position.", Type: "bool", Default: "true"},
+					{Name: "drop_at_cursor", Description: "when enabled, dragging and dropping windows will put them at the cursor position. Otherwise, when dropped at the stack side, they will go to the top/bottom of the stack depending on new_on_top.", Type: "bool", Default: "true"},
+					{Name: "always_keep_position", Description: "whether to keep the master window in its configured position when there are no slave windows", Type: "bool", Default: "false"},
+				},
+			},
+			{
+				Path:                     []string{"Dwindle"},
+				Description:              "Dwindle is a BSPWM-like layout, where every window on a workspace is a member of a binary tree.",
+				DocumentationFile:        "Dwindle-Layout",
+				DocumentationHeadingSlug: "config",
+				Variables: []VariableDefinition{
+					{Name: "pseudotile", Description: "enable pseudotiling. Pseudotiled windows retain their floating size when tiled.", Type: "bool", Default: "false"},
+					{Name: "force_split", Description: "0 -> split follows mouse, 1 -> always split to the left (new = left or top) 2 -> always split to the right (new = right or bottom)", Type: "int", Default: "0", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "split follows mouse"},
+						{Value: "1", Description: "always split to the left (new = left or top)"},
+						{Value: "2", Description: "always split to the right (new = right or bottom)"},
+					}},
+					{Name: "preserve_split", Description: "if enabled, the split (side/top) will not change regardless of what happens to the container.", Type: "bool", Default: "false"},
+					{Name: "smart_split", Description: "if enabled, allows a more precise control over the window split direction based on the cursor's position. The window is conceptually divided into four triangles, and cursor's triangle determines the split direction. This feature also turns on preserve_split.", Type: "bool", Default: "false"},
+					{Name: "smart_resizing", Description: "if enabled, resizing direction will be determined by the mouse's position on the window (nearest to which corner). Else, it is based on the window's tiling position.", Type: "bool", Default: "true"},
+					{Name: "permanent_direction_override", Description: "if enabled, makes the preselect direction persist until either this mode is turned off, another direction is specified, or a non-direction is specified (anything other than l,r,u/t,d/b)", Type: "bool", Default: "false"},
+					{Name: "special_scale_factor", Description: "specifies the scale factor of windows on the special workspace [0 - 1]", Type: "float", Default: "1", Bounds: &ValueBounds{Min: 0, Max: 1}},
+					{Name: "split_width_multiplier", Description: "specifies the auto-split width multiplier. Multiplying window size is useful on widescreen monitors where window W > H even after several splits.", Type: "float", Default: "1.0"},
+					{Name: "use_active_for_splits", Description: "whether to prefer the active window or the mouse position for splits", Type: "bool", Default: "true"},
+					{Name: "default_split_ratio", Description: "the default split ratio on window open. 1 means even 50/50 split. [0.1 - 1.9]", Type: "float", Default: "1.0", Bounds: &ValueBounds{Min: 0.1, Max: 1.9}},
+					{Name: "split_bias", Description: "specifies which window will receive the split ratio. 0 -> directional (the top or left window), 1 -> the current window", Type: "int", Default: "0", AllowedValues: []AllowedValue{
+						{Value: "0", Description: "directional (the top or left window)"},
+						{Value: "1", Description: "the current window"},
+					}},
+					{Name: "precise_mouse_move", Description: "bindm movewindow will drop the window more precisely depending on where your mouse is.", Type: "bool", Default: "false"},
+				},
+			},
+		},
+	},
+}
@@ -5,19 +5,33 @@ import (
 	"strings"
 )
 
+// FindSectionDefinitionByName finds a section of the documentation of HyprlandVersion. See Schema.FindSectionDefinitionByName for other versions.
 func FindSectionDefinitionByName(name string) *SectionDefinition {
-	for _, sec := range Sections {
-		if sec.Name() == name || sec.JSONName() == name {
-			return &sec
-		}
-	}
-	return nil
+	return documentedSchema.FindSectionDefinitionByName(name)
+}
+
+// FindSectionDefinitionByPath finds the section at path, such as ["decoration", "blur"], ignoring case, in the documentation of HyprlandVersion.
+func FindSectionDefinitionByPath(path []string) *SectionDefinition {
+	return documentedSchema.FindSectionDefinitionByPath(path)
 }
 
 type SectionDefinition struct {
 	Path        []string
 	Subsections []SectionDefinition
 	Variables   []VariableDefinition
+	// Description is the first paragraph of the wiki about the section
+	Description string
+	// DocumentationFile is the wiki page that documents the section, and DocumentationHeadingSlug the anchor of its heading
+	DocumentationFile        string
+	DocumentationHeadingSlug string
+}
+
+// DocumentationLink returns the URL of the section's documentation on the wiki, or an empty string if it is unknown.
+func (s SectionDefinition) DocumentationLink() string {
+	if s.DocumentationFile == "" {
+		return ""
+	}
+	return fmt.Sprintf("https://wiki.hyprland.org/Configuring/%s/#%s", s.DocumentationFile, s.DocumentationHeadingSlug)
 }
 
 func (s SectionDefinition) Name() string {
@@ -1,11 +1,14 @@
 package parser_data
 
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FindVariableDefinitionInSection finds an option in the documentation of HyprlandVersion. See Schema.FindVariableDefinitionInSection for other versions.
 func FindVariableDefinitionInSection(sectionName, variableName string) *VariableDefinition {
-	sec := FindSectionDefinitionByName(sectionName)
-	if sec == nil {
-		return nil
-	}
-	return sec.VariableDefinition(variableName)
+	return documentedSchema.FindVariableDefinitionInSection(sectionName, variableName)
 }
 
 type VariableDefinition struct {
@@ -13,6 +16,61 @@ type VariableDefinition struct {
 	Description string
 	Type        string
 	Default     string
+	// AllowedValues are the values the option accepts, when its description lists them. Empty when any value of its type is accepted.
+	AllowedValues []AllowedValue
+	// Bounds are the minimum and maximum values of a numeric option, when its description gives them
+	Bounds *ValueBounds
+}
+
+// ValueBounds is the inclusive range of values a numeric option accepts.
+type ValueBounds struct {
+	Min float64
+	Max float64
+}
+
+// Contains tells whether value is within the bounds.
+func (b ValueBounds) Contains(value float64) bool {
+	return b.Min <= value && value <= b.Max
+}
+
+func (b ValueBounds) String() string {
+	return fmt.Sprintf("%s to %s", strconv.FormatFloat(b.Min, 'f', -1, 64), strconv.FormatFloat(b.Max, 'f', -1, 64))
+}
+
+// AllowedValue is one of the values an option accepts.
+type AllowedValue struct {
+	Value       string
+	Description string
+	// Parameters are the arguments written after the value, such as <step> <points...> after custom. Empty when the value takes none.
+	Parameters string
+}
+
+// hyprlangBooleans are the booleans hyprlang accepts in place of 1 and 0 for int options.
+var hyprlangBooleans = map[string]string{"true": "1", "on": "1", "yes": "1", "false": "0", "off": "0", "no": "0"}
+
+// AllowedValue finds the allowed value that value is.
+// Only the first word is compared to values that take parameters, and booleans are read as 1 and 0 for int options.
+func (v VariableDefinition) AllowedValue(value string) (AllowedValue, bool) {
+	value = strings.TrimSpace(value)
+	if number, isBoolean := hyprlangBooleans[value]; isBoolean && v.Type == "int" {
+		value = number
+	}
+	word, _, _ := strings.Cut(value, " ")
+	for _, allowed := range v.AllowedValues {
+		if allowed.Value == value || (allowed.Parameters != "" && allowed.Value == word) {
+			return allowed, true
+		}
+	}
+	return AllowedValue{}, false
+}
+
+// Allows tells whether value is one of the allowed values of the option, or if the option accepts any value.
+func (v VariableDefinition) Allows(value string) bool {
+	if len(v.AllowedValues) == 0 {
+		return true
+	}
+	_, found := v.AllowedValue(value)
+	return found
 }
 
 func (v VariableDefinition) PrettyDefault() string {
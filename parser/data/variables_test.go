@@ -0,0 +1,28 @@
+package parser_data
+
+import "testing"
+
+func TestAllows(t *testing.T) {
+	accelProfile := FindVariableDefinitionInSection("input", "accel_profile")
+	vrr := FindVariableDefinitionInSection("misc", "vrr")
+	layout := FindVariableDefinitionInSection("general", "layout")
+	for _, test := range []struct {
+		variable *VariableDefinition
+		value    string
+		expected bool
+	}{
+		{accelProfile, "flat", true},
+		{accelProfile, "custom 200 0.0 0.5", true},
+		{accelProfile, "flat 200", false},
+		{vrr, "2", true},
+		{vrr, "true", true},
+		{vrr, "off", true},
+		{vrr, "enabled", false},
+		{layout, "master", true},
+		{layout, "true", false},
+	} {
+		if actual := test.variable.Allows(test.value); actual != test.expected {
+			t.Errorf("%s = %s: expected %v, got %v", test.variable.Name, test.value, test.expected, actual)
+		}
+	}
+}
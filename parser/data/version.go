@@ -0,0 +1,63 @@
+package parser_data
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// HyprlandVersion is the version of Hyprland the embedded documentation is for. just pull-wiki keeps it in sync with hyprland_version.
+const HyprlandVersion = "0.55.0"
+
+// CompareVersions compares two Hyprland versions such as 0.45 or 0.45.2, like strings.Compare does. Missing components count as 0.
+func CompareVersions(a, b string) int {
+	aParts, bParts := versionComponents(a), versionComponents(b)
+	for i := range max(len(aParts), len(bParts)) {
+		var aPart, bPart int
+		if i < len(aParts) {
+			aPart = aParts[i]
+		}
+		if i < len(bParts) {
+			bPart = bParts[i]
+		}
+		if aPart != bPart {
+			if aPart < bPart {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+func versionComponents(version string) []int {
+	components := make([]int, 0, 3)
+	for _, part := range strings.Split(strings.TrimPrefix(strings.TrimSpace(version), "v"), ".") {
+		component, _ := strconv.Atoi(part)
+		components = append(components, component)
+	}
+	return components
+}
+
+// NormalizeVersion turns versions such as v0.45 into 0.45.0
+func NormalizeVersion(version string) string {
+	components := versionComponents(version)
+	for len(components) < 3 {
+		components = append(components, 0)
+	}
+	return fmt.Sprintf("%d.%d.%d", components[0], components[1], components[2])
+}
+
+// ValidVersion tells whether version looks like a Hyprland version, such as 0.45 or v0.45.2.
+func ValidVersion(version string) bool {
+	parts := strings.Split(strings.TrimPrefix(strings.TrimSpace(version), "v"), ".")
+	if len(parts) < 2 || len(parts) > 3 {
+		return false
+	}
+	for _, part := range parts {
+		if _, err := strconv.Atoi(part); err != nil {
+			return false
+		}
+	}
+	return true
+}
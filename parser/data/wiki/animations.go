@@ -0,0 +1,82 @@
+package wiki
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	parser_data "github.com/hyprland-community/hyprls/parser/data"
+)
+
+var animationTreeCodeBlockPattern = regexp.MustCompile("(?s)### Animation tree\\s+```txt\n(.+?)```")
+var parentheticalPattern = regexp.MustCompile(`\s*\(.*?\)`)
+
+// parseAnimationTree reads the animation tree code block of the Animations wiki page:
+//
+//	global
+//	  ↳ windows - styles: slide, popin, gnomed
+//	    ↳ windowsIn - window open - styles: same as windows
+//
+// An animation indented deeper than a child of the previous line is an error, since its parent can't be told.
+func parseAnimationTree(source []byte) ([]parser_data.AnimationDefinition, error) {
+	matches := animationTreeCodeBlockPattern.FindSubmatch(source)
+	if matches == nil {
+		return []parser_data.AnimationDefinition{}, nil
+	}
+
+	animations := make([]parser_data.AnimationDefinition, 0)
+	// ancestry[depth] is the name of the last animation seen at that depth
+	ancestry := make([]string, 0)
+	for _, line := range strings.Split(string(matches[1]), "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		depth := (len(line) - len(strings.TrimLeft(line, " "))) / 2
+		if depth > len(ancestry) {
+			return nil, fmt.Errorf("animation tree line %q is indented deeper than a child of the line before", strings.TrimSpace(line))
+		}
+		parts := strings.Split(strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "↳")), " - ")
+
+		animation := parser_data.AnimationDefinition{Name: strings.TrimSpace(parts[0])}
+		for _, part := range parts[1:] {
+			if styles, ok := strings.CutPrefix(strings.TrimSpace(part), "styles:"); ok {
+				animation.Styles = parseAnimationStyles(styles)
+			} else {
+				animation.Description = strings.TrimSpace(part)
+			}
+		}
+
+		ancestry = append(ancestry[:min(depth, len(ancestry))], animation.Name)
+		if depth > 0 {
+			animation.Parent = ancestry[depth-1]
+		}
+		animations = append(animations, animation)
+	}
+
+	// Resolve "styles: same as windows"
+	for i, animation := range animations {
+		if len(animation.Styles) != 1 {
+			continue
+		}
+		if other, ok := strings.CutPrefix(animation.Styles[0], "same as "); ok {
+			idx := slices.IndexFunc(animations, func(a parser_data.AnimationDefinition) bool { return a.Name == other })
+			if idx >= 0 {
+				animations[i].Styles = animations[idx].Styles
+			}
+		}
+	}
+
+	return animations, nil
+}
+
+func parseAnimationStyles(raw string) []string {
+	styles := make([]string, 0)
+	for _, style := range strings.Split(parentheticalPattern.ReplaceAllString(raw, ""), ",") {
+		if style = strings.TrimSpace(style); style != "" {
+			styles = append(styles, style)
+		}
+	}
+	return styles
+}
@@ -0,0 +1,31 @@
+package wiki
+
+import (
+	"regexp"
+	"strconv"
+
+	parser_data "github.com/hyprland-community/hyprls/parser/data"
+)
+
+// boundsPattern matches the range of values given in descriptions of numeric options, such as [0.0 - 1.0]
+var boundsPattern = regexp.MustCompile(`\[(-?\d+(?:\.\d+)?)\s*-\s*(-?\d+(?:\.\d+)?)\]`)
+
+// valueBounds extracts the minimum and maximum values of a numeric option from its description.
+func valueBounds(variable parser_data.VariableDefinition) *parser_data.ValueBounds {
+	switch variable.Type {
+	case "int", "float", "floatvalue":
+	default:
+		return nil
+	}
+
+	match := boundsPattern.FindStringSubmatch(variable.Description)
+	if match == nil {
+		return nil
+	}
+	min, errMin := strconv.ParseFloat(match[1], 64)
+	max, errMax := strconv.ParseFloat(match[2], 64)
+	if errMin != nil || errMax != nil || min > max {
+		return nil
+	}
+	return &parser_data.ValueBounds{Min: min, Max: max}
+}
@@ -0,0 +1,64 @@
+package wiki
+
+import (
+	"regexp"
+	"strings"
+
+	parser_data "github.com/hyprland-community/hyprls/parser/data"
+)
+
+var dispatchersTableHeaderPattern = regexp.MustCompile(`(?i)^\|\s*dispatcher\s*\|\s*description\s*\|\s*params\s*\|$`)
+var relrefPattern = regexp.MustCompile(`\{\{<\s*relref\s+"([^"]*)"\s*>\}\}`)
+var relativeLinkPattern = regexp.MustCompile(`\]\(\.\./`)
+
+// undocumentedDispatchers are only mentioned by the layout pages.
+var undocumentedDispatchers = []parser_data.DispatcherDefinition{
+	{
+		Name:        "layoutmsg",
+		Description: "sends a message to the current layout, see [Master Layout](https://wiki.hyprland.org/Configuring/Master-Layout/) and [Dwindle Layout](https://wiki.hyprland.org/Configuring/Dwindle-Layout/)",
+		Params:      "the message and its arguments, separated by spaces",
+	},
+}
+
+// parseDispatchers reads the dispatcher | description | params tables of a wiki page.
+func parseDispatchers(source []byte, page string) []parser_data.DispatcherDefinition {
+	dispatchers := make([]parser_data.DispatcherDefinition, 0)
+	inTable := false
+	for _, line := range strings.Split(string(source), "\n") {
+		line = strings.TrimSpace(line)
+		if dispatchersTableHeaderPattern.MatchString(line) {
+			inTable = true
+			continue
+		}
+		if !strings.HasPrefix(line, "|") {
+			inTable = false
+			continue
+		}
+		cells := tableRowCells(line)
+		if !inTable || len(cells) < 3 || strings.Trim(cells[0], "- ") == "" {
+			continue
+		}
+		dispatchers = append(dispatchers, parser_data.DispatcherDefinition{
+			Name:        cells[0],
+			Description: absoluteWikiLinks(cells[1], page),
+			Params:      absoluteWikiLinks(cells[2], page),
+		})
+	}
+	return dispatchers
+}
+
+// tableRowCells splits a markdown table row, keeping escaped pipes in cells.
+func tableRowCells(row string) []string {
+	row = strings.ReplaceAll(strings.Trim(row, "|"), `\|`, "\x00")
+	cells := strings.Split(row, "|")
+	for i, cell := range cells {
+		cells[i] = strings.ReplaceAll(strings.TrimSpace(cell), "\x00", "|")
+	}
+	return cells
+}
+
+// absoluteWikiLinks makes relative links of a page of the wiki work outside of it.
+func absoluteWikiLinks(markdown string, page string) string {
+	markdown = relrefPattern.ReplaceAllString(markdown, "https://wiki.hyprland.org/Configuring/"+page+"/$1")
+	return relativeLinkPattern.ReplaceAllString(markdown, "](https://wiki.hyprland.org/Configuring/")
+}
@@ -0,0 +1,106 @@
+package wiki
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	parser_data "github.com/hyprland-community/hyprls/parser/data"
+)
+
+// enumerationPattern matches the allowed values listed at the end of some descriptions, such as [dwindle/master/scrolling/monocle]
+var enumerationPattern = regexp.MustCompile(`\[([\w.-]+(?:/[\w.-]+)+)\]`)
+
+// numberedValuePattern matches the start of each item of lists such as 0 - off, 1 - on or 0 (disabled), 1 (enabled)
+var numberedValuePattern = regexp.MustCompile(`(?:^|[^\d.])(\d+)\s*(->|-|\()\s*`)
+
+// namedValuePattern matches the start of each item of lists such as default - Use default value, srgb - Treat unspecified as sRGB
+var namedValuePattern = regexp.MustCompile(`(?:^|[,.:]\s+)([a-z][a-z0-9_]*)\s+(-)\s+`)
+
+// linkTextPattern matches the text of links to other documentations that ends some items, such as libinput#tap-and-drag
+var linkTextPattern = regexp.MustCompile(`\s+[\w.-]+#[\w.-]+$`)
+
+// parametersPattern matches the forms of values that take parameters shown by the wiki, such as custom <step> <points...>
+var parametersPattern = regexp.MustCompile(`^([\w.-]+)\s+(<.+>)$`)
+
+// allowedValues extracts the values an option accepts from its description.
+func allowedValues(variable parser_data.VariableDefinition) []parser_data.AllowedValue {
+	var listed []parser_data.AllowedValue
+	switch variable.Type {
+	case "bool":
+		return nil
+	case "int":
+		listed = numberedValues(variable.Description)
+	case "str", "string":
+		listed = listedValues(variable.Description, namedValuePattern)
+	}
+
+	match := enumerationPattern.FindStringSubmatch(variable.Description)
+	if match == nil {
+		return listed
+	}
+	values := make([]parser_data.AllowedValue, 0)
+	for _, value := range strings.Split(match[1], "/") {
+		allowed := parser_data.AllowedValue{Value: value}
+		for _, described := range listed {
+			if described.Value == value {
+				allowed.Description = described.Description
+			}
+		}
+		values = append(values, allowed)
+	}
+	return values
+}
+
+// numberedValues extracts lists of values numbered from 0.
+func numberedValues(description string) []parser_data.AllowedValue {
+	values := listedValues(description, numberedValuePattern)
+	for i, value := range values {
+		if value.Value != strconv.Itoa(i) {
+			return values[:i]
+		}
+	}
+	return values
+}
+
+// listedValues extracts the items of a list of values and their descriptions, where pattern matches the start of each item.
+// Lists need at least two items.
+func listedValues(description string, pattern *regexp.Regexp) []parser_data.AllowedValue {
+	matches := pattern.FindAllStringSubmatchIndex(description, -1)
+	values := make([]parser_data.AllowedValue, 0, len(matches))
+	for i, match := range matches {
+		end := len(description)
+		if i+1 < len(matches) {
+			end = matches[i+1][0]
+		}
+		text := description[match[1]:end]
+		if description[match[4]:match[5]] == "(" {
+			text, _, _ = strings.Cut(text, ")")
+		}
+		text = strings.TrimSpace(enumerationPattern.ReplaceAllString(text, ""))
+		text = linkTextPattern.ReplaceAllString(strings.TrimRight(text, ",.; "), "")
+		values = append(values, parser_data.AllowedValue{
+			Value:       description[match[2]:match[3]],
+			Description: strings.TrimRight(text, ",.; "),
+		})
+	}
+	if len(values) < 2 {
+		return nil
+	}
+	return values
+}
+
+// addParameters documents the parameters of the allowed values whose form the page shows in code spans, such as custom <step> <points...>.
+func addParameters(values []parser_data.AllowedValue, codeSpans []string) {
+	for _, code := range codeSpans {
+		match := parametersPattern.FindStringSubmatch(strings.TrimSpace(code))
+		if match == nil {
+			continue
+		}
+		for i := range values {
+			if values[i].Value == match[1] {
+				values[i].Parameters = match[2]
+			}
+		}
+	}
+}
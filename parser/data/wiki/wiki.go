@@ -0,0 +1,324 @@
+// Package wiki parses the Configuring pages of the Hyprland wiki into the definitions of parser/data.
+// It is only used by parser/data/generate, the language server loads the definitions it generated.
+package wiki
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/anaskhan96/soup"
+	"github.com/metal3d/go-slugify"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+
+	html2markdown "github.com/evorts/html-to-markdown"
+	parser_data "github.com/hyprland-community/hyprls/parser/data"
+)
+
+var html2md = html2markdown.NewConverter("wiki.hyprlang.org", true, &html2markdown.Options{})
+var md = goldmark.New(goldmark.WithExtensions(extension.GFM))
+
+func debug(msg string, fmtArgs ...any) {
+	// fmt.Fprintf(os.Stderr, msg, fmtArgs...)
+}
+
+//go:embed sources/*.md
+var documentationSources embed.FS
+
+var undocumentedGeneralSectionVariables = []parser_data.VariableDefinition{
+	{
+		Name:        "autogenerated",
+		Description: "Whether this configuration was autogenerated",
+		Type:        "bool",
+		Default:     "1",
+	},
+}
+
+func init() {
+	html2md.AddRules(html2markdown.Rule{
+		Filter: []string{"a"},
+		Replacement: func(content string, selec *goquery.Selection, options *html2markdown.Options) *string {
+			href, _ := selec.Attr("href")
+			if strings.HasPrefix(href, "../") {
+				href = strings.Replace(href, "../", "https://wiki.hyprland.org/Configuring/", 1)
+			}
+			result := fmt.Sprintf("[%s](%s)", content, href)
+			return html2markdown.String(result)
+		},
+	})
+}
+
+// Parse reads the embedded wiki pages, which document HyprlandVersion. keywords are documented with the section of the wiki page they point to.
+func Parse(keywords []parser_data.KeywordDefinition) (parser_data.Schema, error) {
+	sources, _ := fs.Sub(documentationSources, "sources")
+	return ParseFS(sources, parser_data.HyprlandVersion, keywords)
+}
+
+// ParseFS reads the Configuring pages of the wiki of a Hyprland version, such as a snapshot of hyprland-wiki/content/Configuring.
+func ParseFS(sources fs.FS, version string, keywords []parser_data.KeywordDefinition) (parser_data.Schema, error) {
+	schema := parser_data.Schema{Version: version}
+	page := func(name string) []byte {
+		source, err := fs.ReadFile(sources, name+".md")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to read %s documentation: %s\n", name, err)
+		}
+		return source
+	}
+
+	schema.Sections = parseDocumentationMarkdown(page("Variables"), "Variables", 3)
+	schema.Sections = append(schema.Sections, parseDocumentationMarkdownWithRootSectionName(page("Master-Layout"), "Master-Layout", 2, "Master")...)
+	schema.Sections = append(schema.Sections, parseDocumentationMarkdownWithRootSectionName(page("Dwindle-Layout"), "Dwindle-Layout", 2, "Dwindle")...)
+	addVariableDefsOnSection(schema.Sections, "General", undocumentedGeneralSectionVariables)
+
+	schema.Animations = []parser_data.AnimationDefinition{}
+	if animationsSource := page("Animations"); animationsSource != nil {
+		animations, err := parseAnimationTree(animationsSource)
+		if err != nil {
+			return parser_data.Schema{}, fmt.Errorf("while reading the Animations page: %w", err)
+		}
+		schema.Animations = animations
+	}
+
+	schema.Dispatchers = make([]parser_data.DispatcherDefinition, 0)
+	for _, name := range []string{"Dispatchers", "Master-Layout", "Dwindle-Layout"} {
+		if source := page(name); source != nil {
+			schema.Dispatchers = append(schema.Dispatchers, parseDispatchers(source, name)...)
+		}
+	}
+	schema.Dispatchers = append(schema.Dispatchers, undocumentedDispatchers...)
+
+	schema.Keywords = make([]parser_data.KeywordDefinition, 0, len(keywords))
+	for _, kw := range keywords {
+		kw.Description = keywordDescription(sources, kw)
+		schema.Keywords = append(schema.Keywords, kw)
+	}
+	return schema, nil
+}
+
+func keywordDescription(sources fs.FS, kw parser_data.KeywordDefinition) string {
+	content, err := fs.ReadFile(sources, kw.DocumentationFile()+".md")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to read documentation file for %s: %s\n", kw.Name, err)
+		return ""
+	}
+
+	document := markdownToHTML(content)
+	headings := make([]soup.Root, 0)
+	for _, t := range []string{"h1", "h2", "h3", "h4", "h5", "h6"} {
+		headings = append(headings, document.FindAll(t)...)
+	}
+	for _, h := range headings {
+		if id, ok := h.Attrs()["id"]; ok && id == kw.DocumentationHeadingSlug() {
+			description, _ := html2md.ConvertString(htmlBetweenHeadingAndNextHeading(h, h))
+			return description
+		}
+		anchor := slugify.Marshal(strings.TrimSpace(h.Text()), true)
+		anchor = regexp.MustCompile(`^weight-%d+-title-`).ReplaceAllString(anchor, "")
+		if anchor == kw.DocumentationHeadingSlug() {
+			description, _ := html2md.ConvertString(htmlBetweenHeadingAndNextHeading(h, h))
+			return description
+		}
+	}
+	fmt.Fprintf(os.Stderr, "Failed to find heading %s in %s\n", kw.DocumentationHeadingSlug(), kw.DocumentationFile())
+	return ""
+}
+
+func addVariableDefsOnSection(sections []parser_data.SectionDefinition, sectionName string, variables []parser_data.VariableDefinition) {
+	for i, sec := range sections {
+		if sec.Name() != sectionName {
+			continue
+		}
+		sections[i].Variables = append(sections[i].Variables, variables...)
+	}
+}
+
+func parseDocumentationMarkdownWithRootSectionName(source []byte, page string, headingRootLevel int, rootSectionName string) []parser_data.SectionDefinition {
+	sections := parseDocumentationMarkdown(source, page, headingRootLevel)
+	// The introduction of the page describes the root section better than the heading of its options
+	introduction := markdownToHTML(source).Find("p")
+	for i := range sections {
+		sections[i].Path[0] = rootSectionName
+		if len(sections[i].Path) == 1 && sections[i].Description == "" && introduction.Error == nil {
+			sections[i].Description = paragraphMarkdown(introduction)
+		}
+	}
+	return sections
+}
+
+func markdownToHTML(source []byte) soup.Root {
+	var html bytes.Buffer
+	err := md.Convert(source, &html)
+	if err != nil {
+		panic(err)
+	}
+
+	return soup.HTMLParse(html.String())
+}
+
+func parseDocumentationMarkdown(source []byte, page string, headingRootLevel int) (sections []parser_data.SectionDefinition) {
+	document := markdownToHTML(source)
+	codeSpans := make([]string, 0)
+	for _, code := range document.FindAll("code") {
+		codeSpans = append(codeSpans, code.FullText())
+	}
+	for _, table := range document.FindAll("table") {
+		if !arraysEqual(tableHeaderCells(table), []string{"name", "description", "type", "default"}) {
+			continue
+		}
+
+		// fmt.Printf("Processing table %s\n", table.HTML())
+		heading := backtrackToNearestHeader(table)
+		section := parser_data.SectionDefinition{
+			Path:                     headingPath(heading, headingRootLevel),
+			Description:              sectionSummary(heading),
+			DocumentationFile:        page,
+			DocumentationHeadingSlug: slugify.Marshal(strings.TrimSpace(heading.FullText()), true),
+		}
+		section.Variables = make([]parser_data.VariableDefinition, 0)
+		for _, row := range table.FindAll("tr")[1:] {
+			cells := row.FindAll("td")
+			if len(cells) != 4 {
+				continue
+			}
+
+			variable := parser_data.VariableDefinition{
+				Name:        cells[0].FullText(),
+				Description: cells[1].FullText(),
+				Type:        cells[2].FullText(),
+				Default:     cells[3].FullText()}
+			variable.AllowedValues = allowedValues(variable)
+			addParameters(variable.AllowedValues, codeSpans)
+			variable.Bounds = valueBounds(variable)
+			section.Variables = append(section.Variables, variable)
+		}
+		sections = append(sections, section)
+	}
+
+	for i, section := range sections {
+		if len(section.Path) == 1 {
+			sections[i] = section.AttachSubsections(sections)
+		}
+	}
+	return sections
+}
+
+func tableHeaderCells(table soup.Root) []string {
+	headerCells := table.FindAll("th")
+	cells := make([]string, 0, len(headerCells))
+	for _, cell := range headerCells {
+		cells = append(cells, cell.FullText())
+	}
+	return cells
+}
+
+// categoryNamePattern matches paragraphs such as _Subcategory `general:snap:`_, that only repeat the path of the section.
+var categoryNamePattern = regexp.MustCompile(`(?i)^\s*(sub)?category( name)?\b`)
+
+// alertMarkerPattern matches the marker of alerts such as > [!NOTE], and the bold title that may follow it.
+var alertMarkerPattern = regexp.MustCompile(`^\[!\w+\]\s*(\*\*[^*]+\*\*\s*$)?`)
+
+// sectionSummary returns the first paragraph between heading and the next one, other than the one naming the section.
+// Most sections only have a table, the first paragraph of their notes is used instead, unless it introduces an example.
+func sectionSummary(heading soup.Root) string {
+	note := ""
+	for element := heading.FindNextElementSibling(); element.Error == nil && !isHeading(element); element = element.FindNextElementSibling() {
+		switch element.NodeValue {
+		case "p":
+			if !categoryNamePattern.MatchString(element.FullText()) {
+				return paragraphMarkdown(element)
+			}
+		case "blockquote":
+			for _, paragraph := range element.FindAll("p") {
+				if text := alertMarkerPattern.ReplaceAllString(paragraphMarkdown(paragraph), ""); note == "" && text != "" {
+					if !strings.HasSuffix(text, ":") {
+						note = text
+					}
+					break
+				}
+			}
+		}
+	}
+	return note
+}
+
+func paragraphMarkdown(paragraph soup.Root) string {
+	converted, _ := html2md.ConvertString(paragraph.HTML())
+	return strings.Join(strings.Fields(converted), " ")
+}
+
+// headingPath returns the titles of the heading and of the headings it is nested in, up to one of headingRootLevel.
+func headingPath(heading soup.Root, headingRootLevel int) []string {
+	level := headingLevel(heading)
+	if level <= headingRootLevel {
+		return []string{heading.FullText()}
+	}
+	// Skip sibling headings, such as Blur when looking for the parent of Shadow
+	parent := backtrackToNearestHeader(heading.FindPrevElementSibling())
+	for headingLevel(parent) >= level {
+		parent = backtrackToNearestHeader(parent.FindPrevElementSibling())
+	}
+	return append(headingPath(parent, headingRootLevel), heading.FullText())
+}
+
+func backtrackToNearestHeader(element soup.Root) soup.Root {
+	if element.NodeValue != "table" {
+		debug("backtracking to nearest header from %s\n", element.HTML())
+	}
+	if regexp.MustCompile(`^h[1-6]$`).MatchString(element.NodeValue) {
+		debug("-> returning from backtrack with %s\n", element.HTML())
+		return element
+	}
+	prev := element.FindPrevElementSibling()
+	debug("-> prev is %s\n", prev.HTML())
+	return backtrackToNearestHeader(prev)
+}
+
+func htmlBetweenHeadingAndNextHeading(heading soup.Root, element soup.Root) string {
+	next := element.FindNextElementSibling()
+	if isHeading(next) && headingLevel(next) == headingLevel(heading) {
+		return ""
+	}
+
+	defer func() {
+		if crash := recover(); crash != nil {
+			if os.Getenv("DEBUG") != "" {
+				fmt.Fprintf(os.Stderr, "Panic while rendering %s\n", next.HTML())
+			}
+		}
+	}()
+
+	rendered := next.HTML()
+
+	return rendered + htmlBetweenHeadingAndNextHeading(heading, next)
+}
+
+func isHeading(element soup.Root) bool {
+	return regexp.MustCompile(`^h[1-6]$`).MatchString(element.NodeValue)
+}
+
+func headingLevel(heading soup.Root) int {
+	level, err := strconv.Atoi(heading.NodeValue[1:])
+	if err != nil {
+		panic(err)
+	}
+	return level
+}
+
+func arraysEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i, v := range a {
+		if strings.TrimSpace(v) != strings.TrimSpace(b[i]) {
+			return false
+		}
+	}
+	return true
+}
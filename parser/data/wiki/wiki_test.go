@@ -0,0 +1,119 @@
+package wiki
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	parser_data "github.com/hyprland-community/hyprls/parser/data"
+)
+
+// TestGeneratedDocumentation checks that documentation_generated.go is up to date with the wiki pages in sources/.
+// Run go generate ./parser/data to update it.
+func TestGeneratedDocumentation(t *testing.T) {
+	parsed, err := Parse(parser_data.Keywords)
+	if err != nil {
+		t.Fatal(err)
+	}
+	generated := parser_data.SchemaFor(parser_data.HyprlandVersion)
+
+	if !reflect.DeepEqual(parsed.Sections, generated.Sections) {
+		t.Error("generated sections differ from the wiki's")
+	}
+	if !reflect.DeepEqual(parsed.Animations, generated.Animations) {
+		t.Error("generated animations differ from the wiki's")
+	}
+	if !reflect.DeepEqual(parsed.Keywords, generated.Keywords) {
+		t.Error("generated keywords differ from the wiki's")
+	}
+	if !reflect.DeepEqual(parsed.Dispatchers, generated.Dispatchers) {
+		t.Error("generated dispatchers differ from the wiki's")
+	}
+	if len(parsed.Sections) == 0 || len(parsed.Animations) == 0 || parsed.Keywords[0].Description == "" || len(parsed.Dispatchers) == 0 {
+		t.Error("expected the wiki to document sections, animations, keywords and dispatchers")
+	}
+}
+
+func TestAllowedValues(t *testing.T) {
+	for _, test := range []struct {
+		variable parser_data.VariableDefinition
+		expected []parser_data.AllowedValue
+	}{
+		{
+			parser_data.VariableDefinition{Type: "str", Description: "which layout to use. [dwindle/master]"},
+			[]parser_data.AllowedValue{{Value: "dwindle"}, {Value: "master"}},
+		},
+		{
+			parser_data.VariableDefinition{Type: "int", Description: "VRR. 0 - off, 1 - on, 2 - fullscreen only [0/1/2]"},
+			[]parser_data.AllowedValue{{Value: "0", Description: "off"}, {Value: "1", Description: "on"}, {Value: "2", Description: "fullscreen only"}},
+		},
+		{
+			parser_data.VariableDefinition{Type: "int", Description: "Options: 0 (disabled), 1 (enabled)"},
+			[]parser_data.AllowedValue{{Value: "0", Description: "disabled"}, {Value: "1", Description: "enabled"}},
+		},
+		{
+			parser_data.VariableDefinition{Type: "str", Description: "Transfer function. default - Use default value (Gamma 2.2), srgb - Treat unspecified as sRGB"},
+			[]parser_data.AllowedValue{{Value: "default", Description: "Use default value (Gamma 2.2)"}, {Value: "srgb", Description: "Treat unspecified as sRGB"}},
+		},
+		{
+			parser_data.VariableDefinition{Type: "int", Description: "0 -> disabled, 1 -> enabled with timeout, 2 -> enabled sticky. libinput#tap-and-drag"},
+			[]parser_data.AllowedValue{{Value: "0", Description: "disabled"}, {Value: "1", Description: "enabled with timeout"}, {Value: "2", Description: "enabled sticky"}},
+		},
+		{parser_data.VariableDefinition{Type: "float", Description: "opacity [0.0 - 1.0]"}, nil},
+		{parser_data.VariableDefinition{Type: "int", Description: "power [1 - 4]"}, nil},
+		{parser_data.VariableDefinition{Type: "bool", Description: "whether [0/1]"}, nil},
+	} {
+		if actual := allowedValues(test.variable); !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("%q: expected %v, got %v", test.variable.Description, test.expected, actual)
+		}
+	}
+
+	for _, section := range parser_data.Sections {
+		for _, variable := range section.Variables {
+			if variable.Default != "[[Empty]]" && !variable.Allows(variable.Default) {
+				t.Errorf("default value %q of %s is not one of its allowed values", variable.Default, variable.Name)
+			}
+		}
+	}
+}
+
+func TestValueBounds(t *testing.T) {
+	for _, test := range []struct {
+		variable parser_data.VariableDefinition
+		expected *parser_data.ValueBounds
+	}{
+		{parser_data.VariableDefinition{Type: "float", Description: "opacity [0.0 - 1.0]"}, &parser_data.ValueBounds{Min: 0, Max: 1}},
+		{parser_data.VariableDefinition{Type: "int", Description: "falloff power [1 - 4]"}, &parser_data.ValueBounds{Min: 1, Max: 4}},
+		{parser_data.VariableDefinition{Type: "float", Description: "scale [0.1 - 1.9]"}, &parser_data.ValueBounds{Min: 0.1, Max: 1.9}},
+		{parser_data.VariableDefinition{Type: "vec2", Description: "region [0, 0]"}, nil},
+		{parser_data.VariableDefinition{Type: "str", Description: "name [1 - 4]"}, nil},
+	} {
+		if actual := valueBounds(test.variable); !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("%q: expected %v, got %v", test.variable.Description, test.expected, actual)
+		}
+	}
+
+	for _, section := range parser_data.Sections {
+		for _, variable := range section.Variables {
+			if value, err := strconv.ParseFloat(variable.Default, 64); err == nil && variable.Bounds != nil && !variable.Bounds.Contains(value) {
+				t.Errorf("default value %q of %s is not within %s", variable.Default, variable.Name, variable.Bounds)
+			}
+		}
+	}
+}
+
+func TestAnimationTreeIndentation(t *testing.T) {
+	source := "### Animation tree\n\n```txt\nglobal\n  ↳ windows - styles: slide, popin\n      ↳ windowsIn - window open\n```\n"
+	if _, err := parseAnimationTree([]byte(source)); err == nil {
+		t.Error("expected an error for windowsIn, indented deeper than a child of windows")
+	}
+
+	source = "### Animation tree\n\n```txt\nglobal\n  ↳ windows - styles: slide, popin\n    ↳ windowsIn - window open - styles: same as windows\n```\n"
+	animations, err := parseAnimationTree([]byte(source))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(animations) != 3 || animations[2].Parent != "windows" || len(animations[2].Styles) != 2 {
+		t.Errorf("unexpected animations: %+v", animations)
+	}
+}
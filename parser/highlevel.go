@@ -140,8 +140,8 @@ type ConfigurationDecoration struct {
 	// whether the window border should be a part of the window
 	BorderPartOfWindow bool `json:"border_part_of_window"`
 
-	Blur   ConfigurationDecorationBlur       `json:"blur"`
-	Shadow ConfigurationDecorationBlurShadow `json:"shadow"`
+	Blur   ConfigurationDecorationBlur   `json:"blur"`
+	Shadow ConfigurationDecorationShadow `json:"shadow"`
 }
 
 type ConfigurationDecorationBlur struct {
@@ -194,7 +194,7 @@ type ConfigurationDecorationBlur struct {
 	InputMethodsIgnorealpha float32 `json:"input_methods_ignorealpha"`
 }
 
-type ConfigurationDecorationBlurShadow struct {
+type ConfigurationDecorationShadow struct {
 	// enable drop shadows on windows
 	Enabled bool `json:"enabled"`
 
@@ -319,10 +319,10 @@ type ConfigurationInput struct {
 	// Emulates discrete scrolling from high resolution scrolling events. 0 disables it, 1 enables handling of non-standard events only, and 2 force enables all scroll wheel events to be handled
 	EmulateDiscreteScroll int `json:"emulate_discrete_scroll"`
 
-	Touchpad        ConfigurationInputTouchpad                                 `json:"touchpad"`
-	Touchdevice     ConfigurationInputTouchpadTouchdevice                      `json:"touchdevice"`
-	Virtualkeyboard ConfigurationInputTouchpadTouchdeviceVirtualkeyboard       `json:"virtualkeyboard"`
-	Tablet          ConfigurationInputTouchpadTouchdeviceVirtualkeyboardTablet `json:"tablet"`
+	Touchpad        ConfigurationInputTouchpad        `json:"touchpad"`
+	Touchdevice     ConfigurationInputTouchdevice     `json:"touchdevice"`
+	Virtualkeyboard ConfigurationInputVirtualkeyboard `json:"virtualkeyboard"`
+	Tablet          ConfigurationInputTablet          `json:"tablet"`
 }
 
 type ConfigurationInputTouchpad struct {
@@ -363,7 +363,7 @@ type ConfigurationInputTouchpad struct {
 	Drag3fg int `json:"drag_3fg"`
 }
 
-type ConfigurationInputTouchpadTouchdevice struct {
+type ConfigurationInputTouchdevice struct {
 	// Transform the input from touchdevices. The possible transformations are the same as those of the monitors. -1 means it's unset.
 	Transform int `json:"transform"`
 
@@ -374,7 +374,7 @@ type ConfigurationInputTouchpadTouchdevice struct {
 	Enabled bool `json:"enabled"`
 }
 
-type ConfigurationInputTouchpadTouchdeviceVirtualkeyboard struct {
+type ConfigurationInputVirtualkeyboard struct {
 	// Unify key down states and modifier states with other keyboards. 0 -> no, 1 -> yes, 2 -> yes unless IME client
 	ShareStates int `json:"share_states"`
 
@@ -382,7 +382,7 @@ type ConfigurationInputTouchpadTouchdeviceVirtualkeyboard struct {
 	ReleasePressedOnClose bool `json:"release_pressed_on_close"`
 }
 
-type ConfigurationInputTouchpadTouchdeviceVirtualkeyboardTablet struct {
+type ConfigurationInputTablet struct {
 	// transform the input from tablets. The possible transformations are the same as those of the monitors. -1 means it's unset.
 	Transform int `json:"transform"`
 
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"image/color"
+	"math"
 	"regexp"
 	"strconv"
 	"strings"
@@ -58,9 +59,20 @@ type CustomVariable struct {
 type Statement struct {
 	Keyword   Keyword  `json:"k"`
 	Arguments []Value  `json:"args"`
+	ValueRaw  string   `json:"r"`
 	Position  Position `json:"pos"`
 }
 
+// RawArguments returns the comma-separated arguments of the statement as they were written, without surrounding whitespace.
+// The returned slice is aligned with Arguments.
+func (s Statement) RawArguments() []string {
+	args := strings.Split(s.ValueRaw, ",")
+	for i, arg := range args {
+		args[i] = strings.TrimSpace(arg)
+	}
+	return args
+}
+
 type Keyword string
 
 // ValueKind represents the kind of values assignments can have.
@@ -77,7 +89,7 @@ const (
 	String
 	Gradient
 	KindFontWeight
-	// Value contains a custom variable, its type can't be determined at compile time
+	// Value contains a custom variable that could not be expanded, see Section.ExpandVariables
 	Custom
 )
 
@@ -139,13 +151,32 @@ var ColorValuePattern = regexp.MustCompile(regexp.MustCompile(`\s+`).ReplaceAllS
 		(?P<legacy_g>[0-9a-fA-F]{2})
 		(?P<legacy_b>[0-9a-fA-F]{2})
 	)
+	|
+	(?:rgba?\(
+		(?P<decimal_r>\d{1,3}),
+		(?P<decimal_g>\d{1,3}),
+		(?P<decimal_b>\d{1,3})
+		(?:,(?P<decimal_a>\d*\.?\d+))?
+	\))
 `, ""))
-var GradientAnglePattern = regexp.MustCompile(`(\d+)deg`)
+var GradientAnglePattern = regexp.MustCompile(`^(\d+)deg$`)
 var ModMaskSeparator = regexp.MustCompile(`[^a-zA-Z0-9,]`)
 
 type GradientValue struct {
 	Stops []Value `json:"stops,omitempty"`
 	Angle float32 `json:"angle,omitempty"`
+	// AngleStart and AngleEnd are the position of the deg token, zero when the angle is not written
+	AngleStart Position `json:"angleStart,omitzero"`
+	AngleEnd   Position `json:"angleEnd,omitzero"`
+}
+
+// HasAngle is true when the angle of the gradient is written.
+func (g GradientValue) HasAngle() bool {
+	return g.AngleEnd != g.AngleStart
+}
+
+func (g GradientValue) AngleLSPRange() protocol.Range {
+	return protocol.Range{Start: g.AngleStart.LSP(), End: g.AngleEnd.LSP()}
 }
 
 type Value struct {
@@ -159,9 +190,10 @@ type Value struct {
 	String     string        `json:"str,omitempty"`
 	Gradient   GradientValue `json:"gradient,omitempty"`
 	FontWeight FontWeight    `json:"fontweight,omitempty"`
-	Custom     string        `json:"custom,omitempty"`
-	Start      Position      `json:"start"`
-	End        Position      `json:"end"`
+	// Custom is the value as written, when it references custom variables
+	Custom string   `json:"custom,omitempty"`
+	Start  Position `json:"start"`
+	End    Position `json:"end"`
 }
 
 func (v Value) GoValue() any {
@@ -256,7 +288,7 @@ func Parse(input string) (Section, error) {
 
 		if strings.Contains(line, "=") {
 			ass, stmt, customVar, isStatement, isCustomVar := ParseEqualLine(line, originalLine, Position{i, 0})
-			pos := Position{i, strings.IndexFunc(line, unicode.IsPrint)}
+			pos := Position{i, strings.IndexFunc(originalLine, not(unicode.IsSpace))}
 			if isCustomVar {
 				customVar.Position = pos
 				currentSection.Variables = append(currentSection.Variables, customVar)
@@ -284,6 +316,7 @@ func Parse(input string) (Section, error) {
 	}
 	// FIXME 0 is incorrect, but do we care?
 	document.End = Position{endLine, 0}
+	document.ExpandVariables(VariablesOf(document))
 	return document, nil
 }
 
@@ -299,7 +332,8 @@ func ParseEqualLine(line string, originalLine string, start Position) (ass Assig
 	encounteredEquals := false
 	encounteredValue := false
 	valueStart := start
-	valueEnd := Position{start.Line, strings.LastIndexFunc(originalLine, not(unicode.IsSpace))}
+	// End is exclusive, like in LSP ranges
+	valueEnd := Position{start.Line, strings.LastIndexFunc(originalLine, not(unicode.IsSpace)) + 1}
 	for i, char := range originalLine {
 		if !encounteredEquals && unicode.IsSpace(char) {
 			continue
@@ -317,7 +351,7 @@ func ParseEqualLine(line string, originalLine string, start Position) (ass Assig
 
 		if encounteredValue {
 			if char == '#' {
-				valueEnd.Column = strings.LastIndexFunc(originalLine[:i], not(unicode.IsSpace))
+				valueEnd.Column = strings.LastIndexFunc(originalLine[:i], not(unicode.IsSpace)) + 1
 				break
 			}
 			valueRaw += string(char)
@@ -332,7 +366,7 @@ func ParseEqualLine(line string, originalLine string, start Position) (ass Assig
 			Assignment: _ass,
 		}
 	} else if isStatement {
-		stmt = parseStatement(key, valueRaw)
+		stmt = parseStatement(key, valueRaw, valueStart)
 	} else {
 		ass = parseAssignment(key, valueRaw, valueStart)
 		ass.Value.Start = valueStart
@@ -342,14 +376,23 @@ func ParseEqualLine(line string, originalLine string, start Position) (ass Assig
 	return
 }
 
-func parseStatement(key string, valueRaw string) Statement {
+func parseStatement(key string, valueRaw string, valueStart Position) Statement {
 	args := make([]Value, 0)
+	cursorAt := valueStart.Column
 	for _, arg := range strings.Split(valueRaw, ",") {
-		args = append(args, parseValue(strings.TrimSpace(arg) /* TODO: pass down valueStart position */, Position{0, 0}))
+		trimmed := strings.TrimSpace(arg)
+		argStart := Position{valueStart.Line, cursorAt + strings.Index(arg, trimmed)}
+		value := parseValue(trimmed, argStart)
+		value.Start = argStart
+		value.End = Position{valueStart.Line, argStart.Column + len(trimmed)}
+		args = append(args, value)
+		// +1 for the comma we splitted on
+		cursorAt += len(arg) + 1
 	}
 	return Statement{
 		Keyword:   Keyword(key),
 		Arguments: args,
+		ValueRaw:  valueRaw,
 	}
 }
 
@@ -499,7 +542,7 @@ func parseFontWeight(raw string) (FontWeight, error) {
 		}
 
 		if parsed < 100 || parsed > 1000 {
-			return 0, fmt.Errorf("font weight %q must be between 100 and 1000, or one of the predefined keywords", parsed)
+			return 0, fmt.Errorf("font weight %d must be between 100 and 1000, or one of the predefined keywords", parsed)
 		}
 
 		return FontWeight(parsed), nil
@@ -537,38 +580,58 @@ func parseVec2(raw string) ([2]float32, error) {
 	return vec, nil
 }
 
+// ParseColor parses the color syntaxes Hyprland accepts: rgb(RRGGBB), rgba(RRGGBBAA), rgb(r, g, b), rgba(r, g, b, a) with a between 0 and 1, and the legacy 0xAARRGGBB.
 func ParseColor(raw string) (color.RGBA, error) {
-	if ColorValuePattern.MatchString(raw) {
-		matches := ColorValuePattern.FindStringSubmatch(raw)
-		return color.RGBA{
-			R: decodeHexComponent(matches, "r", 0),
-			G: decodeHexComponent(matches, "g", 0),
-			B: decodeHexComponent(matches, "b", 0),
-			A: decodeHexComponent(matches, "a", 0xff),
-		}, nil
+	compact := strings.Join(strings.Fields(raw), "")
+	matches := ColorValuePattern.FindStringSubmatch(compact)
+	if matches == nil || matches[0] != compact {
+		return color.RGBA{0, 0, 0, 0}, errors.New("invalid color value")
+	}
+	if matches[ColorValuePattern.SubexpIndex("decimal_r")] != "" {
+		return decodeDecimalColor(matches)
+	}
+	return color.RGBA{
+		R: decodeHexComponent(matches, "r", 0),
+		G: decodeHexComponent(matches, "g", 0),
+		B: decodeHexComponent(matches, "b", 0),
+		A: decodeHexComponent(matches, "a", 0xff),
+	}, nil
+}
+
+func decodeDecimalColor(matches []string) (color.RGBA, error) {
+	components := [3]uint8{}
+	for i, component := range []string{"r", "g", "b"} {
+		decoded, err := strconv.ParseUint(matches[ColorValuePattern.SubexpIndex("decimal_"+component)], 10, 8)
+		if err != nil {
+			return color.RGBA{}, fmt.Errorf("invalid color component %s: must be between 0 and 255", component)
+		}
+		components[i] = uint8(decoded)
 	}
-	return color.RGBA{0, 0, 0, 0}, errors.New("invalid color value")
+	alpha := 1.0
+	if raw := matches[ColorValuePattern.SubexpIndex("decimal_a")]; raw != "" {
+		alpha, _ = strconv.ParseFloat(raw, 64)
+		if alpha > 1 {
+			return color.RGBA{}, errors.New("invalid color alpha: must be between 0 and 1")
+		}
+	}
+	return color.RGBA{R: components[0], G: components[1], B: components[2], A: uint8(math.Round(alpha * 255))}, nil
 }
 
 func parseGradient(raw string, valueStart Position) (GradientValue, error) {
-	args := strings.Split(raw, " ")
+	tokens := GradientTokens(raw)
 	value := GradientValue{}
 
-	cursorAt := 0
-	for i, arg := range args {
-		originalArg := arg
-		arg = strings.TrimSpace(arg)
-		// +1 since we splitted the spaces out
-		cursorAt += len(originalArg) - len(arg) + 1
-
-		color, err := ParseColor(arg)
+	for i, token := range tokens {
+		color, err := ParseColor(token.Text)
 		if err != nil {
-			if i == len(args)-1 {
-				if !GradientAnglePattern.MatchString(arg) {
+			if i == len(tokens)-1 && i > 0 {
+				if !GradientAnglePattern.MatchString(token.Text) {
 					return GradientValue{}, errors.New("invalid gradient angle")
 				}
-				angle, _ := strconv.ParseFloat(GradientAnglePattern.FindStringSubmatch(arg)[1], 32)
+				angle, _ := strconv.ParseFloat(GradientAnglePattern.FindStringSubmatch(token.Text)[1], 32)
 				value.Angle = float32(angle)
+				value.AngleStart = Position{valueStart.Line, valueStart.Column + token.Start}
+				value.AngleEnd = Position{valueStart.Line, valueStart.Column + token.Start + len(token.Text)}
 				return value, nil
 			} else {
 				return GradientValue{}, errors.New("invalid gradient value")
@@ -578,15 +641,51 @@ func parseGradient(raw string, valueStart Position) (GradientValue, error) {
 		value.Stops = append(value.Stops, Value{
 			Kind:  Color,
 			Color: color,
-			Start: Position{valueStart.Line, valueStart.Column + cursorAt - 1},
-			End:   Position{valueStart.Line, valueStart.Column + cursorAt + len(arg) - 1},
+			Start: Position{valueStart.Line, valueStart.Column + token.Start},
+			End:   Position{valueStart.Line, valueStart.Column + token.Start + len(token.Text)},
 		})
-		cursorAt += len(arg)
 	}
 
+	if len(value.Stops) == 0 {
+		return GradientValue{}, errors.New("empty gradient")
+	}
 	return value, nil
 }
 
+type GradientToken struct {
+	Text string
+	// Start is the byte offset of the token in the gradient
+	Start int
+}
+
+// GradientTokens splits a gradient on whitespace, except inside parentheses, so that rgba(r, g, b, a) colors stay whole.
+func GradientTokens(raw string) []GradientToken {
+	tokens := make([]GradientToken, 0)
+	depth, start := 0, -1
+	for i, char := range raw {
+		switch {
+		case char == '(':
+			depth++
+		case char == ')' && depth > 0:
+			depth--
+		}
+		if unicode.IsSpace(char) && depth == 0 {
+			if start >= 0 {
+				tokens = append(tokens, GradientToken{Text: raw[start:i], Start: start})
+				start = -1
+			}
+			continue
+		}
+		if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		tokens = append(tokens, GradientToken{Text: raw[start:], Start: start})
+	}
+	return tokens
+}
+
 func hexToColor(hexstring string) color.RGBA {
 	components := []uint64{0, 0, 0, 0xff}
 	for i := 0; i < 4; i++ {
@@ -636,6 +735,15 @@ func (s Section) WalkValues(f func(assignment *Assignment, v *Value)) {
 	}
 }
 
+func (s Section) WalkStatements(f func(stmt *Statement)) {
+	for _, stmt := range s.Statements {
+		f(&stmt)
+	}
+	for _, s := range s.Subsections {
+		s.WalkStatements(f)
+	}
+}
+
 func (s Section) WalkCustomVariables(f func(v *CustomVariable)) {
 	for _, v := range s.Variables {
 		f(&v)
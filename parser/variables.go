@@ -0,0 +1,220 @@
+package parser
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Variables are custom variables, in the order they are declared.
+//
+// References are expanded the way hyprlang does: $name is replaced by the value of the longest declared variable name it starts with,
+// as declared by the latest declaration before the reference, or by its last declaration if it is only declared afterwards
+// (e.g. in a file sourced later). Values of variables are expanded too.
+// ${name} references the exact name, and ${name:-fallback} is replaced by fallback when name is not declared.
+type Variables struct {
+	Declarations []CustomVariable
+	// LookupEnv resolves references to names that no custom variable has, typically os.LookupEnv. Nil to leave them undefined.
+	LookupEnv func(name string) (string, bool)
+}
+
+// Reference is a reference to a variable in a value.
+type Reference struct {
+	// Name of the variable, without the $
+	Name string
+	// Start and End are the byte offsets of the reference in the value
+	Start, End int
+	// Fallback is the value used when the variable is undefined, for ${name:-fallback} references
+	Fallback    string
+	HasFallback bool
+	Declared    bool
+	// Environment is true when no custom variable has the name but an environment variable does
+	Environment bool
+}
+
+// Expansion is the result of expanding the references of a value.
+type Expansion struct {
+	Value string
+	// Undefined are the names of referenced variables that are not declared, in order of appearance
+	Undefined []string
+	// Cyclic are the names of variables whose value references themselves, directly or not
+	Cyclic []string
+}
+
+// Complete is true when every reference could be expanded.
+func (e Expansion) Complete() bool {
+	return len(e.Undefined) == 0 && len(e.Cyclic) == 0
+}
+
+var variableReferencePattern = regexp.MustCompile(`\$(?:\{([A-Za-z0-9_]+)(?::-([^}]*))?\}|([A-Za-z0-9_]+))`)
+
+// VariablesOf collects the custom variables declared in documents, in order.
+func VariablesOf(documents ...Section) Variables {
+	vars := Variables{}
+	for _, document := range documents {
+		declarations := make([]CustomVariable, 0)
+		document.WalkCustomVariables(func(v *CustomVariable) {
+			declarations = append(declarations, *v)
+		})
+		slices.SortStableFunc(declarations, func(a, b CustomVariable) int { return a.Position.Line - b.Position.Line })
+		vars.Declarations = append(vars.Declarations, declarations...)
+	}
+	return vars
+}
+
+// Names returns the declared variable names, sorted and without duplicates.
+func (vars Variables) Names() []string {
+	names := make([]string, 0, len(vars.Declarations))
+	for _, v := range vars.Declarations {
+		names = append(names, v.Key)
+	}
+	slices.Sort(names)
+	return slices.Compact(names)
+}
+
+// Lookup returns the value of the last declaration of name, as written.
+func (vars Variables) Lookup(name string) (string, bool) {
+	if i := vars.declaration(name, len(vars.Declarations)); i >= 0 {
+		return strings.TrimSpace(vars.Declarations[i].ValueRaw), true
+	}
+	return "", false
+}
+
+// DeclarationAt returns the index of the declaration a reference to name made before the declaration at index before uses, or -1.
+func (vars Variables) DeclarationAt(name string, before int) int {
+	return vars.declaration(name, before)
+}
+
+// declaration returns the index of the declaration a reference to name made before the declaration at index before gets, or -1.
+func (vars Variables) declaration(name string, before int) int {
+	for i := min(before, len(vars.Declarations)) - 1; i >= 0; i-- {
+		if vars.Declarations[i].Key == name {
+			return i
+		}
+	}
+	for i := len(vars.Declarations) - 1; i >= before; i-- {
+		if vars.Declarations[i].Key == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// References finds the references to variables in raw.
+func (vars Variables) References(raw string) []Reference {
+	references := make([]Reference, 0)
+	for _, match := range variableReferencePattern.FindAllStringSubmatchIndex(raw, -1) {
+		reference := Reference{Start: match[0], End: match[1]}
+		if match[2] >= 0 {
+			reference.Name = raw[match[2]:match[3]]
+			reference.Declared = vars.declaration(reference.Name, len(vars.Declarations)) >= 0
+			if match[4] >= 0 {
+				reference.Fallback, reference.HasFallback = raw[match[4]:match[5]], true
+			}
+		} else {
+			written := raw[match[6]:match[7]]
+			reference.Name = written
+			for _, v := range vars.Declarations {
+				if strings.HasPrefix(written, v.Key) && (!reference.Declared || len(v.Key) > len(reference.Name)) {
+					reference.Name, reference.Declared = v.Key, true
+				}
+			}
+			reference.End = reference.Start + 1 + len(reference.Name)
+		}
+		if !reference.Declared && vars.LookupEnv != nil {
+			_, reference.Environment = vars.LookupEnv(reference.Name)
+		}
+		references = append(references, reference)
+	}
+	return references
+}
+
+// Expand expands the references of raw, used after every declaration.
+func (vars Variables) Expand(raw string) Expansion {
+	return vars.ExpandAt(raw, len(vars.Declarations))
+}
+
+// ExpandAt expands the references of raw, used before the declaration at index before.
+func (vars Variables) ExpandAt(raw string, before int) Expansion {
+	expansion := Expansion{}
+	expansion.Value = vars.expand(raw, before, map[int]bool{}, &expansion)
+	return expansion
+}
+
+func (vars Variables) expand(raw string, before int, expanding map[int]bool, expansion *Expansion) string {
+	if !strings.Contains(raw, "$") {
+		return raw
+	}
+
+	var expanded strings.Builder
+	cursor := 0
+	for _, reference := range vars.References(raw) {
+		expanded.WriteString(raw[cursor:reference.Start])
+		cursor = reference.End
+
+		switch i := vars.declaration(reference.Name, before); {
+		case i >= 0 && expanding[i]:
+			if !slices.Contains(expansion.Cyclic, reference.Name) {
+				expansion.Cyclic = append(expansion.Cyclic, reference.Name)
+			}
+			expanded.WriteString(raw[reference.Start:reference.End])
+		case i >= 0:
+			expanding[i] = true
+			expanded.WriteString(vars.expand(strings.TrimSpace(vars.Declarations[i].ValueRaw), i, expanding, expansion))
+			delete(expanding, i)
+		case reference.Environment:
+			value, _ := vars.LookupEnv(reference.Name)
+			expanded.WriteString(value)
+		case reference.HasFallback:
+			expanded.WriteString(vars.expand(reference.Fallback, before, expanding, expansion))
+		default:
+			if !slices.Contains(expansion.Undefined, reference.Name) {
+				expansion.Undefined = append(expansion.Undefined, reference.Name)
+			}
+			expanded.WriteString(raw[reference.Start:reference.End])
+		}
+	}
+	expanded.WriteString(raw[cursor:])
+	return expanded.String()
+}
+
+// ExpandVariables gives values that reference variables the kind of their expanded value.
+// vars are the variables of the document the section is part of: each value is expanded with the declarations that precede its line, see Variables.
+// Values that can't be completely expanded keep the Custom kind.
+func (s *Section) ExpandVariables(vars Variables) {
+	before := func(line int) int {
+		return len(slices.DeleteFunc(slices.Clone(vars.Declarations), func(v CustomVariable) bool { return v.Position.Line >= line }))
+	}
+	retype := func(value *Value, line int) {
+		if value.Custom == "" {
+			return
+		}
+		expansion := vars.ExpandAt(value.Custom, before(line))
+		if !expansion.Complete() {
+			return
+		}
+		retyped := parseValue(expansion.Value, value.Start)
+		retyped.Custom, retyped.Start, retyped.End = value.Custom, value.Start, value.End
+		// Stops come from the expanded text, their positions are the ones of the whole value
+		for i := range retyped.Gradient.Stops {
+			retyped.Gradient.Stops[i].Start, retyped.Gradient.Stops[i].End = value.Start, value.End
+		}
+		retyped.Gradient.AngleStart, retyped.Gradient.AngleEnd = Position{}, Position{}
+		*value = retyped
+	}
+
+	for i := range s.Assignments {
+		retype(&s.Assignments[i].Value, s.Assignments[i].Position.Line)
+	}
+	for i := range s.Variables {
+		retype(&s.Variables[i].Value, s.Variables[i].Position.Line)
+	}
+	for i := range s.Statements {
+		for j := range s.Statements[i].Arguments {
+			retype(&s.Statements[i].Arguments[j], s.Statements[i].Position.Line)
+		}
+	}
+	for i := range s.Subsections {
+		s.Subsections[i].ExpandVariables(vars)
+	}
+}
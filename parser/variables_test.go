@@ -0,0 +1,69 @@
+package parser
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestExpandVariables(t *testing.T) {
+	document, err := Parse(`$mainMod = SUPER
+$accent = rgba(33ccffee)
+$border = $accent rgba(00ff99ee) 45deg
+$gaps = 5
+$gaps = $gaps0
+$a = $b
+$b = $a
+general {
+    col.active_border = $border
+    gaps_in = $gaps
+    gaps_out = ${missing:-20}
+    layout = $undefined
+}
+bind = $mainMod SHIFT, Q, killactive,
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vars := VariablesOf(document)
+	cases := []struct {
+		raw       string
+		expected  string
+		undefined []string
+		cyclic    []string
+	}{
+		{"$mainMod, Q", "SUPER, Q", nil, nil},
+		{"$mainModifier", "SUPERifier", nil, nil},
+		{"${mainMod}_L", "SUPER_L", nil, nil},
+		{"$border", "rgba(33ccffee) rgba(00ff99ee) 45deg", nil, nil},
+		{"$gaps", "50", nil, nil},
+		{"${nope:-$gaps}", "50", nil, nil},
+		{"$nope", "$nope", []string{"nope"}, nil},
+		{"$a", "$a", nil, []string{"a"}},
+	}
+	for _, c := range cases {
+		expansion := vars.Expand(c.raw)
+		if expansion.Value != c.expected || !slices.Equal(expansion.Undefined, c.undefined) || !slices.Equal(expansion.Cyclic, c.cyclic) {
+			t.Errorf("Expand(%q) = %+v, expected %q, undefined %v, cyclic %v", c.raw, expansion, c.expected, c.undefined, c.cyclic)
+		}
+	}
+
+	general := document.Subsections[0]
+	kinds := map[string]ValueKind{
+		"col.active_border": Gradient,
+		"gaps_in":           Integer,
+		"gaps_out":          Integer,
+		"layout":            Custom,
+	}
+	for _, assignment := range general.Assignments {
+		if assignment.Value.Kind != kinds[assignment.Key] {
+			t.Errorf("expected %s to be of kind %d, got %d", assignment.Key, kinds[assignment.Key], assignment.Value.Kind)
+		}
+	}
+	if gaps := general.Assignments[1].Value; gaps.Integer != 50 || gaps.Custom != "$gaps" {
+		t.Errorf("expected gaps_in to be the last value of $gaps, got %+v", gaps)
+	}
+	if mods := document.Statements[0].Arguments[0]; mods.Kind != Modmask || !slices.Equal(mods.Modmask, []ModKey{ModSuper, ModShift}) {
+		t.Errorf("expected $mainMod SHIFT to be a modmask, got %+v", mods)
+	}
+}
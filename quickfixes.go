@@ -0,0 +1,371 @@
+package hyprls
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/hyprland-community/hyprls/parser"
+	"go.lsp.dev/protocol"
+)
+
+// configProblem is a diagnostic that comes with quick fixes.
+type configProblem struct {
+	Diagnostic protocol.Diagnostic
+	// Fixes are offered in order, the first one is the preferred one
+	Fixes []quickFix
+}
+
+// quickFix is a set of edits of the document that fixes a problem.
+type quickFix struct {
+	Title string
+	Edits []protocol.TextEdit
+}
+
+type problemsFinder func(uri protocol.URI, document parser.Section, lines []string) []configProblem
+
+var problemsFinders = []problemsFinder{
+	misspelledOptionProblems,
+	undefinedVariableProblems,
+	legacyColorProblems,
+	duplicateAssignmentProblems,
+	unclosedSectionProblems,
+	deprecationProblems,
+	disallowedValueProblems,
+	outOfBoundsProblems,
+	gradientProblems,
+	contrastProblems,
+	unknownKeyProblems,
+}
+
+// freeformSections can be repeated and hold options that are not documented with the section itself.
+var freeformSections = []string{"device", "monitorv2", "windowrule", "layerrule", "plugin"}
+
+func configProblems(uri protocol.URI, document parser.Section) []configProblem {
+	contents, err := file(uri)
+	if err != nil {
+		return []configProblem{}
+	}
+	lines := strings.Split(contents, "\n")
+
+	problems := make([]configProblem, 0)
+	for _, find := range problemsFinders {
+		problems = append(problems, find(uri, document, lines)...)
+	}
+	return problems
+}
+
+func quickFixableDiagnostics(uri protocol.URI, document parser.Section) []protocol.Diagnostic {
+	diagnostics := make([]protocol.Diagnostic, 0)
+	for _, problem := range configProblems(uri, document) {
+		diagnostics = append(diagnostics, problem.Diagnostic)
+	}
+	return diagnostics
+}
+
+// walkAssignmentsWithPath calls f on every assignment of the section along with the path of the option it sets,
+// such as [decoration blur size] for both decoration { blur { size = ... } } and decoration:blur:size = ...
+func walkAssignmentsWithPath(section parser.Section, path []string, f func(path []string, assignment parser.Assignment)) {
+	for _, assignment := range section.Assignments {
+		f(append(slices.Clone(path), strings.Split(assignment.Key, ":")...), assignment)
+	}
+	for _, subsection := range section.Subsections {
+		walkAssignmentsWithPath(subsection, append(slices.Clone(path), subsection.Name), f)
+	}
+}
+
+func isFreeformPath(path []string) bool {
+	return slices.ContainsFunc(path, func(name string) bool {
+		return slices.Contains(freeformSections, name)
+	})
+}
+
+// keyRange spans the last component of an assignment's key, the name of the option.
+func keyRange(lines []string, assignment parser.Assignment) protocol.Range {
+	line := lines[assignment.Position.Line]
+	end := len(indentation(line)) + len(assignment.Key)
+	start := end - len(assignment.Key[strings.LastIndex(assignment.Key, ":")+1:])
+	return protocol.Range{
+		Start: protocol.Position{Line: uint32(assignment.Position.Line), Character: uint32(start)},
+		End:   protocol.Position{Line: uint32(assignment.Position.Line), Character: uint32(end)},
+	}
+}
+
+func misspelledOptionProblems(uri protocol.URI, document parser.Section, lines []string) []configProblem {
+	problems := make([]configProblem, 0)
+	schema := schemaOf(uri)
+	walkAssignmentsWithPath(document, []string{}, func(path []string, assignment parser.Assignment) {
+		if len(path) < 2 || isFreeformPath(path) || isMigratedOption(path) {
+			return
+		}
+		section := schema.FindSectionDefinitionByName(path[len(path)-2])
+		name := path[len(path)-1]
+		if section == nil || section.VariableDefinition(name) != nil {
+			return
+		}
+
+		candidates := make([]string, 0, len(section.Variables))
+		for _, variable := range section.Variables {
+			candidates = append(candidates, variable.Name)
+		}
+		suggestion, found := closestName(name, candidates)
+		if !found {
+			return
+		}
+
+		rang := keyRange(lines, assignment)
+		problems = append(problems, configProblem{
+			Diagnostic: protocol.Diagnostic{
+				Range:    rang,
+				Severity: protocol.DiagnosticSeverityWarning,
+				Source:   diagnosticsSource,
+				Message:  fmt.Sprintf("unknown option %s in %s, did you mean %s?", name, strings.Join(path[:len(path)-1], ":"), suggestion),
+			},
+			Fixes: []quickFix{{
+				Title: fmt.Sprintf("Replace with %s", suggestion),
+				Edits: []protocol.TextEdit{{Range: rang, NewText: suggestion}},
+			}},
+		})
+	})
+	return problems
+}
+
+// variableReference is a $name in a value.
+type variableReference struct {
+	// Name is the name of the referenced variable, which can be a prefix of the name as written: $mainModifier refers to $mainMod if only the latter is declared.
+	Name  string
+	Range protocol.Range
+	// Declared is false when no variable matches the reference
+	Declared bool
+	// Resolved is true when the reference is declared, is an environment variable or has a fallback value
+	Resolved bool
+}
+
+// variableReferences finds references to custom variables in the values of a document.
+func variableReferences(lines []string, variables parser.Variables) []variableReference {
+	references := make([]variableReference, 0)
+	for i, line := range lines {
+		line, _, _ = strings.Cut(line, "#")
+		equals := strings.Index(line, "=")
+		if equals < 0 {
+			continue
+		}
+
+		for _, ref := range variables.References(line[equals:]) {
+			references = append(references, variableReference{
+				Name:     ref.Name,
+				Declared: ref.Declared,
+				Resolved: ref.Declared || ref.Environment || ref.HasFallback,
+				Range: protocol.Range{
+					Start: protocol.Position{Line: uint32(i), Character: uint32(equals + ref.Start)},
+					End:   protocol.Position{Line: uint32(i), Character: uint32(equals + ref.End)},
+				},
+			})
+		}
+	}
+	return references
+}
+
+// undefinedVariableProblems reports references to variables that are not declared, and variables whose value references themselves.
+// References in keywords' arguments that could be meant for a shell, such as $HOME in exec = ..., are only reported when they look like a typo.
+func undefinedVariableProblems(uri protocol.URI, document parser.Section, lines []string) []configProblem {
+	graph := configGraph(uri)
+	variables := graphVariables(graph)
+	names := variables.Names()
+
+	optionLines := make(map[uint32]bool)
+	walkAssignmentsWithPath(document, []string{}, func(path []string, assignment parser.Assignment) {
+		optionLines[uint32(assignment.Position.Line)] = true
+	})
+
+	problems := make([]configProblem, 0)
+	for _, reference := range variableReferences(lines, variables) {
+		if reference.Resolved {
+			continue
+		}
+		suggestion, found := closestName(reference.Name, names)
+		if !found {
+			if optionLines[reference.Range.Start.Line] {
+				problems = append(problems, configProblem{Diagnostic: protocol.Diagnostic{
+					Range:    reference.Range,
+					Severity: protocol.DiagnosticSeverityWarning,
+					Source:   diagnosticsSource,
+					Message:  fmt.Sprintf("undefined variable $%s", reference.Name),
+				}})
+			}
+			continue
+		}
+		problems = append(problems, configProblem{
+			Diagnostic: protocol.Diagnostic{
+				Range:    reference.Range,
+				Severity: protocol.DiagnosticSeverityWarning,
+				Source:   diagnosticsSource,
+				Message:  fmt.Sprintf("undefined variable $%s, did you mean $%s?", reference.Name, suggestion),
+			},
+			Fixes: []quickFix{{
+				Title: fmt.Sprintf("Replace with $%s", suggestion),
+				Edits: []protocol.TextEdit{{Range: reference.Range, NewText: "$" + suggestion}},
+			}},
+		})
+	}
+
+	// Declarations of this document start after the ones of the documents Hyprland reads before it
+	offset := 0
+	for _, doc := range graph {
+		if doc.URI == uri {
+			break
+		}
+		offset += len(parser.VariablesOf(doc.Document).Declarations)
+	}
+	for i, declaration := range parser.VariablesOf(document).Declarations {
+		expansion := variables.ExpandAt(declaration.ValueRaw, offset+i)
+		if len(expansion.Cyclic) == 0 {
+			continue
+		}
+		line := lines[declaration.Position.Line]
+		problems = append(problems, configProblem{Diagnostic: protocol.Diagnostic{
+			Range: protocol.Range{
+				Start: protocol.Position{Line: uint32(declaration.Position.Line), Character: uint32(len(indentation(line)))},
+				End:   protocol.Position{Line: uint32(declaration.Position.Line), Character: uint32(len(strings.TrimRightFunc(line, unicode.IsSpace)))},
+			},
+			Severity: protocol.DiagnosticSeverityError,
+			Source:   diagnosticsSource,
+			Message:  fmt.Sprintf("$%s can't be expanded, its value references itself through $%s", declaration.Key, strings.Join(expansion.Cyclic, ", $")),
+		}})
+	}
+	return problems
+}
+
+var legacyColorPattern = regexp.MustCompile(`\b0x([0-9a-fA-F]{2})([0-9a-fA-F]{6})\b`)
+
+func legacyColorProblems(uri protocol.URI, document parser.Section, lines []string) []configProblem {
+	problems := make([]configProblem, 0)
+	for i, line := range lines {
+		line, _, _ = strings.Cut(line, "#")
+		equals := strings.Index(line, "=")
+		if equals < 0 {
+			continue
+		}
+
+		for _, match := range legacyColorPattern.FindAllStringSubmatchIndex(line[equals:], -1) {
+			alpha, rgb := line[equals+match[2]:equals+match[3]], line[equals+match[4]:equals+match[5]]
+			replacement := fmt.Sprintf("rgba(%s%s)", rgb, alpha)
+			rang := protocol.Range{
+				Start: protocol.Position{Line: uint32(i), Character: uint32(equals + match[0])},
+				End:   protocol.Position{Line: uint32(i), Character: uint32(equals + match[1])},
+			}
+			problems = append(problems, configProblem{
+				Diagnostic: protocol.Diagnostic{
+					Range:    rang,
+					Severity: protocol.DiagnosticSeverityHint,
+					Source:   diagnosticsSource,
+					Message:  fmt.Sprintf("0xAARRGGBB colors are deprecated, use %s instead", replacement),
+					Tags:     []protocol.DiagnosticTag{protocol.DiagnosticTagDeprecated},
+				},
+				Fixes: []quickFix{{
+					Title: fmt.Sprintf("Convert to %s", replacement),
+					Edits: []protocol.TextEdit{{Range: rang, NewText: replacement}},
+				}},
+			})
+		}
+	}
+	return problems
+}
+
+// duplicateAssignmentProblems reports assignments that are overridden later in the same document, which have no effect.
+func duplicateAssignmentProblems(uri protocol.URI, document parser.Section, lines []string) []configProblem {
+	type occurrence struct {
+		path       []string
+		assignment parser.Assignment
+	}
+	occurrences := make([]occurrence, 0)
+	walkAssignmentsWithPath(document, []string{}, func(path []string, assignment parser.Assignment) {
+		if !isFreeformPath(path) {
+			occurrences = append(occurrences, occurrence{path, assignment})
+		}
+	})
+	// Sections are walked after the assignments of their parent
+	slices.SortStableFunc(occurrences, func(a, b occurrence) int {
+		return a.assignment.Position.Line - b.assignment.Position.Line
+	})
+
+	problems := make([]configProblem, 0)
+	for i, overridden := range occurrences {
+		for _, later := range occurrences[i+1:] {
+			if !slices.Equal(overridden.path, later.path) {
+				continue
+			}
+			problems = append(problems, configProblem{
+				Diagnostic: protocol.Diagnostic{
+					Range:    keyRange(lines, overridden.assignment),
+					Severity: protocol.DiagnosticSeverityWarning,
+					Source:   diagnosticsSource,
+					Message:  fmt.Sprintf("%s is set again on line %d, this assignment has no effect", strings.Join(overridden.path, ":"), later.assignment.Position.Line+1),
+					Tags:     []protocol.DiagnosticTag{protocol.DiagnosticTagUnnecessary},
+				},
+				Fixes: []quickFix{{
+					Title: "Remove overridden assignment",
+					Edits: []protocol.TextEdit{{Range: lineRange(overridden.assignment.Position.Line), NewText: ""}},
+				}},
+			})
+			break
+		}
+	}
+	return problems
+}
+
+func unclosedSectionProblems(uri protocol.URI, document parser.Section, lines []string) []configProblem {
+	opened := make([]int, 0)
+	for i, line := range lines {
+		line, _, _ = strings.Cut(line, "#")
+		line = strings.TrimSpace(line)
+		switch {
+		case strings.HasSuffix(line, "{"):
+			opened = append(opened, i)
+		case line == "}" && len(opened) > 0:
+			opened = opened[:len(opened)-1]
+		}
+	}
+	if len(opened) == 0 {
+		return []configProblem{}
+	}
+
+	var closing strings.Builder
+	lastLine := lines[len(lines)-1]
+	if strings.TrimSpace(lastLine) != "" {
+		closing.WriteString("\n")
+	}
+	for i := len(opened) - 1; i >= 0; i-- {
+		closing.WriteString(indentation(lines[opened[i]]) + "}\n")
+	}
+	fix := quickFix{
+		Title: "Add missing closing brace",
+		Edits: []protocol.TextEdit{{
+			Range:   collapsedRange(protocol.Position{Line: uint32(len(lines) - 1), Character: uint32(len(lastLine))}),
+			NewText: closing.String(),
+		}},
+	}
+	if len(opened) > 1 {
+		fix.Title = "Add missing closing braces"
+	}
+
+	problems := make([]configProblem, 0, len(opened))
+	for _, line := range opened {
+		header := lines[line]
+		problems = append(problems, configProblem{
+			Diagnostic: protocol.Diagnostic{
+				Range: protocol.Range{
+					Start: protocol.Position{Line: uint32(line), Character: uint32(len(indentation(header)))},
+					End:   protocol.Position{Line: uint32(line), Character: uint32(len(strings.TrimRight(header, " \t\r")))},
+				},
+				Severity: protocol.DiagnosticSeverityError,
+				Source:   diagnosticsSource,
+				Message:  fmt.Sprintf("section %s is never closed", strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(header), "{"))),
+			},
+			Fixes: []quickFix{fix},
+		})
+	}
+	return problems
+}
@@ -0,0 +1,64 @@
+package hyprls
+
+import (
+	"regexp"
+
+	parser_data "github.com/hyprland-community/hyprls/parser/data"
+	"go.lsp.dev/protocol"
+)
+
+// configuredHyprlandVersion is the Hyprland version set with the hyprlandVersion initialization option or setting, empty to use the documented one.
+var configuredHyprlandVersion string
+
+// hyprlandVersionCommentPattern matches comments such as # hyprlang version 0.45.2 that declare which Hyprland version a configuration is for.
+var hyprlandVersionCommentPattern = regexp.MustCompile(`(?im)^\s*#\s*hypr(?:lang|land)\s+version\s*[:=]?\s*(v?\d+\.\d+(?:\.\d+)?)\b`)
+
+// declaredHyprlandVersion returns the version declared with a # hyprlang version comment in contents.
+func declaredHyprlandVersion(contents string) (string, bool) {
+	match := hyprlandVersionCommentPattern.FindStringSubmatch(contents)
+	if match == nil {
+		return "", false
+	}
+	return match[1], true
+}
+
+// hyprlandVersionOf returns the Hyprland version the document at uri is written for:
+// the one declared in the document or in the main configuration that sources it, else the configured one, else the documented one.
+func hyprlandVersionOf(uri protocol.URI, contents string) string {
+	if version, ok := declaredHyprlandVersion(contents); ok {
+		return version
+	}
+	if root, found := mainConfigOf(uri); found && root != uri {
+		if rootContents, err := graphFile(root); err == nil {
+			if version, ok := declaredHyprlandVersion(rootContents); ok {
+				return version
+			}
+		}
+	}
+	if configuredHyprlandVersion != "" {
+		return configuredHyprlandVersion
+	}
+	return parser_data.HyprlandVersion
+}
+
+// documentVersion returns the Hyprland version the document at uri is written for, see hyprlandVersionOf.
+// Invalid versions are replaced by the documented one.
+func documentVersion(uri protocol.URI) string {
+	contents, _ := graphFile(uri)
+	version := hyprlandVersionOf(uri, contents)
+	if !parser_data.ValidVersion(version) {
+		return parser_data.HyprlandVersion
+	}
+	return parser_data.NormalizeVersion(version)
+}
+
+// schemaOf returns the documentation of the Hyprland version the document at uri is written for.
+// Sourced files use the version of the main configuration that sources them.
+func schemaOf(uri protocol.URI) parser_data.Schema {
+	return parser_data.SchemaFor(documentVersion(uri))
+}
+
+func extractHyprlandVersion(options map[string]any) (version string, isAvailable bool) {
+	version, isAvailable = options["hyprlandVersion"].(string)
+	return
+}
@@ -0,0 +1,50 @@
+package hyprls
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	parser_data "github.com/hyprland-community/hyprls/parser/data"
+	lspuri "go.lsp.dev/uri"
+)
+
+func TestDeclaredHyprlandVersion(t *testing.T) {
+	directory := t.TempDir()
+	mainFile := filepath.Join(directory, "hyprland.conf")
+	os.WriteFile(mainFile, []byte("# hyprlang version 0.44\nsource = decoration.conf\n"), 0644)
+	os.WriteFile(filepath.Join(directory, "decoration.conf"), []byte("decoration {\n    drop_shadow = yes\n}\n"), 0644)
+
+	uri := lspuri.File(filepath.Join(directory, "decoration.conf"))
+	document, err := parse(uri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version := documentVersion(uri); version != "0.44.0" {
+		t.Errorf("expected the version declared in hyprland.conf to be used, got %s", version)
+	}
+	if schema := schemaOf(uri); schema.FindVariableDefinitionInSection("decoration", "drop_shadow") == nil {
+		t.Errorf("expected the schema of Hyprland %s to document decoration:drop_shadow", schema.Version)
+	}
+	for _, problem := range configProblems(uri, document) {
+		if strings.Contains(problem.Diagnostic.Message, "drop_shadow") {
+			t.Errorf("unexpected problem for Hyprland 0.44: %s", problem.Diagnostic.Message)
+		}
+	}
+
+	os.WriteFile(mainFile, []byte("source = decoration.conf\n"), 0644)
+	if document, err = parse(uri); err != nil {
+		t.Fatal(err)
+	}
+	if version := documentVersion(uri); version != parser_data.HyprlandVersion {
+		t.Errorf("expected the documented version to be used, got %s", version)
+	}
+	found := false
+	for _, problem := range configProblems(uri, document) {
+		found = found || strings.Contains(problem.Diagnostic.Message, "decoration:drop_shadow was renamed")
+	}
+	if !found {
+		t.Error("expected decoration:drop_shadow to be reported as renamed")
+	}
+}
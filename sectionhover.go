@@ -0,0 +1,52 @@
+package hyprls
+
+import (
+	"fmt"
+	"strings"
+
+	parser_data "github.com/hyprland-community/hyprls/parser/data"
+)
+
+// sectionHoverContents documents the section at path: its description from the wiki, the options it accepts and its subsections.
+func sectionHoverContents(schema parser_data.Schema, path []string) string {
+	if isFreeformPath(path) {
+		return ""
+	}
+	section := schema.FindSectionDefinitionByPath(path)
+	if section == nil {
+		return ""
+	}
+
+	var contents strings.Builder
+	fmt.Fprintf(&contents, "### %s", strings.Join(path, ":"))
+	if link := section.DocumentationLink(); link != "" {
+		fmt.Fprintf(&contents, " [[docs]](%s)", link)
+	}
+	contents.WriteString("\n")
+	if section.Description != "" {
+		fmt.Fprintf(&contents, "\n%s\n", section.Description)
+	}
+	if len(section.Variables) > 0 {
+		contents.WriteString("\n| Option | Type | Default |\n| --- | --- | --- |\n")
+		for _, variable := range section.Variables {
+			fmt.Fprintf(&contents, "| `%s` | %s | %s |\n", variable.Name, variable.Type, markdownTableCell(variable.PrettyDefault()))
+		}
+	}
+	if len(section.Subsections) > 0 {
+		names := make([]string, 0, len(section.Subsections))
+		for _, subsection := range section.Subsections {
+			names = append(names, fmt.Sprintf("`%s`", subsection.JSONName()))
+		}
+		fmt.Fprintf(&contents, "\nSubsections: %s\n", strings.Join(names, ", "))
+	}
+
+	return contents.String()
+}
+
+// markdownTableCell escapes pipes, that would otherwise end the cell.
+func markdownTableCell(text string) string {
+	if text == "" {
+		return " "
+	}
+	return strings.ReplaceAll(text, "|", `\|`)
+}
@@ -0,0 +1,54 @@
+package hyprls
+
+import (
+	"strings"
+	"testing"
+
+	"go.lsp.dev/protocol"
+)
+
+func TestSectionHover(t *testing.T) {
+	contents := `decoration {
+    rounding = 10
+    blur {
+        enabled = true
+    }
+}
+`
+	uri := openTestConfig(t, contents)
+
+	hover := func(line, character uint32) *protocol.Hover {
+		result, err := Handler{}.Hover(t.Context(), &protocol.HoverParams{TextDocumentPositionParams: protocol.TextDocumentPositionParams{
+			TextDocument: protocol.TextDocumentIdentifier{URI: uri},
+			Position:     protocol.Position{Line: line, Character: character},
+		}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return result
+	}
+
+	blur := hover(2, 6)
+	if blur == nil {
+		t.Fatal("expected a hover on the blur section header")
+	}
+	for _, expected := range []string{
+		"### decoration:blur [[docs]](https://wiki.hyprland.org/Configuring/Variables/#blur)",
+		"| `enabled` | bool | true |",
+		"| `passes` | int | 1 |",
+	} {
+		if !strings.Contains(blur.Contents.Value, expected) {
+			t.Errorf("expected the blur hover to contain %q, got:\n%s", expected, blur.Contents.Value)
+		}
+	}
+	if blur.Range.Start.Character != 4 || blur.Range.End.Character != 8 {
+		t.Errorf("expected the hover to span blur, got %+v", blur.Range)
+	}
+
+	if decoration := hover(0, 2); decoration == nil || !strings.Contains(decoration.Contents.Value, "Subsections: `blur`, `shadow`") {
+		t.Errorf("expected the decoration hover to list its subsections, got %+v", decoration)
+	}
+	if option := hover(3, 10); option != nil && strings.Contains(option.Contents.Value, "| Option |") {
+		t.Errorf("expected options to keep their own hover, got %s", option.Contents.Value)
+	}
+}
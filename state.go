@@ -7,7 +7,6 @@ import (
 	"strings"
 
 	"github.com/hyprland-community/hyprls/parser"
-	parser_data "github.com/hyprland-community/hyprls/parser/data"
 	"go.lsp.dev/protocol"
 	"go.uber.org/zap"
 )
@@ -18,6 +17,40 @@ var openedFiles = make(map[protocol.URI]string)
 var defaultIgnores = []string{"hyprlock.conf", "hypridle.conf"}
 var Ignores = defaultIgnores
 
+// KnownWindowClasses are sample window classes used to show what a window rule regex matches.
+// Can be extended with the hyprls.knownWindowClasses setting.
+var KnownWindowClasses = defaultKnownWindowClasses
+var defaultKnownWindowClasses = []string{
+	"kitty",
+	"Alacritty",
+	"foot",
+	"org.wezfurlong.wezterm",
+	"firefox",
+	"chromium",
+	"google-chrome",
+	"code",
+	"code-url-handler",
+	"discord",
+	"vesktop",
+	"Spotify",
+	"steam",
+	"mpv",
+	"org.pulseaudio.pavucontrol",
+	"pavucontrol",
+	"org.gnome.Nautilus",
+	"thunar",
+	"org.kde.dolphin",
+	"xdg-desktop-portal-gtk",
+	"polkit-gnome-authentication-agent-1",
+	"nm-connection-editor",
+	"blueman-manager",
+	"com.obsproject.Studio",
+	"org.telegram.desktop",
+	"jetbrains-idea",
+	"gimp",
+	"imv",
+}
+
 type state struct {
 }
 
@@ -30,33 +63,34 @@ func parse(uri protocol.URI) (parser.Section, error) {
 	return parser.Parse(contents)
 }
 
-func currentSection(root parser.Section, position protocol.Position) *parser.Section {
-	if !within(root.LSPRange(), position) {
-		return nil
-	}
-
-	for _, section := range root.Subsections {
-		sec := currentSection(section, position)
-		if sec != nil {
-			return sec
+// statementAt returns the statement at position, along with the index of the argument under the cursor (-1 if the cursor is not on an argument).
+func statementAt(root parser.Section, position protocol.Position) (*parser.Statement, int) {
+	var found *parser.Statement
+	argument := -1
+	root.WalkStatements(func(stmt *parser.Statement) {
+		if found != nil || stmt.Position.Line != int(position.Line) {
+			return
 		}
-	}
-
-	return &root
+		found = stmt
+		for i, arg := range stmt.Arguments {
+			if within(arg.LSPRange(), position) {
+				argument = i
+				return
+			}
+		}
+	})
+	return found, argument
 }
 
-func currentAssignment(root parser.Section, position protocol.Position) *parser_data.VariableDefinition {
-	if !within(root.LSPRange(), position) {
-		return nil
+// statementRange spans the whole statement, from its keyword to its last argument.
+func statementRange(stmt parser.Statement) protocol.Range {
+	if len(stmt.Arguments) == 0 {
+		return collapsedRange(stmt.Position.LSP())
 	}
-
-	for _, assignment := range root.Assignments {
-		if assignment.Position.Line == int(position.Line) {
-			return parser_data.FindVariableDefinitionInSection(root.Name, assignment.Key)
-		}
+	return protocol.Range{
+		Start: stmt.Position.LSP(),
+		End:   stmt.Arguments[len(stmt.Arguments)-1].End.LSP(),
 	}
-
-	return nil
 }
 
 func within(rang protocol.Range, position protocol.Position) bool {
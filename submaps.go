@@ -0,0 +1,266 @@
+package hyprls
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hyprland-community/hyprls/parser"
+	"go.lsp.dev/protocol"
+)
+
+// submapBlock is a submap = NAME ... submap = reset block. The same submap can be defined by several blocks.
+type submapBlock struct {
+	URI   protocol.URI
+	Name  string
+	Start parser.Statement
+	// End is the submap = ... statement closing the block, nil if the block is not closed in the same file
+	End *parser.Statement
+	// Fallback is the submap Hyprland switches to after any keybinding of the block is triggered, as in submap = NAME, reset
+	Fallback    string
+	Keybindings []keybinding
+}
+
+func (b submapBlock) Location() protocol.Location {
+	return protocol.Location{
+		URI:   b.URI,
+		Range: b.Start.Arguments[0].LSPRange(),
+	}
+}
+
+// LSPRange spans the block, from its submap = NAME statement to the statement that closes it or its last keybinding.
+func (b submapBlock) LSPRange() protocol.Range {
+	rang := statementRange(b.Start)
+	if b.End != nil {
+		rang.End = statementRange(*b.End).End
+	} else if len(b.Keybindings) > 0 {
+		rang.End = statementRange(b.Keybindings[len(b.Keybindings)-1].Statement).End
+	}
+	return rang
+}
+
+// canBeLeft tells whether one of the block's keybindings switches to another submap.
+func (b submapBlock) canBeLeft() bool {
+	if b.Fallback != "" {
+		return true
+	}
+	for _, bind := range b.Keybindings {
+		if bind.entersSubmap() && bind.Params != b.Name || bind.Dispatcher == "exec" && strings.Contains(bind.Params, "dispatch submap") {
+			return true
+		}
+	}
+	return false
+}
+
+// entersSubmap tells whether the keybinding switches to a submap, the name of which is its Params.
+func (k keybinding) entersSubmap() bool {
+	return k.Dispatcher == "submap"
+}
+
+// submapIndex holds the submaps of a configuration graph and the keybindings that switch to them.
+type submapIndex struct {
+	Blocks []submapBlock
+	// Entries are keybindings using the submap dispatcher, including the ones switching back to the global submap with submap, reset
+	Entries []keybinding
+	// Universal are the keybindings active in every submap (u flag)
+	Universal []keybinding
+}
+
+func indexSubmaps(graph []configDocument) submapIndex {
+	variables := graphVariables(graph)
+	index := submapIndex{
+		Blocks:    make([]submapBlock, 0),
+		Entries:   make([]keybinding, 0),
+		Universal: make([]keybinding, 0),
+	}
+
+	current := -1
+	walkConfigStatements(graph, func(doc configDocument, stmt *parser.Statement) {
+		switch {
+		case stmt.Keyword == "submap":
+			if current >= 0 && index.Blocks[current].URI == doc.URI {
+				index.Blocks[current].End = stmt
+			}
+			current = -1
+
+			args := strings.SplitN(expandCustomVariables(stmt.ValueRaw, variables), ",", 2)
+			name := submapName(strings.TrimSpace(args[0]))
+			if name == "" {
+				return
+			}
+			block := submapBlock{URI: doc.URI, Name: name, Start: *stmt, Keybindings: make([]keybinding, 0)}
+			if len(args) > 1 {
+				block.Fallback = strings.TrimSpace(args[1])
+			}
+			index.Blocks = append(index.Blocks, block)
+			current = len(index.Blocks) - 1
+
+		case strings.HasPrefix(string(stmt.Keyword), "bind"):
+			submap := ""
+			if current >= 0 {
+				submap = index.Blocks[current].Name
+			}
+			bind := parseKeybinding(doc.URI, *stmt, submap, variables)
+			if current >= 0 {
+				index.Blocks[current].Keybindings = append(index.Blocks[current].Keybindings, bind)
+			}
+			if bind.entersSubmap() {
+				index.Entries = append(index.Entries, bind)
+			}
+			if bind.HasFlag('u') {
+				index.Universal = append(index.Universal, bind)
+			}
+		}
+	})
+
+	return index
+}
+
+// BlocksOf returns the blocks defining the submap called name.
+func (index submapIndex) BlocksOf(name string) []submapBlock {
+	blocks := make([]submapBlock, 0)
+	for _, block := range index.Blocks {
+		if block.Name == name {
+			blocks = append(blocks, block)
+		}
+	}
+	return blocks
+}
+
+// canBeLeft tells whether any keybinding lets the user switch out of the submap called name.
+func (index submapIndex) canBeLeft(name string) bool {
+	for _, block := range index.BlocksOf(name) {
+		if block.canBeLeft() {
+			return true
+		}
+	}
+	for _, bind := range index.Universal {
+		if bind.entersSubmap() && bind.Params != name {
+			return true
+		}
+	}
+	return false
+}
+
+// submapNameAt returns the name of the submap under the cursor, in a submap = NAME statement or in a keybinding's params.
+func submapNameAt(uri protocol.URI, stmt parser.Statement, argument int) (string, bool) {
+	switch {
+	case stmt.Keyword == "submap" && argument == 0:
+		name := submapName(stmt.RawArguments()[0])
+		return name, name != ""
+	case strings.HasPrefix(string(stmt.Keyword), "bind"):
+		bind := parseKeybinding(uri, stmt, "", graphVariables(configGraph(uri)))
+		if bind.entersSubmap() && argument == bind.paramsArgument() && submapName(bind.Params) != "" {
+			return bind.Params, true
+		}
+	}
+	return "", false
+}
+
+func submapDefinitions(uri protocol.URI, name string) []protocol.Location {
+	locations := make([]protocol.Location, 0)
+	for _, block := range indexSubmaps(configGraph(uri)).BlocksOf(name) {
+		locations = append(locations, block.Location())
+	}
+	return locations
+}
+
+func (h Handler) References(ctx context.Context, params *protocol.ReferenceParams) ([]protocol.Location, error) {
+	if isFileIgnored(params.TextDocument.URI) {
+		return nil, nil
+	}
+	document, err := parse(params.TextDocument.URI)
+	if err != nil {
+		return nil, nil
+	}
+
+	stmt, argument := statementAt(document, params.Position)
+	if stmt == nil {
+		return nil, nil
+	}
+
+	name, ok := submapNameAt(params.TextDocument.URI, *stmt, argument)
+	if !ok {
+		return nil, nil
+	}
+
+	index := indexSubmaps(configGraph(params.TextDocument.URI))
+	locations := make([]protocol.Location, 0)
+	if params.Context.IncludeDeclaration {
+		for _, block := range index.BlocksOf(name) {
+			locations = append(locations, block.Location())
+		}
+	}
+	for _, entry := range index.Entries {
+		if entry.Params == name {
+			locations = append(locations, protocol.Location{URI: entry.URI, Range: entry.ParamsRange()})
+		}
+	}
+	return locations, nil
+}
+
+func submapDiagnostics(uri protocol.URI, document parser.Section) []protocol.Diagnostic {
+	index := indexSubmaps(configGraph(uri))
+	diagnostics := make([]protocol.Diagnostic, 0)
+
+	for _, entry := range index.Entries {
+		if entry.URI != uri || submapName(entry.Params) == "" || len(index.BlocksOf(entry.Params)) > 0 {
+			continue
+		}
+		diagnostics = append(diagnostics, protocol.Diagnostic{
+			Range:    entry.ParamsRange(),
+			Severity: protocol.DiagnosticSeverityWarning,
+			Source:   diagnosticsSource,
+			Message:  fmt.Sprintf("submap %s is never defined, add a submap = %s block with its keybindings", entry.Params, entry.Params),
+		})
+	}
+
+	reported := make(map[string]bool)
+	for _, block := range index.Blocks {
+		if block.URI != uri || reported[block.Name] || index.canBeLeft(block.Name) {
+			continue
+		}
+		reported[block.Name] = true
+		diagnostics = append(diagnostics, protocol.Diagnostic{
+			Range:    block.Location().Range,
+			Severity: protocol.DiagnosticSeverityWarning,
+			Source:   diagnosticsSource,
+			Message:  fmt.Sprintf("no keybinding leaves submap %s, add one such as bind = , escape, submap, reset", block.Name),
+		})
+	}
+
+	return diagnostics
+}
+
+// submapSymbols lists the submap blocks of a document, with their keybindings as children.
+func submapSymbols(uri protocol.URI, document parser.Section) []protocol.DocumentSymbol {
+	symbols := make([]protocol.DocumentSymbol, 0)
+	for _, block := range indexSubmaps([]configDocument{{URI: uri, Document: document}}).Blocks {
+		children := make([]protocol.DocumentSymbol, 0, len(block.Keybindings))
+		for _, bind := range block.Keybindings {
+			detail := bind.Description
+			if detail == "" {
+				detail = strings.TrimSpace(bind.Dispatcher + " " + bind.Params)
+			}
+			name := bind.Chord()
+			if name == "" {
+				name = string(bind.Statement.Keyword)
+			}
+			children = append(children, protocol.DocumentSymbol{
+				Name:           name,
+				Kind:           protocol.SymbolKindKey,
+				Detail:         detail,
+				Range:          statementRange(bind.Statement),
+				SelectionRange: bind.LSPRange(),
+			})
+		}
+		symbols = append(symbols, protocol.DocumentSymbol{
+			Name:           "submap " + block.Name,
+			Kind:           protocol.SymbolKindModule,
+			Range:          block.LSPRange(),
+			SelectionRange: block.Location().Range,
+			Children:       children,
+		})
+	}
+	return symbols
+}
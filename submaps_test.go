@@ -0,0 +1,56 @@
+package hyprls
+
+import (
+	"testing"
+
+	"go.lsp.dev/protocol"
+)
+
+func TestSubmaps(t *testing.T) {
+	uri := openTestConfig(t, `bind = ALT, R, submap, resize
+bind = ALT, M, submap, move
+bind = ALT, T, submap, trap
+
+submap = resize
+binde = , right, resizeactive, 10 0
+bind = , escape, submap, reset
+submap = reset
+
+submap = trap
+bind = , right, resizeactive, 10 0
+submap = reset
+`)
+	document, err := parse(uri)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	index := indexSubmaps(configGraph(uri))
+	if len(index.Blocks) != 2 || len(index.Blocks[0].Keybindings) != 2 || index.Blocks[0].End == nil || index.Blocks[0].End.Position.Line != 7 {
+		t.Fatalf("unexpected blocks: %#v", index.Blocks)
+	}
+	if len(index.Entries) != 4 {
+		t.Errorf("expected 4 entries, got %d", len(index.Entries))
+	}
+
+	definitions := submapDefinitions(uri, "resize")
+	if len(definitions) != 1 || definitions[0].Range.Start.Line != 4 {
+		t.Errorf("unexpected definitions: %#v", definitions)
+	}
+
+	diagnostics := submapDiagnostics(uri, document)
+	if len(diagnostics) != 2 {
+		t.Fatalf("expected 2 diagnostics, got %#v", diagnostics)
+	}
+	if diagnostics[0].Range.Start != (protocol.Position{Line: 1, Character: 23}) {
+		t.Errorf("expected undefined submap move to be reported on its name, got %#v", diagnostics[0])
+	}
+	if diagnostics[1].Range.Start.Line != 9 {
+		t.Errorf("expected submap trap to be reported as inescapable, got %#v", diagnostics[1])
+	}
+
+	symbols := submapSymbols(uri, document)
+	if len(symbols) != 2 || symbols[0].Name != "submap resize" || len(symbols[0].Children) != 2 || symbols[0].Children[1].Name != "escape" {
+		t.Errorf("unexpected symbols: %#v", symbols)
+	}
+}
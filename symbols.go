@@ -20,6 +20,9 @@ func (h Handler) DocumentSymbol(ctx context.Context, params *protocol.DocumentSy
 	for _, symb := range gatherAllSymbols(document) {
 		symbols = append(symbols, &symb)
 	}
+	for _, symb := range submapSymbols(params.TextDocument.URI, document) {
+		symbols = append(symbols, &symb)
+	}
 	return symbols, nil
 }
 
@@ -16,6 +16,7 @@ func (h Handler) DidChange(ctx context.Context, params *protocol.DidChangeTextDo
 	if len(params.ContentChanges) > 0 {
 		openedFiles[params.TextDocument.URI] = params.ContentChanges[len(params.ContentChanges)-1].Text
 	}
+	h.publishDiagnostics(ctx, params.TextDocument.URI)
 	return nil
 }
 
@@ -24,6 +25,7 @@ func (h Handler) DidClose(ctx context.Context, params *protocol.DidCloseTextDocu
 		return nil
 	}
 	delete(openedFiles, params.TextDocument.URI)
+	h.clearDiagnostics(ctx, params.TextDocument.URI)
 	return nil
 }
 
@@ -31,7 +33,8 @@ func (h Handler) DidOpen(ctx context.Context, params *protocol.DidOpenTextDocume
 	if isFileIgnored(params.TextDocument.URI) {
 		return nil
 	}
-	file(params.TextDocument.URI)
+	openedFiles[params.TextDocument.URI] = params.TextDocument.Text
+	h.publishDiagnostics(ctx, params.TextDocument.URI)
 	return nil
 }
 
@@ -0,0 +1,175 @@
+package hyprls
+
+import (
+	"image/color"
+	"slices"
+	"strings"
+
+	"github.com/hyprland-community/hyprls/parser"
+	"go.lsp.dev/protocol"
+)
+
+// tokenKind is what the element of the configuration under the cursor is.
+type tokenKind int
+
+const (
+	tokenNone tokenKind = iota
+	tokenSectionHeader
+	tokenOptionKey
+	tokenOptionValue
+	tokenVariableDeclaration
+	tokenVariableReference
+	tokenColor
+	tokenKeyword
+	tokenArgument
+)
+
+// token is the element of the configuration under the cursor, resolved from the parse tree.
+type token struct {
+	Kind  tokenKind
+	Range protocol.Range
+	// Text is the token as written
+	Text string
+	// Section is the path of the section the cursor is in, such as [decoration blur]. On a section header, it is the path of that section.
+	Section []string
+	// Option is the full path of the option, for option keys and values
+	Option     []string
+	Assignment *parser.Assignment
+	Variable   *parser.CustomVariable
+	Statement  *parser.Statement
+	// Argument is the index of the argument of Statement the cursor is in, -1 when it is not in one
+	Argument int
+	// Reference is the variable reference under the cursor, for tokenVariableReference
+	Reference parser.Reference
+	Color     color.RGBA
+}
+
+// tokenAt resolves the token under the cursor. variables are used to tell which references are declared.
+func tokenAt(document parser.Section, lines []string, variables parser.Variables, position protocol.Position) token {
+	section, path, onHeader := sectionPathAt(document, []string{}, position)
+	tok := token{Section: path, Argument: -1}
+	if int(position.Line) >= len(lines) {
+		return tok
+	}
+	line := lines[position.Line]
+
+	if onHeader {
+		start := len(indentation(line))
+		tok.Kind, tok.Text = tokenSectionHeader, strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(line), "{"))
+		tok.Range = columnsRange(position.Line, start, start+len(tok.Text))
+		return tok
+	}
+
+	for i := range section.Assignments {
+		assignment := &section.Assignments[i]
+		if assignment.Position.Line != int(position.Line) {
+			continue
+		}
+		tok.Assignment = assignment
+		tok.Option = append(slices.Clone(path), strings.Split(assignment.Key, ":")...)
+		keyRange := columnsRange(position.Line, assignment.Position.Column, assignment.Position.Column+len(assignment.Key))
+		if within(keyRange, position) {
+			tok.Kind, tok.Text, tok.Range = tokenOptionKey, assignment.Key, keyRange
+			return tok
+		}
+		tok.Kind = tokenOptionValue
+		if assignment.ValueRaw == "" {
+			tok.Range = collapsedRange(position)
+			return tok
+		}
+		return valueToken(tok, line, assignment.Value.Start, assignment.Value.End, variables, position)
+	}
+
+	for i := range section.Variables {
+		variable := &section.Variables[i]
+		if variable.Position.Line != int(position.Line) {
+			continue
+		}
+		tok.Variable = variable
+		nameRange := columnsRange(position.Line, variable.Position.Column, variable.Position.Column+len("$"+variable.Key))
+		if within(nameRange, position) {
+			tok.Kind, tok.Text, tok.Range = tokenVariableDeclaration, "$"+variable.Key, nameRange
+			return tok
+		}
+		tok.Kind = tokenVariableDeclaration
+		if variable.ValueRaw == "" {
+			tok.Range = collapsedRange(position)
+			return tok
+		}
+		return valueToken(tok, line, variable.Value.Start, variable.Value.End, variables, position)
+	}
+
+	for i := range section.Statements {
+		stmt := &section.Statements[i]
+		if stmt.Position.Line != int(position.Line) {
+			continue
+		}
+		tok.Statement = stmt
+		keywordRange := columnsRange(position.Line, stmt.Position.Column, stmt.Position.Column+len(stmt.Keyword))
+		if within(keywordRange, position) {
+			tok.Kind, tok.Text, tok.Range = tokenKeyword, string(stmt.Keyword), keywordRange
+			return tok
+		}
+		for j, argument := range stmt.Arguments {
+			if within(argument.LSPRange(), position) {
+				tok.Kind, tok.Argument = tokenArgument, j
+				return valueToken(tok, line, argument.Start, argument.End, variables, position)
+			}
+		}
+		return tok
+	}
+	return tok
+}
+
+// valueToken narrows tok down to the variable reference or the color under the cursor in the value written between start and end.
+// tok spans the whole value otherwise.
+func valueToken(tok token, line string, start, end parser.Position, variables parser.Variables, position protocol.Position) token {
+	if start.Column > len(line) || end.Column > len(line) || start.Column > end.Column {
+		return tok
+	}
+	text := line[start.Column:end.Column]
+	tok.Text, tok.Range = text, columnsRange(position.Line, start.Column, end.Column)
+	offset := int(position.Character) - start.Column
+
+	for _, reference := range variables.References(text) {
+		if offset >= reference.Start && offset <= reference.End {
+			tok.Kind, tok.Reference = tokenVariableReference, reference
+			tok.Text, tok.Range = text[reference.Start:reference.End], columnsRange(position.Line, start.Column+reference.Start, start.Column+reference.End)
+			return tok
+		}
+	}
+	for _, word := range parser.GradientTokens(text) {
+		if offset < word.Start || offset > word.Start+len(word.Text) {
+			continue
+		}
+		if c, err := parser.ParseColor(word.Text); err == nil {
+			tok.Kind, tok.Color = tokenColor, c
+			tok.Text, tok.Range = word.Text, columnsRange(position.Line, start.Column+word.Start, start.Column+word.Start+len(word.Text))
+		}
+		break
+	}
+	return tok
+}
+
+// sectionPathAt returns the innermost section containing position along with its path, and whether position is on the line of its header.
+func sectionPathAt(section parser.Section, path []string, position protocol.Position) (parser.Section, []string, bool) {
+	line := int(position.Line)
+	for _, subsection := range section.Subsections {
+		if line < subsection.Start.Line || line > subsection.End.Line {
+			continue
+		}
+		subpath := append(slices.Clone(path), subsection.Name)
+		if line == subsection.Start.Line {
+			return subsection, subpath, true
+		}
+		return sectionPathAt(subsection, subpath, position)
+	}
+	return section, path, false
+}
+
+func columnsRange(line uint32, start, end int) protocol.Range {
+	return protocol.Range{
+		Start: protocol.Position{Line: line, Character: uint32(start)},
+		End:   protocol.Position{Line: line, Character: uint32(end)},
+	}
+}
@@ -7,10 +7,6 @@ import (
 	"go.lsp.dev/protocol"
 )
 
-func (h Handler) Definition(ctx context.Context, params *protocol.DefinitionParams) ([]protocol.Location, error) {
-	return []protocol.Location{}, errors.New("unimplemented")
-}
-
 func (h Handler) WorkDoneProgressCancel(ctx context.Context, params *protocol.WorkDoneProgressCancelParams) error {
 	return errors.New("unimplemented")
 }
@@ -23,18 +19,6 @@ func (h Handler) SetTrace(ctx context.Context, params *protocol.SetTraceParams)
 	return errors.New("unimplemented")
 }
 
-func (h Handler) CodeAction(ctx context.Context, params *protocol.CodeActionParams) ([]protocol.CodeAction, error) {
-	return nil, errors.New("unimplemented")
-}
-
-func (h Handler) CodeLens(ctx context.Context, params *protocol.CodeLensParams) ([]protocol.CodeLens, error) {
-	return nil, errors.New("unimplemented")
-}
-
-func (h Handler) CodeLensResolve(ctx context.Context, params *protocol.CodeLens) (*protocol.CodeLens, error) {
-	return nil, errors.New("unimplemented")
-}
-
 func (h Handler) Declaration(ctx context.Context, params *protocol.DeclarationParams) ([]protocol.Location, error) {
 	return nil, errors.New("unimplemented")
 }
@@ -55,10 +39,6 @@ func (h Handler) DocumentLinkResolve(ctx context.Context, params *protocol.Docum
 	return nil, errors.New("unimplemented")
 }
 
-func (h Handler) ExecuteCommand(ctx context.Context, params *protocol.ExecuteCommandParams) (interface{}, error) {
-	return nil, errors.New("unimplemented")
-}
-
 func (h Handler) FoldingRanges(ctx context.Context, params *protocol.FoldingRangeParams) ([]protocol.FoldingRange, error) {
 	return nil, errors.New("unimplemented")
 }
@@ -83,10 +63,6 @@ func (h Handler) RangeFormatting(ctx context.Context, params *protocol.DocumentR
 	return nil, errors.New("unimplemented")
 }
 
-func (h Handler) References(ctx context.Context, params *protocol.ReferenceParams) ([]protocol.Location, error) {
-	return nil, errors.New("unimplemented")
-}
-
 func (h Handler) Rename(ctx context.Context, params *protocol.RenameParams) (*protocol.WorkspaceEdit, error) {
 	return nil, errors.New("unimplemented")
 }
@@ -178,7 +154,3 @@ func (h Handler) LinkedEditingRange(ctx context.Context, params *protocol.Linked
 func (h Handler) Moniker(ctx context.Context, params *protocol.MonikerParams) ([]protocol.Moniker, error) {
 	return nil, errors.New("unimplemented")
 }
-
-func (h Handler) Request(ctx context.Context, method string, params interface{}) (interface{}, error) {
-	return nil, errors.New("unimplemented")
-}
@@ -1,6 +1,10 @@
 package hyprls
 
 import (
+	"slices"
+	"strings"
+	"unicode"
+
 	"go.lsp.dev/protocol"
 )
 
@@ -10,3 +14,83 @@ func collapsedRange(position protocol.Position) protocol.Range {
 		End:   position,
 	}
 }
+
+func rangesOverlap(a, b protocol.Range) bool {
+	return within(a, b.Start) || within(a, b.End) || within(b, a.Start)
+}
+
+// lineRange spans a whole line, including its line break, so that replacing it with an empty string removes the line.
+func lineRange(line int) protocol.Range {
+	return protocol.Range{
+		Start: protocol.Position{Line: uint32(line)},
+		End:   protocol.Position{Line: uint32(line + 1)},
+	}
+}
+
+// indentation returns the leading whitespace of line.
+func indentation(line string) string {
+	return line[:len(line)-len(strings.TrimLeftFunc(line, unicode.IsSpace))]
+}
+
+// indentUnit guesses the indentation used for sections' contents in a document, defaulting to four spaces.
+func indentUnit(lines []string) string {
+	for i := 1; i < len(lines); i++ {
+		previous := strings.TrimSpace(lines[i-1])
+		if strings.HasSuffix(previous, "{") && indentation(lines[i]) != "" && indentation(lines[i-1]) == "" {
+			return indentation(lines[i])
+		}
+	}
+	return "    "
+}
+
+// closestName returns the candidate that is the most similar to name, if it is similar enough for name to likely be a typo of it.
+func closestName(name string, candidates []string) (string, bool) {
+	best, bestDistance := "", len(name)+1
+	for _, candidate := range candidates {
+		if distance := levenshtein(strings.ToLower(name), strings.ToLower(candidate)); distance < bestDistance {
+			best, bestDistance = candidate, distance
+		}
+	}
+	return best, best != "" && best != name && bestDistance <= max(1, min(3, len(name)/3))
+}
+
+func levenshtein(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			substitution := previous[j-1]
+			if a[i-1] != b[j-1] {
+				substitution++
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, substitution)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+// applyTextEdits applies non-overlapping edits to text.
+func applyTextEdits(text string, edits []protocol.TextEdit) string {
+	lines := strings.SplitAfter(text, "\n")
+	length := len(text)
+	offset := func(position protocol.Position) int {
+		total := 0
+		for _, line := range lines[:min(int(position.Line), len(lines))] {
+			total += len(line)
+		}
+		return min(total+int(position.Character), length)
+	}
+
+	// Apply edits from the end so that offsets of the remaining ones stay valid
+	edits = slices.Clone(edits)
+	slices.SortStableFunc(edits, func(a, b protocol.TextEdit) int { return offset(b.Range.Start) - offset(a.Range.Start) })
+	for _, edit := range edits {
+		text = text[:offset(edit.Range.Start)] + edit.NewText + text[offset(edit.Range.End):]
+	}
+	return text
+}
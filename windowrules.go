@@ -0,0 +1,364 @@
+package hyprls
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/hyprland-community/hyprls/parser"
+	"go.lsp.dev/protocol"
+)
+
+// ruleRegexFields are the window and layer rule props whose argument is a RE2 regular expression.
+// Both the current snake_case names and the camelCase names of windowrulev2 are accepted.
+var ruleRegexFields = []string{
+	"class",
+	"title",
+	"initial_class",
+	"initial_title",
+	"initialClass",
+	"initialTitle",
+	"xdg_tag",
+	"xdgTag",
+	"namespace",
+}
+
+// appIDRuleRegexFields are matched against application IDs, which are usually meant to be matched exactly.
+var appIDRuleRegexFields = []string{"class", "initial_class", "initialClass"}
+
+const negatedRuleRegexPrefix = "negative:"
+
+// ruleRegex is a regular expression used as a matcher in a window or layer rule.
+type ruleRegex struct {
+	Field   string
+	Pattern string
+	Negated bool
+	// Start is the position of the first character of Pattern
+	Start parser.Position
+}
+
+func (r ruleRegex) LSPRange() protocol.Range {
+	return protocol.Range{
+		Start: r.Start.LSP(),
+		End:   parser.Position{Line: r.Start.Line, Column: r.Start.Column + len(r.Pattern)}.LSP(),
+	}
+}
+
+func (r ruleRegex) rangeAt(start, end int) protocol.Range {
+	return protocol.Range{
+		Start: parser.Position{Line: r.Start.Line, Column: r.Start.Column + start}.LSP(),
+		End:   parser.Position{Line: r.Start.Line, Column: r.Start.Column + end}.LSP(),
+	}
+}
+
+func isRuleKeyword(keyword string) bool {
+	return keyword == "windowrule" || keyword == "windowrulev2" || keyword == "layerrule"
+}
+
+func newRuleRegex(field, pattern string, start parser.Position) (ruleRegex, bool) {
+	if !slices.Contains(ruleRegexFields, field) {
+		return ruleRegex{}, false
+	}
+
+	leading := len(pattern) - len(strings.TrimLeftFunc(pattern, unicode.IsSpace))
+	pattern = strings.TrimSpace(pattern)
+	start.Column += leading
+
+	negated := strings.HasPrefix(pattern, negatedRuleRegexPrefix)
+	if negated {
+		pattern = strings.TrimPrefix(pattern, negatedRuleRegexPrefix)
+		start.Column += len(negatedRuleRegexPrefix)
+	}
+
+	return ruleRegex{
+		Field:   field,
+		Pattern: pattern,
+		Negated: negated,
+		Start:   start,
+	}, true
+}
+
+// collectRuleRegexes finds all regular expressions used in window and layer rules, be it in the
+// anonymous syntax (windowrule = match:class kitty, float on), the windowrulev2 syntax (windowrulev2 = float, class:kitty)
+// or the named syntax (windowrule { match:class = kitty }).
+func collectRuleRegexes(root parser.Section) []ruleRegex {
+	regexes := make([]ruleRegex, 0)
+	for _, stmt := range root.Statements {
+		if !isRuleKeyword(string(stmt.Keyword)) {
+			continue
+		}
+
+		for _, argument := range ruleArguments(stmt) {
+			arg, start := argument.Text, argument.Start
+			if rest, ok := strings.CutPrefix(arg, "match:"); ok {
+				field, pattern, _ := strings.Cut(rest, " ")
+				start.Column += len("match:") + len(field) + 1
+				if r, ok := newRuleRegex(field, pattern, start); ok {
+					regexes = append(regexes, r)
+				}
+			} else if stmt.Keyword == "windowrulev2" {
+				field, pattern, found := strings.Cut(arg, ":")
+				if !found {
+					continue
+				}
+				start.Column += len(field) + 1
+				if r, ok := newRuleRegex(field, pattern, start); ok {
+					regexes = append(regexes, r)
+				}
+			}
+		}
+	}
+
+	if isRuleKeyword(root.Name) {
+		for _, ass := range root.Assignments {
+			field, ok := strings.CutPrefix(ass.Key, "match:")
+			if !ok {
+				continue
+			}
+			if r, ok := newRuleRegex(field, ass.ValueRaw, ass.Value.Start); ok {
+				regexes = append(regexes, r)
+			}
+		}
+	}
+
+	for _, sec := range root.Subsections {
+		regexes = append(regexes, collectRuleRegexes(sec)...)
+	}
+
+	return regexes
+}
+
+// ruleArgument is an argument of a rule keyword as written, and the position of its first character.
+type ruleArgument struct {
+	Text  string
+	Start parser.Position
+}
+
+// ruleArguments splits the arguments of a rule at top-level commas only, unlike Statement.RawArguments,
+// so that commas of regexes such as ^(a{1,3})$ or [,;] don't cut them in half.
+func ruleArguments(stmt parser.Statement) []ruleArgument {
+	if len(stmt.Arguments) == 0 {
+		return nil
+	}
+	first, _, _ := strings.Cut(stmt.ValueRaw, ",")
+	valueStart := stmt.Arguments[0].Start.Column - strings.Index(first, strings.TrimSpace(first))
+
+	arguments := make([]ruleArgument, 0, len(stmt.Arguments))
+	add := func(start, end int) {
+		text := stmt.ValueRaw[start:end]
+		leading := len(text) - len(strings.TrimLeftFunc(text, unicode.IsSpace))
+		arguments = append(arguments, ruleArgument{
+			Text:  strings.TrimSpace(text),
+			Start: parser.Position{Line: stmt.Arguments[0].Start.Line, Column: valueStart + start + leading},
+		})
+	}
+	depth, inClass, start := 0, false, 0
+	for i := 0; i < len(stmt.ValueRaw); i++ {
+		switch c := stmt.ValueRaw[i]; {
+		case c == '\\':
+			i++
+		case inClass:
+			inClass = c != ']'
+		case c == '[':
+			inClass = true
+		case c == '(' || c == '{':
+			depth++
+		case (c == ')' || c == '}') && depth > 0:
+			depth--
+		case c == ',' && depth == 0:
+			add(start, i)
+			start = i + 1
+		}
+	}
+	add(start, len(stmt.ValueRaw))
+	return arguments
+}
+
+func windowRuleRegexDiagnostics(uri protocol.URI, document parser.Section) []protocol.Diagnostic {
+	diagnostics := make([]protocol.Diagnostic, 0)
+	for _, r := range collectRuleRegexes(document) {
+		diagnostics = append(diagnostics, checkRuleRegex(r)...)
+	}
+	return diagnostics
+}
+
+func checkRuleRegex(r ruleRegex) []protocol.Diagnostic {
+	if r.Pattern == "" {
+		return []protocol.Diagnostic{{
+			Range:    r.LSPRange(),
+			Severity: protocol.DiagnosticSeverityError,
+			Source:   diagnosticsSource,
+			Message:  fmt.Sprintf("missing regular expression for %s", r.Field),
+		}}
+	}
+
+	if _, err := syntax.Parse(r.Pattern, syntax.Perl); err != nil {
+		var syntaxErr *syntax.Error
+		start, end := 0, len(r.Pattern)
+		if errors.As(err, &syntaxErr) {
+			start, end = regexErrorOffsets(r.Pattern, syntaxErr)
+		}
+		return []protocol.Diagnostic{{
+			Range:    r.rangeAt(start, end),
+			Severity: protocol.DiagnosticSeverityError,
+			Source:   diagnosticsSource,
+			Message:  fmt.Sprintf("invalid regular expression: %s. Hyprland will never match this rule", err),
+		}}
+	}
+
+	diagnostics := make([]protocol.Diagnostic, 0)
+	if !slices.Contains(appIDRuleRegexFields, r.Field) {
+		return diagnostics
+	}
+
+	for _, dot := range unescapedDotsInAppID(r.Pattern) {
+		diagnostics = append(diagnostics, protocol.Diagnostic{
+			Range:    r.rangeAt(dot, dot+1),
+			Severity: protocol.DiagnosticSeverityWarning,
+			Source:   diagnosticsSource,
+			Message:  `unescaped "." matches any character, use "\." to match a literal dot`,
+		})
+	}
+
+	return diagnostics
+}
+
+// regexErrorOffsets returns the byte range of pattern that caused err.
+func regexErrorOffsets(pattern string, err *syntax.Error) (start, end int) {
+	switch err.Code {
+	case syntax.ErrMissingParen:
+		if i := unbalancedParen(pattern, '('); i >= 0 {
+			return i, i + 1
+		}
+	case syntax.ErrUnexpectedParen:
+		if i := unbalancedParen(pattern, ')'); i >= 0 {
+			return i, i + 1
+		}
+	}
+
+	if i := strings.LastIndex(pattern, err.Expr); err.Expr != "" && i >= 0 {
+		return i, i + len(err.Expr)
+	}
+	return 0, len(pattern)
+}
+
+// unbalancedParen returns the index of the first unbalanced paren of the given kind, or -1.
+// Escaped parens and parens inside character classes are ignored.
+func unbalancedParen(pattern string, kind byte) int {
+	opened := make([]int, 0)
+	inClass := false
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case c == '\\':
+			i++
+		case inClass:
+			inClass = c != ']'
+		case c == '[':
+			inClass = true
+		case c == '(':
+			opened = append(opened, i)
+		case c == ')':
+			if len(opened) == 0 {
+				if kind == ')' {
+					return i
+				}
+				continue
+			}
+			opened = opened[:len(opened)-1]
+		}
+	}
+
+	if kind == '(' && len(opened) > 0 {
+		return opened[len(opened)-1]
+	}
+	return -1
+}
+
+// unescapedDotsInAppID returns the indices of dots that sit between two alphanumeric characters, as in org.gnome.Nautilus,
+// which are almost always meant to match a literal dot.
+func unescapedDotsInAppID(pattern string) []int {
+	dots := make([]int, 0)
+	inClass := false
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case c == '\\':
+			i++
+		case inClass:
+			inClass = c != ']'
+		case c == '[':
+			inClass = true
+		case c == '.' && i > 0 && i < len(pattern)-1:
+			if isAlphanumeric(pattern[i-1]) && isAlphanumeric(pattern[i+1]) {
+				dots = append(dots, i)
+			}
+		}
+	}
+	return dots
+}
+
+func isAlphanumeric(c byte) bool {
+	return c < unicode.MaxASCII && (unicode.IsLetter(rune(c)) || unicode.IsDigit(rune(c)))
+}
+
+func ruleRegexAt(document parser.Section, position protocol.Position) *ruleRegex {
+	for _, r := range collectRuleRegexes(document) {
+		if within(r.LSPRange(), position) {
+			return &r
+		}
+	}
+	return nil
+}
+
+// ruleRegexHover shows the classes the regex matches. Hyprland matches the whole class (RE2::FullMatch), so the pattern is anchored.
+func ruleRegexHover(r ruleRegex) *protocol.Hover {
+	rang := r.LSPRange()
+	compiled, err := regexp.Compile(`^(?:` + r.Pattern + `)$`)
+	if err != nil {
+		return &protocol.Hover{
+			Contents: protocol.MarkupContent{
+				Kind:  protocol.Markdown,
+				Value: fmt.Sprintf("### %s regex\n\nInvalid regular expression: %s", r.Field, err),
+			},
+			Range: &rang,
+		}
+	}
+
+	simplified := r.Pattern
+	if parsed, err := syntax.Parse(r.Pattern, syntax.Perl); err == nil {
+		simplified = parsed.Simplify().String()
+	}
+
+	matching := make([]string, 0)
+	for _, class := range KnownWindowClasses {
+		if compiled.MatchString(class) != r.Negated {
+			matching = append(matching, "- `"+class+"`")
+		}
+		if len(matching) == maxRuleRegexHoverSamples {
+			break
+		}
+	}
+
+	samples := "None of the known classes match."
+	if len(matching) > 0 {
+		samples = "Matches known classes:\n\n" + strings.Join(matching, "\n")
+	}
+
+	negation := ""
+	if r.Negated {
+		negation = " (negated)"
+	}
+
+	return &protocol.Hover{
+		Contents: protocol.MarkupContent{
+			Kind:  protocol.Markdown,
+			Value: fmt.Sprintf("### %s regex%s\n\nCompiled pattern: `%s`\n\n%s", r.Field, negation, simplified, samples),
+		},
+		Range: &rang,
+	}
+}
+
+const maxRuleRegexHoverSamples = 5
@@ -0,0 +1,91 @@
+package hyprls
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hyprland-community/hyprls/parser"
+	"go.lsp.dev/protocol"
+)
+
+func TestCollectRuleRegexes(t *testing.T) {
+	document, _ := parser.Parse(`windowrulev2 = float, class:^(pavucontrol$
+windowrule = match:class negative:^(kitty)$, float on
+windowrule {
+    name = portal
+    match:title = ^(Open File)$
+}
+`)
+	regexes := collectRuleRegexes(document)
+	if len(regexes) != 3 {
+		t.Fatalf("expected 3 regexes, got %d: %#v", len(regexes), regexes)
+	}
+
+	expected := []ruleRegex{
+		{Field: "class", Pattern: "^(pavucontrol$", Start: parser.Position{Line: 0, Column: 28}},
+		{Field: "class", Pattern: "^(kitty)$", Negated: true, Start: parser.Position{Line: 1, Column: 34}},
+		{Field: "title", Pattern: "^(Open File)$", Start: parser.Position{Line: 4, Column: 18}},
+	}
+	for i, r := range regexes {
+		if r != expected[i] {
+			t.Errorf("regex %d: expected %#v, got %#v", i, expected[i], r)
+		}
+	}
+}
+
+func TestRuleRegexesWithCommas(t *testing.T) {
+	document, _ := parser.Parse("windowrulev2 = float, class:^(a{1,3})$, title:[,;] (x|y,z)\n")
+	regexes := collectRuleRegexes(document)
+	expected := []ruleRegex{
+		{Field: "class", Pattern: "^(a{1,3})$", Start: parser.Position{Line: 0, Column: 28}},
+		{Field: "title", Pattern: "[,;] (x|y,z)", Start: parser.Position{Line: 0, Column: 46}},
+	}
+	if len(regexes) != len(expected) {
+		t.Fatalf("expected %d regexes, got %#v", len(expected), regexes)
+	}
+	for i, r := range regexes {
+		if r != expected[i] {
+			t.Errorf("regex %d: expected %#v, got %#v", i, expected[i], r)
+		}
+		if diagnostics := checkRuleRegex(r); len(diagnostics) != 0 {
+			t.Errorf("regex %d: expected no diagnostics, got %#v", i, diagnostics)
+		}
+	}
+}
+
+func TestRuleRegexHoverMatchesWholeClasses(t *testing.T) {
+	hover := ruleRegexHover(ruleRegex{Field: "class", Pattern: "code"})
+	if contents := hover.Contents.Value; !strings.Contains(contents, "`code`") || strings.Contains(contents, "`code-url-handler`") {
+		t.Errorf("expected only classes matching code as a whole, got %s", contents)
+	}
+}
+
+func TestCheckRuleRegex(t *testing.T) {
+	start := parser.Position{Line: 0, Column: 28}
+
+	diagnostics := checkRuleRegex(ruleRegex{Field: "class", Pattern: "^(pavucontrol$", Start: start})
+	if len(diagnostics) != 1 || diagnostics[0].Severity != protocol.DiagnosticSeverityError {
+		t.Fatalf("expected a single error, got %#v", diagnostics)
+	}
+	if diagnostics[0].Range.Start.Character != 29 || diagnostics[0].Range.End.Character != 30 {
+		t.Errorf("expected error on the unclosed paren, got %#v", diagnostics[0].Range)
+	}
+
+	diagnostics = checkRuleRegex(ruleRegex{Field: "class", Pattern: "^(org.gnome.Nautilus)$", Start: start})
+	if len(diagnostics) != 2 {
+		t.Fatalf("expected two unescaped dots warnings, got %#v", diagnostics)
+	}
+	if diagnostics[0].Range.Start.Character != 33 {
+		t.Errorf("expected warning on the first dot, got %#v", diagnostics[0].Range)
+	}
+
+	diagnostics = checkRuleRegex(ruleRegex{Field: "class", Pattern: "kitty", Start: start})
+	if len(diagnostics) != 0 {
+		t.Errorf("expected no warnings for a pattern that Hyprland matches against the whole class, got %#v", diagnostics)
+	}
+
+	diagnostics = checkRuleRegex(ruleRegex{Field: "title", Pattern: "Picture-in-Picture", Start: start})
+	if len(diagnostics) != 0 {
+		t.Errorf("expected no warnings for titles, got %#v", diagnostics)
+	}
+}
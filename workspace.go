@@ -8,12 +8,29 @@ import (
 	"go.uber.org/zap"
 )
 
-func extractIgnoresFromChangeConfigSettings(params *protocol.DidChangeConfigurationParams) (ignores []string, isAvailable bool) {
+func hyprlsSettings(params *protocol.DidChangeConfigurationParams) (map[string]any, bool) {
 	settings, ok := (params.Settings).(map[string]any)
 	if !ok {
 		return nil, false
 	}
 	hyprls, ok := settings["hyprls"].(map[string]any)
+	return hyprls, ok
+}
+
+func extractStringsSetting(hyprls map[string]any, key string) (values []string, isAvailable bool) {
+	if arr, ok := hyprls[key].([]any); ok {
+		isAvailable = true
+		for _, v := range arr {
+			if s, ok := v.(string); ok {
+				values = append(values, s)
+			}
+		}
+	}
+	return
+}
+
+func extractIgnoresFromChangeConfigSettings(params *protocol.DidChangeConfigurationParams) (ignores []string, isAvailable bool) {
+	hyprls, ok := hyprlsSettings(params)
 	if !ok {
 		return nil, false
 	}
@@ -22,17 +39,15 @@ func extractIgnoresFromChangeConfigSettings(params *protocol.DidChangeConfigurat
 			preferIgnoreFile = pfIgnoreBool
 		}
 	}
-	if ignore, ok := hyprls["ignore"]; ok {
-		if arr, ok := ignore.([]any); ok {
-			isAvailable = true
-			for _, v := range arr {
-				if s, ok := v.(string); ok {
-					ignores = append(ignores, s)
-				}
-			}
-		}
+	return extractStringsSetting(hyprls, "ignore")
+}
+
+func extractKnownWindowClassesFromChangeConfigSettings(params *protocol.DidChangeConfigurationParams) (classes []string, isAvailable bool) {
+	hyprls, ok := hyprlsSettings(params)
+	if !ok {
+		return nil, false
 	}
-	return
+	return extractStringsSetting(hyprls, "knownWindowClasses")
 }
 
 func (h Handler) DidChangeConfiguration(ctx context.Context, params *protocol.DidChangeConfigurationParams) error {
@@ -41,6 +56,24 @@ func (h Handler) DidChangeConfiguration(ctx context.Context, params *protocol.Di
 		Ignores = newIgnores
 		h.Logger.Info("configuration changed", zap.Strings("ignores", Ignores))
 	}
+	if classes, updated := extractKnownWindowClassesFromChangeConfigSettings(params); updated {
+		KnownWindowClasses = append(classes, defaultKnownWindowClasses...)
+		h.Logger.Info("configuration changed", zap.Strings("knownWindowClasses", classes))
+	}
+	if hyprls, ok := hyprlsSettings(params); ok {
+		if version, updated := extractHyprlandVersion(hyprls); updated {
+			configuredHyprlandVersion = version
+			h.Logger.Info("configuration changed", zap.String("hyprlandVersion", version))
+		}
+		if mode, updated := extractDefaultValueHints(hyprls); updated {
+			defaultValueHints = mode
+			h.Logger.Info("configuration changed", zap.String("defaultValueHints", mode))
+		}
+		if enabled, updated := extractContrastDiagnostics(hyprls); updated {
+			contrastDiagnostics = enabled
+			h.Logger.Info("configuration changed", zap.Bool("contrastDiagnostics", enabled))
+		}
+	}
 	return nil
 }
 